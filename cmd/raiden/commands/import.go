@@ -72,6 +72,9 @@ func ImportCommand() *cobra.Command {
 			// 2. run import
 			if err = imports.Run(&f.LogFlags, &f.Imports, currentDir); err != nil {
 				imports.ImportLogger.Error(err.Error())
+				if f.Imports.Check {
+					os.Exit(1)
+				}
 				return
 			}
 		},
@@ -0,0 +1,100 @@
+package commands
+
+import (
+	"github.com/sev-2/raiden"
+	"github.com/sev-2/raiden/pkg/cli"
+	"github.com/sev-2/raiden/pkg/cli/configure"
+	"github.com/sev-2/raiden/pkg/cli/migrations"
+	"github.com/sev-2/raiden/pkg/utils"
+	"github.com/spf13/cobra"
+)
+
+type MigrationsFlags struct {
+	cli.LogFlags
+	Migrations migrations.Flags
+}
+
+func MigrationsCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "migrations",
+		Short: "Manage apply migration history",
+		Long:  "List migration history of apply and rollback applied migration",
+	}
+
+	cmd.AddCommand(migrationsListCommand(), migrationsRollbackCommand())
+	return cmd
+}
+
+func migrationsListCommand() *cobra.Command {
+	var f MigrationsFlags
+
+	return &cobra.Command{
+		Use:    "list",
+		Short:  "List migration history",
+		Long:   "List migration history stored in raiden.migrations table",
+		PreRun: PreRun(&f.LogFlags, migrations.PreRun),
+		Run: func(cmd *cobra.Command, args []string) {
+			f.CheckAndActivateDebug(cmd)
+
+			config, _, err := loadMigrationsConfig()
+			if err != nil {
+				migrations.MigrationLogger.Error(err.Error())
+				return
+			}
+
+			if err := migrations.List(config); err != nil {
+				migrations.MigrationLogger.Error(err.Error())
+			}
+		},
+	}
+}
+
+func migrationsRollbackCommand() *cobra.Command {
+	var f MigrationsFlags
+
+	cmd := &cobra.Command{
+		Use:    "rollback <id>",
+		Short:  "Rollback migration",
+		Long:   "Restore resource to the state before migration is applied",
+		Args:   cobra.ExactArgs(1),
+		PreRun: PreRun(&f.LogFlags, migrations.PreRun),
+		Run: func(cmd *cobra.Command, args []string) {
+			f.CheckAndActivateDebug(cmd)
+
+			id, err := migrations.ParseMigrationId(args)
+			if err != nil {
+				migrations.MigrationLogger.Error(err.Error())
+				return
+			}
+
+			config, currentDir, err := loadMigrationsConfig()
+			if err != nil {
+				migrations.MigrationLogger.Error(err.Error())
+				return
+			}
+
+			if err := migrations.Rollback(&f.Migrations, config, currentDir, id); err != nil {
+				migrations.MigrationLogger.Error(err.Error())
+			}
+		},
+	}
+
+	f.Migrations.Bind(cmd)
+	return cmd
+}
+
+func loadMigrationsConfig() (*raiden.Config, string, error) {
+	currentDir, err := utils.GetCurrentDirectory()
+	if err != nil {
+		return nil, "", err
+	}
+
+	migrations.MigrationLogger.Info("load configuration")
+	configFilePath := configure.GetConfigFilePath(currentDir)
+	migrations.MigrationLogger.Debug("config file information", "path", configFilePath)
+	config, err := raiden.LoadConfig(&configFilePath)
+	if err != nil {
+		return nil, "", err
+	}
+	return config, currentDir, nil
+}
@@ -0,0 +1,40 @@
+package commands
+
+import (
+	"github.com/sev-2/raiden/pkg/cli"
+	"github.com/sev-2/raiden/pkg/cli/promote"
+	"github.com/sev-2/raiden/pkg/utils"
+	"github.com/spf13/cobra"
+)
+
+type PromoteFlags struct {
+	cli.LogFlags
+	Promote promote.Flags
+}
+
+func PromoteCommand() *cobra.Command {
+	var f PromoteFlags
+
+	cmd := &cobra.Command{
+		Use:    "promote",
+		Short:  "Promote schema between environment",
+		Long:   "Apply schema of source environment database to target environment database",
+		PreRun: PreRun(&f.LogFlags, promote.PreRun),
+		Run: func(cmd *cobra.Command, args []string) {
+			f.CheckAndActivateDebug(cmd)
+
+			currentDir, err := utils.GetCurrentDirectory()
+			if err != nil {
+				promote.PromoteLogger.Error(err.Error())
+				return
+			}
+
+			if err := promote.Run(&f.Promote, currentDir); err != nil {
+				promote.PromoteLogger.Error(err.Error())
+			}
+		},
+	}
+
+	f.Promote.Bind(cmd)
+	return cmd
+}
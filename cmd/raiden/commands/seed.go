@@ -0,0 +1,61 @@
+package commands
+
+import (
+	"github.com/sev-2/raiden"
+	"github.com/sev-2/raiden/pkg/cli"
+	"github.com/sev-2/raiden/pkg/cli/configure"
+	"github.com/sev-2/raiden/pkg/cli/generate"
+	"github.com/sev-2/raiden/pkg/cli/seed"
+	"github.com/sev-2/raiden/pkg/utils"
+	"github.com/spf13/cobra"
+)
+
+type SeedFlags struct {
+	cli.LogFlags
+	Seed     seed.Flags
+	Generate generate.Flags
+}
+
+func SeedCommand() *cobra.Command {
+	var f SeedFlags
+
+	cmd := &cobra.Command{
+		Use:    "seed",
+		Short:  "Seed reference data",
+		Long:   "Upsert seed data from internal/seeds to database, unchanged seed row is skipped",
+		PreRun: PreRun(&f.LogFlags, seed.PreRun),
+		Run: func(cmd *cobra.Command, args []string) {
+			f.CheckAndActivateDebug(cmd)
+
+			currentDir, err := utils.GetCurrentDirectory()
+			if err != nil {
+				seed.SeedLogger.Error(err.Error())
+				return
+			}
+
+			seed.SeedLogger.Info("load configuration")
+			configFilePath := configure.GetConfigFilePath(currentDir)
+			config, err := raiden.LoadConfig(&configFilePath)
+			if err != nil {
+				seed.SeedLogger.Error(err.Error())
+				return
+			}
+
+			// 1. generate all resource
+			if err = generate.Run(&f.Generate, config, currentDir, false); err != nil {
+				seed.SeedLogger.Error(err.Error())
+				return
+			}
+
+			// 2. run seed
+			if err = seed.Run(&f.LogFlags, &f.Seed, config, currentDir); err != nil {
+				seed.SeedLogger.Error(err.Error())
+			}
+		},
+	}
+
+	f.Seed.Bind(cmd)
+	f.Generate.Bind(cmd)
+
+	return cmd
+}
@@ -1,6 +1,7 @@
 package main
 
 import (
+	"github.com/hashicorp/go-hclog"
 	"github.com/sev-2/raiden/cmd/raiden/commands"
 	"github.com/sev-2/raiden/pkg/cli"
 
@@ -9,8 +10,16 @@ import (
 
 func main() {
 	f := cli.LogFlags{}
+	ef := cli.EnvFlags{}
 
-	rootCmd := &cobra.Command{Use: "raiden"}
+	rootCmd := &cobra.Command{
+		Use: "raiden",
+		PersistentPreRun: func(cmd *cobra.Command, args []string) {
+			if err := ef.Activate(); err != nil {
+				hclog.Default().Error(err.Error())
+			}
+		},
+	}
 
 	rootCmd.AddCommand(
 		commands.ApplyCommand(),
@@ -19,6 +28,9 @@ func main() {
 		commands.GenerateCommand(),
 		commands.ImportCommand(),
 		commands.InitCommand(),
+		commands.MigrationsCommand(),
+		commands.PromoteCommand(),
+		commands.SeedCommand(),
 		commands.RunCommand(),
 		commands.ServeCommand(),
 		commands.StartCommand(),
@@ -26,6 +38,7 @@ func main() {
 	)
 
 	f.Bind(rootCmd)
+	ef.Bind(rootCmd)
 
 	err := rootCmd.Execute()
 	if err != nil {
@@ -1,7 +1,10 @@
 package raiden
 
 import (
+	"fmt"
+	"os"
 	"path/filepath"
+	"reflect"
 
 	"github.com/ory/viper"
 )
@@ -22,6 +25,22 @@ const (
 	SvcMode Mode = "svc"
 )
 
+type StateBackend string
+
+const (
+	StateBackendFile     StateBackend = "file"
+	StateBackendPostgres StateBackend = "postgres"
+)
+
+// QueryDriver is backend of db query, postgres driver run query with
+// direct connection of DATABASE_URL and only available in svc mode
+type QueryDriver string
+
+const (
+	QueryDriverPostgrest QueryDriver = "postgrest"
+	QueryDriverPostgres  QueryDriver = "postgres"
+)
+
 type TokenType string
 
 const (
@@ -29,6 +48,16 @@ const (
 	TokenTypeBearer TokenType = "bearer"
 )
 
+const (
+	// ConfigEnvKey is environment variable for select configuration profile,
+	// profile file is app.<env>.yaml and layered over app.yaml
+	ConfigEnvKey = "RAIDEN_ENV"
+
+	// ConfigEnvPrefix is prefix of environment variable that override configuration,
+	// for example RAIDEN_SERVICE_KEY override SERVICE_KEY
+	ConfigEnvPrefix = "RAIDEN"
+)
+
 type Config struct {
 	AccessToken              string           `mapstructure:"ACCESS_TOKEN"`
 	AnonKey                  string           `mapstructure:"ANON_KEY"`
@@ -38,10 +67,12 @@ type Config struct {
 	CorsAllowedMethods       string           `mapstructure:"CORS_ALLOWED_METHODS"`
 	CorsAllowedHeaders       string           `mapstructure:"CORS_ALLOWED_HEADERS"`
 	CorsAllowCredentials     bool             `mapstructure:"CORS_ALLOWED_CREDENTIALS"`
+	DatabaseUrl              string           `mapstructure:"DATABASE_URL"`
 	DeploymentTarget         DeploymentTarget `mapstructure:"DEPLOYMENT_TARGET"`
 	Environment              string           `mapstructure:"ENVIRONMENT"`
 	GoogleProjectId          string           `mapstructure:"GOOGLE_PROJECT_ID"`
 	GoogleSaPath             string           `mapstructure:"GOOGLE_SA_PATH"`
+	JwtSecret                string           `mapstructure:"JWT_SECRET"`
 	JwtToken                 string           `mapstructure:"JWT_TOKEN"`
 	LogLevel                 string           `mapstructure:"LOG_LEVEL"`
 	MaxServerRequestBodySize int              `mapstructure:"MAX_SERVER_REQUEST_BODY_SIZE"`
@@ -50,6 +81,7 @@ type Config struct {
 	PostgRestUrl             string           `mapstructure:"POSTGREST_URL"`
 	ProjectId                string           `mapstructure:"PROJECT_ID"`
 	ProjectName              string           `mapstructure:"PROJECT_NAME"`
+	QueryDriver              QueryDriver      `mapstructure:"QUERY_DRIVER"`
 	ServiceKey               string           `mapstructure:"SERVICE_KEY"`
 	ServerHost               string           `mapstructure:"SERVER_HOST"`
 	ServerPort               string           `mapstructure:"SERVER_PORT"`
@@ -60,6 +92,7 @@ type Config struct {
 	SupabaseApiTokenType     string           `mapstructure:"SUPABASE_API_TOKEN_TYPE"`
 	SupabasePublicUrl        string           `mapstructure:"SUPABASE_PUBLIC_URL"`
 	ScheduleStatus           ScheduleStatus   `mapstructure:"SCHEDULE_STATUS"`
+	StateBackend             StateBackend     `mapstructure:"STATE_BACKEND"`
 	TraceEnable              bool             `mapstructure:"TRACE_ENABLE"`
 	TraceCollector           string           `mapstructure:"TRACE_COLLECTOR"`
 	TraceCollectorEndpoint   string           `mapstructure:"TRACE_COLLECTOR_ENDPOINT"`
@@ -67,28 +100,41 @@ type Config struct {
 }
 
 // The function `LoadConfig` loads a configuration file based on the provided path or uses default
-// values if no path is provided.
+// values if no path is provided. when RAIDEN_ENV is set, profile file in the same folder is layered
+// over the base file and every key can be overridden with RAIDEN_ prefixed environment variable.
 func LoadConfig(path *string) (*Config, error) {
+	return LoadEnvConfig(path, os.Getenv(ConfigEnvKey))
+}
+
+// LoadEnvConfig loads configuration with the given profile, base file is used when env is empty
+func LoadEnvConfig(path *string, env string) (*Config, error) {
+	folderPath, fileName, fileExtension := "./configs", "app", "yaml"
 	if path != nil && *path != "" {
-		folderPath := filepath.Dir(*path)
+		folderPath = filepath.Dir(*path)
 		file := filepath.Base(*path)
 
-		fileExtension := filepath.Ext(file)[1:]
-		fileName := file[:len(file)-len(fileExtension)-1]
-
-		viper.SetConfigName(fileName)
-		viper.SetConfigType(fileExtension)
-		viper.AddConfigPath(folderPath)
-	} else {
-		viper.SetConfigName("app")
-		viper.SetConfigType("yaml")
-		viper.AddConfigPath("./configs")
+		fileExtension = filepath.Ext(file)[1:]
+		fileName = file[:len(file)-len(fileExtension)-1]
 	}
 
+	viper.SetConfigName(fileName)
+	viper.SetConfigType(fileExtension)
+	viper.AddConfigPath(folderPath)
+
 	if err := viper.ReadInConfig(); err != nil {
 		return nil, err
 	}
 
+	if env != "" {
+		if err := mergeConfigProfile(filepath.Join(folderPath, fmt.Sprintf("%s.%s.%s", fileName, env, fileExtension))); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := bindConfigEnv(); err != nil {
+		return nil, err
+	}
+
 	var config Config
 	if err := viper.Unmarshal(&config); err != nil {
 		return nil, err
@@ -107,6 +153,10 @@ func LoadConfig(path *string) (*Config, error) {
 		config.Version = "1.0.0"
 	}
 
+	if config.Environment == "" && env != "" {
+		config.Environment = env
+	}
+
 	if config.Environment == "" {
 		config.Environment = "development"
 	}
@@ -119,6 +169,14 @@ func LoadConfig(path *string) (*Config, error) {
 		config.ScheduleStatus = ScheduleStatusOff
 	}
 
+	if config.StateBackend == "" {
+		config.StateBackend = StateBackendFile
+	}
+
+	if config.QueryDriver == "" {
+		config.QueryDriver = QueryDriverPostgrest
+	}
+
 	if config.AllowedTables == "" {
 		config.AllowedTables = "*"
 	}
@@ -134,6 +192,38 @@ func LoadConfig(path *string) (*Config, error) {
 	return &config, nil
 }
 
+func mergeConfigProfile(profilePath string) error {
+	file, err := os.Open(profilePath)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return fmt.Errorf("config profile %s is not found", profilePath)
+		}
+		return err
+	}
+	defer file.Close()
+
+	return viper.MergeConfig(file)
+}
+
+// bindConfigEnv register every config key to environment variable,
+// so key that not exist in config file can still be set from environment
+func bindConfigEnv() error {
+	viper.SetEnvPrefix(ConfigEnvPrefix)
+
+	t := reflect.TypeOf(Config{})
+	for i := 0; i < t.NumField(); i++ {
+		key := t.Field(i).Tag.Get("mapstructure")
+		if key == "" {
+			continue
+		}
+
+		if err := viper.BindEnv(key); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
 func (*Config) GetBool(key string) bool {
 	return viper.GetBool(key)
 }
@@ -2,6 +2,7 @@ package raiden_test
 
 import (
 	"os"
+	"path/filepath"
 	"testing"
 
 	"github.com/ory/viper"
@@ -101,6 +102,14 @@ ACCESS_TOKEN: "test-access-token"
 	if config.ScheduleStatus != "off" {
 		t.Errorf("expected default schedule status to be 'off', got %s", config.ScheduleStatus)
 	}
+
+	if config.StateBackend != raiden.StateBackendFile {
+		t.Errorf("expected default state backend to be 'file', got %s", config.StateBackend)
+	}
+
+	if config.QueryDriver != raiden.QueryDriverPostgrest {
+		t.Errorf("expected default query driver to be 'postgrest', got %s", config.QueryDriver)
+	}
 }
 
 func TestLoadConfig_InvalidFile(t *testing.T) {
@@ -132,6 +141,60 @@ func TestLoadConfig_InvalidContent(t *testing.T) {
 	}
 }
 
+func TestLoadConfig_Profile(t *testing.T) {
+	dir := t.TempDir()
+	baseContent := `
+PROJECT_NAME: "test-project"
+SERVER_PORT: "8080"
+SUPABASE_API_URL: "http://dev-supabase-api-url"
+`
+	profileContent := `
+SUPABASE_API_URL: "http://staging-supabase-api-url"
+`
+	if err := os.WriteFile(filepath.Join(dir, "app.yaml"), []byte(baseContent), 0644); err != nil {
+		t.Fatalf("failed to write base config: %v", err)
+	}
+
+	if err := os.WriteFile(filepath.Join(dir, "app.staging.yaml"), []byte(profileContent), 0644); err != nil {
+		t.Fatalf("failed to write profile config: %v", err)
+	}
+
+	t.Setenv(raiden.ConfigEnvKey, "staging")
+	t.Setenv("RAIDEN_SERVICE_KEY", "secret-service-key")
+	t.Setenv("RAIDEN_SERVER_PORT", "9090")
+
+	path := filepath.Join(dir, "app.yaml")
+	config, err := raiden.LoadConfig(&path)
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+
+	if config.ProjectName != "test-project" {
+		t.Errorf("expected project name from base config, got %s", config.ProjectName)
+	}
+
+	if config.SupabaseApiUrl != "http://staging-supabase-api-url" {
+		t.Errorf("expected supabase api url from profile config, got %s", config.SupabaseApiUrl)
+	}
+
+	if config.ServiceKey != "secret-service-key" {
+		t.Errorf("expected service key from environment variable, got %s", config.ServiceKey)
+	}
+
+	if config.ServerPort != "9090" {
+		t.Errorf("expected server port from environment variable, got %s", config.ServerPort)
+	}
+
+	if config.Environment != "staging" {
+		t.Errorf("expected environment to be 'staging', got %s", config.Environment)
+	}
+
+	t.Setenv(raiden.ConfigEnvKey, "production")
+	if _, err := raiden.LoadConfig(&path); err == nil {
+		t.Fatalf("expected error for missing profile, got nil")
+	}
+}
+
 func TestGetBool(t *testing.T) {
 	viper.Set("TEST_BOOL", true)
 	config := &raiden.Config{}
@@ -0,0 +1,30 @@
+package raiden
+
+const (
+	DefaultExtensionSchema = "extensions"
+)
+
+type (
+	Extension interface {
+		Name() string
+		Schema() string
+		Version() string
+	}
+)
+
+// ----- base extension default function -----
+type ExtensionBase struct{}
+
+func (*ExtensionBase) Name() string {
+	return ""
+}
+
+func (*ExtensionBase) Schema() string {
+	return DefaultExtensionSchema
+}
+
+// Version return extension version,
+// empty version mean default version from database
+func (*ExtensionBase) Version() string {
+	return ""
+}
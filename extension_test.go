@@ -0,0 +1,15 @@
+package raiden_test
+
+import (
+	"testing"
+
+	"github.com/sev-2/raiden"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestExtensionBase_Default(t *testing.T) {
+	extensionBase := raiden.ExtensionBase{}
+	assert.Equal(t, "", extensionBase.Name())
+	assert.Equal(t, raiden.DefaultExtensionSchema, extensionBase.Schema())
+	assert.Equal(t, "", extensionBase.Version())
+}
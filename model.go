@@ -13,16 +13,27 @@ type (
 	}
 
 	// definition of column tag, example :
-	// column:"name:id;type:bigint;primaryKey;autoIncrement;nullable:false;unique;default:now()"
+	// column:"name:id;type:bigint;primaryKey;autoIncrement;nullable:false;unique;default:now();index"
+	// column:"name:id;type:bigint;primaryKey;autoIncrement;identity:always;nullable:false"
+	// column:"name:price;type:numeric;check:price > 0;comment:price in cents"
+	// column:"name:total;type:numeric;generated:price * quantity"
+	// column:"name:full_name;type:text;renamedFrom:name"
+	//
+	// value of check, comment and generated can't contain semicolon
 	ColumnTag struct {
-		Name          string
-		Type          string
-		PrimaryKey    bool
-		AutoIncrement bool
-		Nullable      bool
-		Default       any
-		Unique        bool
-		Index         bool
+		Name               string
+		Type               string
+		PrimaryKey         bool
+		AutoIncrement      bool
+		IdentityGeneration string
+		Nullable           bool
+		Default            any
+		Unique             bool
+		Index              bool
+		Check              string
+		Comment            string
+		Generated          string
+		RenamedFrom        string
 	}
 
 	// definition of join tag, example:
@@ -43,6 +54,65 @@ type (
 	}
 
 	RelationType string
+
+	// definition of table index, columns item can be column name
+	// with optional sort order and operator class or an expression, example :
+	//
+	//	func (m *Users) Indexes() []raiden.Index {
+	//		return []raiden.Index{
+	//			{Columns: []string{"last_name", "first_name"}},
+	//			{Name: "idx_users_email", Columns: []string{"lower(email)"}, Unique: true, Where: "deleted_at IS NULL"},
+	//			{Columns: []string{"tags"}, Method: raiden.IndexMethodGin},
+	//		}
+	//	}
+	Index struct {
+		Name    string
+		Columns []string
+		Unique  bool
+		Method  IndexMethod
+		Where   string
+	}
+
+	IndexMethod string
+
+	// IndexedModel is implemented by model that declare table level index
+	IndexedModel interface {
+		Indexes() []Index
+	}
+
+	// definition of table membership in postgres publication,
+	// empty columns mean all column is published and where is row filter, example :
+	//
+	//	func (m *Messages) Publications() []raiden.Publication {
+	//		return []raiden.Publication{
+	//			{Name: raiden.DefaultPublication, Columns: []string{"id", "room_id", "body"}, Where: "is_deleted = false"},
+	//		}
+	//	}
+	//
+	// table can also be added to default publication with metadata tag realtime:"true"
+	Publication struct {
+		Name    string
+		Columns []string
+		Where   string
+	}
+
+	// PublishedModel is implemented by model that declare publication membership
+	PublishedModel interface {
+		Publications() []Publication
+	}
+)
+
+const (
+	DefaultPublication = "supabase_realtime"
+)
+
+const (
+	IndexMethodBtree  IndexMethod = "btree"
+	IndexMethodHash   IndexMethod = "hash"
+	IndexMethodGin    IndexMethod = "gin"
+	IndexMethodGist   IndexMethod = "gist"
+	IndexMethodSpGist IndexMethod = "spgist"
+	IndexMethodBrin   IndexMethod = "brin"
 )
 
 var (
@@ -59,10 +129,12 @@ func UnmarshalColumnTag(tag string) ColumnTag {
 		Unique:        false,
 	}
 
-	tagSplit := strings.Split(tag, ";")
+	tagSplit := splitColumnTag(tag)
 	tagMap := make(map[string]string)
 	for _, c := range tagSplit {
-		cSplit := strings.Split(c, ":")
+		// split by first colon, value can contain colon
+		// for example type cast in check expression
+		cSplit := strings.SplitN(c, ":", 2)
 		if len(cSplit) == 2 {
 			tagMap[cSplit[0]] = cSplit[1]
 		} else if len(cSplit) == 1 {
@@ -93,6 +165,21 @@ func UnmarshalColumnTag(tag string) ColumnTag {
 			}
 		case "unique":
 			columnTag.Unique = true
+		case "index":
+			columnTag.Index = true
+		case "identity":
+			columnTag.AutoIncrement = true
+			if strings.EqualFold(value, "always") {
+				columnTag.IdentityGeneration = "ALWAYS"
+			}
+		case "check":
+			columnTag.Check = value
+		case "comment":
+			columnTag.Comment = value
+		case "generated":
+			columnTag.Generated = value
+		case "renamedFrom":
+			columnTag.RenamedFrom = value
 		}
 	}
 
@@ -103,6 +190,35 @@ func UnmarshalColumnTag(tag string) ColumnTag {
 	return columnTag
 }
 
+// EscapeColumnTagValue escape separator in column tag value, so value
+// like check expression or comment can contain semicolon
+func EscapeColumnTagValue(value string) string {
+	value = strings.ReplaceAll(value, `\`, `\\`)
+	return strings.ReplaceAll(value, ";", `\;`)
+}
+
+// splitColumnTag split column tag by semicolon that is not escaped
+// and unescape the value that escaped with EscapeColumnTagValue
+func splitColumnTag(tag string) (items []string) {
+	var sb strings.Builder
+	for i := 0; i < len(tag); i++ {
+		c := tag[i]
+		if c == '\\' && i+1 < len(tag) && (tag[i+1] == ';' || tag[i+1] == '\\') {
+			sb.WriteByte(tag[i+1])
+			i++
+			continue
+		}
+
+		if c == ';' {
+			items = append(items, sb.String())
+			sb.Reset()
+			continue
+		}
+		sb.WriteByte(c)
+	}
+	return append(items, sb.String())
+}
+
 func UnmarshalJoinTag(tag string) JoinTag {
 	joinTag := JoinTag{}
 
@@ -28,6 +28,37 @@ func TestUnMarshallColumnTag_IdFromSequence(t *testing.T) {
 	assert.Equal(t, false, column.Nullable)
 }
 
+func TestUnMarshallColumnTag_Index(t *testing.T) {
+	column := raiden.UnmarshalColumnTag("name:email;type:text;index")
+	assert.Equal(t, "email", column.Name)
+	assert.True(t, column.Index)
+
+	column = raiden.UnmarshalColumnTag("name:email;type:text")
+	assert.False(t, column.Index)
+}
+
+func TestUnMarshallColumnTag_CheckCommentGenerated(t *testing.T) {
+	column := raiden.UnmarshalColumnTag("name:price;type:numeric;check:(price > (0)::numeric);comment:price in cents")
+	assert.Equal(t, "(price > (0)::numeric)", column.Check)
+	assert.Equal(t, "price in cents", column.Comment)
+	assert.Empty(t, column.Generated)
+
+	column = raiden.UnmarshalColumnTag("name:total;type:numeric;generated:price * quantity")
+	assert.Equal(t, "price * quantity", column.Generated)
+
+	column = raiden.UnmarshalColumnTag("name:id;type:bigint;primaryKey;identity:always")
+	assert.True(t, column.AutoIncrement)
+	assert.Equal(t, "ALWAYS", column.IdentityGeneration)
+
+	column = raiden.UnmarshalColumnTag("name:id;type:bigint;primaryKey;autoIncrement")
+	assert.True(t, column.AutoIncrement)
+	assert.Empty(t, column.IdentityGeneration)
+
+	column = raiden.UnmarshalColumnTag("name:code;type:text;check:" + raiden.EscapeColumnTagValue(`code !~ '\\d'; length(code) > 0`) + ";unique")
+	assert.Equal(t, `code !~ '\\d'; length(code) > 0`, column.Check)
+	assert.True(t, column.Unique)
+}
+
 func TestUnMarshallJoinTag(t *testing.T) {
 	hasOneTag := `joinType:hasOne;primaryKey:id;foreignKey:scouter_id`
 	hasOne := raiden.UnmarshalJoinTag(hasOneTag)
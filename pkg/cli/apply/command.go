@@ -22,12 +22,16 @@ var ApplyLogger hclog.Logger = logger.HcLog().Named("apply")
 var buildDir = "build"
 
 type Flags struct {
-	RpcOnly       bool
-	RolesOnly     bool
-	ModelsOnly    bool
-	StoragesOnly  bool
-	AllowedSchema string
-	DryRun        bool
+	RpcOnly          bool
+	RolesOnly        bool
+	ModelsOnly       bool
+	StoragesOnly     bool
+	AllowedSchema    string
+	DryRun           bool
+	EmitMigrations   string
+	Transactional    bool
+	Output           string
+	AllowDestructive bool
 }
 
 func (f *Flags) Bind(cmd *cobra.Command) {
@@ -37,6 +41,10 @@ func (f *Flags) Bind(cmd *cobra.Command) {
 	cmd.Flags().BoolVarP(&f.StoragesOnly, "storages-only", "", false, "import storage only")
 	cmd.Flags().StringVarP(&f.AllowedSchema, "schema", "s", "", "set allowed schema to import, use coma separator for multiple schema")
 	cmd.Flags().BoolVar(&f.DryRun, "dry-run", false, "run apply in simulate mode without actual running apply change")
+	cmd.Flags().StringVar(&f.EmitMigrations, "emit-migrations", "", "write up and down sql migration file of apply change to directory")
+	cmd.Flags().BoolVar(&f.Transactional, "transactional", false, "run all apply change as single script in one transaction")
+	cmd.Flags().StringVarP(&f.Output, "output", "o", "text", "set apply report format, available format is text and json")
+	cmd.Flags().BoolVar(&f.AllowDestructive, "allow-destructive", false, "allow apply change that drop table, column or other resource that hold data or change column type that can't be casted safely")
 }
 
 func (f *Flags) LoadAll() bool {
@@ -125,6 +133,22 @@ func Run(logFlags *cli.LogFlags, flags *Flags, projectPath string) error {
 		args = append(args, "--dry-run")
 	}
 
+	if flags.EmitMigrations != "" {
+		args = append(args, "--emit-migrations="+flags.EmitMigrations)
+	}
+
+	if flags.Transactional {
+		args = append(args, "--transactional")
+	}
+
+	if flags.Output != "" {
+		args = append(args, "--output="+flags.Output)
+	}
+
+	if flags.AllowDestructive {
+		args = append(args, "--allow-destructive")
+	}
+
 	if logFlags.DebugMode {
 		args = append(args, "--debug")
 	} else if logFlags.TraceMode {
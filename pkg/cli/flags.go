@@ -1,7 +1,10 @@
 package cli
 
 import (
+	"os"
+
 	"github.com/hashicorp/go-hclog"
+	"github.com/sev-2/raiden"
 	"github.com/sev-2/raiden/pkg/logger"
 	"github.com/spf13/cobra"
 )
@@ -27,3 +30,20 @@ func (f LogFlags) CheckAndActivateDebug(cmd *cobra.Command) {
 		logger.HcLog().SetLevel(hclog.Trace)
 	}
 }
+
+// EnvFlags select configuration profile, selected profile is exported
+// as RAIDEN_ENV so binary that run by command use the same profile
+type EnvFlags struct {
+	Env string
+}
+
+func (f *EnvFlags) Bind(cmd *cobra.Command) {
+	cmd.PersistentFlags().StringVar(&f.Env, "env", "", "set configuration profile, load configs/app.<env>.yaml over configs/app.yaml")
+}
+
+func (f EnvFlags) Activate() error {
+	if f.Env == "" {
+		return nil
+	}
+	return os.Setenv(raiden.ConfigEnvKey, f.Env)
+}
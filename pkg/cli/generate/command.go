@@ -223,6 +223,34 @@ func Run(flags *Flags, config *raiden.Config, projectPath string, initialize boo
 		}
 		GenerateLogger.Debug("finish generate types register file")
 
+		// generate triggers register
+		GenerateLogger.Debug("start generate triggers register file")
+		if err := generator.GenerateTriggerRegister(projectPath, config.ProjectName, generator.Generate); err != nil {
+			errChan <- err
+		}
+		GenerateLogger.Debug("finish generate triggers register file")
+
+		// generate extensions register
+		GenerateLogger.Debug("start generate extensions register file")
+		if err := generator.GenerateExtensionRegister(projectPath, config.ProjectName, generator.Generate); err != nil {
+			errChan <- err
+		}
+		GenerateLogger.Debug("finish generate extensions register file")
+
+		// generate views register
+		GenerateLogger.Debug("start generate views register file")
+		if err := generator.GenerateViewRegister(projectPath, config.ProjectName, generator.Generate); err != nil {
+			errChan <- err
+		}
+		GenerateLogger.Debug("finish generate views register file")
+
+		// generate seeds register
+		GenerateLogger.Debug("start generate seeds register file")
+		if err := generator.GenerateSeedRegister(projectPath, config.ProjectName, generator.Generate); err != nil {
+			errChan <- err
+		}
+		GenerateLogger.Debug("finish generate seeds register file")
+
 		GenerateLogger.Debug("start generate libs register file")
 		if err := generator.GenerateLibRegister(projectPath, config.ProjectName, generator.Generate); err != nil {
 			errChan <- err
@@ -237,6 +265,13 @@ func Run(flags *Flags, config *raiden.Config, projectPath string, initialize boo
 			}
 			GenerateLogger.Debug("finish generate import main function file")
 
+			// generate seed main function
+			GenerateLogger.Debug("start generate seed main function file")
+			if err := generator.GenerateSeedMainFunction(projectPath, config, generator.Generate); err != nil {
+				errChan <- err
+			}
+			GenerateLogger.Debug("finish generate seed main function file")
+
 			// generate import main function
 			GenerateLogger.Debug("start generate apply main function file")
 			if err := generator.GenerateApplyMainFunction(projectPath, config, generator.Generate); err != nil {
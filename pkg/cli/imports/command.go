@@ -28,6 +28,8 @@ type Flags struct {
 	StoragesOnly  bool
 	AllowedSchema string
 	DryRun        bool
+	Output        string
+	Check         bool
 }
 
 func (f *Flags) Bind(cmd *cobra.Command) {
@@ -37,7 +39,8 @@ func (f *Flags) Bind(cmd *cobra.Command) {
 	cmd.Flags().BoolVarP(&f.StoragesOnly, "storages-only", "", false, "import storage only")
 	cmd.Flags().StringVarP(&f.AllowedSchema, "schema", "s", "", "set allowed schema to import, use coma separator for multiple schema")
 	cmd.Flags().BoolVar(&f.DryRun, "dry-run", false, "run import in simulate mode without actual import resource as code")
-
+	cmd.Flags().StringVarP(&f.Output, "output", "o", "text", "set import report format, available format is text and json")
+	cmd.Flags().BoolVar(&f.Check, "check", false, "check drift between database and local resource without import, exit with non zero code when drift is found")
 }
 
 func (f *Flags) LoadAll() bool {
@@ -125,6 +128,14 @@ func Run(logFlags *cli.LogFlags, flags *Flags, projectPath string) error {
 		args = append(args, "--dry-run")
 	}
 
+	if flags.Output != "" {
+		args = append(args, "--output="+flags.Output)
+	}
+
+	if flags.Check {
+		args = append(args, "--check")
+	}
+
 	if logFlags.DebugMode {
 		args = append(args, "--debug")
 	} else if logFlags.TraceMode {
@@ -0,0 +1,72 @@
+package migrations
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+
+	"github.com/hashicorp/go-hclog"
+	"github.com/sev-2/raiden"
+	"github.com/sev-2/raiden/pkg/cli/configure"
+	"github.com/sev-2/raiden/pkg/logger"
+	"github.com/sev-2/raiden/pkg/resource"
+	"github.com/spf13/cobra"
+)
+
+var MigrationLogger hclog.Logger = logger.HcLog().Named("migrations")
+
+type Flags struct {
+	AllowedSchema    string
+	DryRun           bool
+	Transactional    bool
+	AllowDestructive bool
+}
+
+func (f *Flags) Bind(cmd *cobra.Command) {
+	cmd.Flags().StringVarP(&f.AllowedSchema, "schema", "s", "", "set allowed schema to rollback, use coma separator for multiple schema")
+	cmd.Flags().BoolVar(&f.DryRun, "dry-run", false, "run rollback in simulate mode without actual running rollback change")
+	cmd.Flags().BoolVar(&f.Transactional, "transactional", false, "run all rollback change as single script in one transaction")
+	cmd.Flags().BoolVar(&f.AllowDestructive, "allow-destructive", false, "allow rollback change that drop table, column or other resource that hold data or change column type that can't be casted safely")
+}
+
+func PreRun(projectPath string) error {
+	if !configure.IsConfigExist(projectPath) {
+		return errors.New("missing config file (./configs/app.yaml), run `raiden configure` first for generate configuration file")
+	}
+
+	return nil
+}
+
+// ParseMigrationId parse migration id from command argument
+func ParseMigrationId(args []string) (int, error) {
+	if len(args) == 0 {
+		return 0, errors.New("migration id is required")
+	}
+
+	id, err := strconv.Atoi(args[0])
+	if err != nil || id <= 0 {
+		return 0, fmt.Errorf("invalid migration id %q", args[0])
+	}
+	return id, nil
+}
+
+func List(config *raiden.Config) error {
+	list, err := resource.ListMigrations(config)
+	if err != nil {
+		return err
+	}
+
+	resource.PrintMigrations(list)
+	return nil
+}
+
+func Rollback(flags *Flags, config *raiden.Config, projectPath string, id int) error {
+	f := resource.Flags{
+		ProjectPath:      projectPath,
+		AllowedSchema:    flags.AllowedSchema,
+		DryRun:           flags.DryRun,
+		Transactional:    flags.Transactional,
+		AllowDestructive: flags.AllowDestructive,
+	}
+	return resource.Rollback(&f, config, id)
+}
@@ -0,0 +1,86 @@
+package promote
+
+import (
+	"errors"
+
+	"github.com/hashicorp/go-hclog"
+	"github.com/sev-2/raiden"
+	"github.com/sev-2/raiden/pkg/cli/configure"
+	"github.com/sev-2/raiden/pkg/logger"
+	"github.com/sev-2/raiden/pkg/resource"
+	"github.com/spf13/cobra"
+)
+
+var PromoteLogger hclog.Logger = logger.HcLog().Named("promote")
+
+type Flags struct {
+	From             string
+	To               string
+	AllowedSchema    string
+	DryRun           bool
+	Transactional    bool
+	Output           string
+	AllowDestructive bool
+}
+
+func (f *Flags) Bind(cmd *cobra.Command) {
+	cmd.Flags().StringVar(&f.From, "from", "", "source configuration profile, load configs/app.<from>.yaml")
+	cmd.Flags().StringVar(&f.To, "to", "", "target configuration profile, load configs/app.<to>.yaml")
+	cmd.Flags().StringVarP(&f.AllowedSchema, "schema", "s", "", "set allowed schema to promote, use coma separator for multiple schema")
+	cmd.Flags().BoolVar(&f.DryRun, "dry-run", false, "run promote in simulate mode without actual running promote change")
+	cmd.Flags().BoolVar(&f.Transactional, "transactional", false, "run all promote change as single script in one transaction")
+	cmd.Flags().StringVarP(&f.Output, "output", "o", "text", "set promote report format, available format is text and json")
+	cmd.Flags().BoolVar(&f.AllowDestructive, "allow-destructive", false, "allow promote change that drop table, column or other resource that hold data or change column type that can't be casted safely")
+}
+
+func (f *Flags) Validate() error {
+	if f.From == "" || f.To == "" {
+		return errors.New("source and target profile is required, use --from and --to flag")
+	}
+
+	if f.From == f.To {
+		return errors.New("source and target profile must be different")
+	}
+	return nil
+}
+
+func PreRun(projectPath string) error {
+	if !configure.IsConfigExist(projectPath) {
+		return errors.New("missing config file (./configs/app.yaml), run `raiden configure` first for generate configuration file")
+	}
+
+	return nil
+}
+
+func Run(flags *Flags, projectPath string) error {
+	if err := flags.Validate(); err != nil {
+		return err
+	}
+
+	configFilePath := configure.GetConfigFilePath(projectPath)
+	PromoteLogger.Info("load source configuration", "profile", flags.From)
+	sourceConfig, err := raiden.LoadEnvConfig(&configFilePath, flags.From)
+	if err != nil {
+		return err
+	}
+
+	PromoteLogger.Info("load target configuration", "profile", flags.To)
+	targetConfig, err := raiden.LoadEnvConfig(&configFilePath, flags.To)
+	if err != nil {
+		return err
+	}
+
+	if sourceConfig.Mode != targetConfig.Mode {
+		return errors.New("source and target must use the same mode")
+	}
+
+	f := resource.Flags{
+		ProjectPath:      projectPath,
+		AllowedSchema:    flags.AllowedSchema,
+		DryRun:           flags.DryRun,
+		Transactional:    flags.Transactional,
+		Output:           flags.Output,
+		AllowDestructive: flags.AllowDestructive,
+	}
+	return resource.Promote(&f, sourceConfig, targetConfig)
+}
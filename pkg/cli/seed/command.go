@@ -0,0 +1,103 @@
+package seed
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"runtime"
+
+	"github.com/hashicorp/go-hclog"
+	"github.com/sev-2/raiden"
+	"github.com/sev-2/raiden/pkg/cli"
+	"github.com/sev-2/raiden/pkg/cli/configure"
+	"github.com/sev-2/raiden/pkg/generator"
+	"github.com/sev-2/raiden/pkg/logger"
+	"github.com/sev-2/raiden/pkg/utils"
+	"github.com/spf13/cobra"
+)
+
+var SeedLogger hclog.Logger = logger.HcLog().Named("seed")
+
+var buildDir = "build"
+
+type Flags struct {
+	DryRun bool
+	Output string
+}
+
+func (f *Flags) Bind(cmd *cobra.Command) {
+	cmd.Flags().BoolVar(&f.DryRun, "dry-run", false, "show seed report without upsert seed data")
+	cmd.Flags().StringVarP(&f.Output, "output", "o", "text", "set seed report format, available format is text and json")
+}
+
+func PreRun(projectPath string) error {
+	if !configure.IsConfigExist(projectPath) {
+		return errors.New("missing config file (./configs/app.yaml), run `raiden configure` first for generate configuration file")
+	}
+
+	return nil
+}
+
+func Run(logFlags *cli.LogFlags, flags *Flags, config *raiden.Config, projectPath string) error {
+	// seed main function is generated every run,
+	// so project that created before seed command is supported
+	if err := generator.GenerateSeedMainFunction(projectPath, config, generator.Generate); err != nil {
+		return err
+	}
+
+	mainFilePath := filepath.Join(projectPath, "cmd/seed/main.go")
+	output := GetBuildFilePath(projectPath, runtime.GOOS, "seed")
+
+	if utils.IsFileExists(output) {
+		if err := utils.DeleteFile(output); err != nil {
+			return err
+		}
+	}
+
+	// Run the "go build" command
+	SeedLogger.Debug("execute command", "cmd", fmt.Sprintf("go build -o %s %s", output, mainFilePath))
+	cmd := exec.Command("go", "build", "-o", output, mainFilePath)
+	cmd.Stdout = os.Stdout
+	cmd.Stderr = os.Stderr
+
+	if err := cmd.Run(); err != nil {
+		return fmt.Errorf("error building binary: %v", err)
+	}
+
+	var args []string
+	if flags.DryRun {
+		args = append(args, "--dry-run")
+	}
+
+	if flags.Output != "" {
+		args = append(args, "--output="+flags.Output)
+	}
+
+	if logFlags.DebugMode {
+		args = append(args, "--debug")
+	} else if logFlags.TraceMode {
+		args = append(args, "--trace")
+	}
+
+	SeedLogger.Info("start seed")
+	SeedLogger.Debug("exec binary", "path", output, "args", args)
+	runCmd := exec.Command(output, args...)
+
+	// Redirect standard input, output, and error to the current process
+	runCmd.Stdin = os.Stdin
+	runCmd.Stdout = os.Stdout
+	runCmd.Stderr = os.Stderr
+
+	return runCmd.Run()
+}
+
+func GetBuildFilePath(projectPath, targetOs string, fileName string) string {
+	fullFilePath := filepath.Join(projectPath, buildDir, fileName)
+	if targetOs == "windows" {
+		fullFilePath += ".exe"
+	}
+
+	return fullFilePath
+}
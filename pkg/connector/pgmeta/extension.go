@@ -0,0 +1,80 @@
+package pgmeta
+
+import (
+	"fmt"
+
+	"github.com/sev-2/raiden"
+	"github.com/sev-2/raiden/pkg/supabase/objects"
+	"github.com/sev-2/raiden/pkg/supabase/query"
+	"github.com/sev-2/raiden/pkg/supabase/query/sql"
+)
+
+func GetExtensions(cfg *raiden.Config) ([]objects.Extension, error) {
+	MetaLogger.Trace("start fetching extensions from meta")
+	q := sql.GenerateInstalledExtensionsQuery()
+	rs, err := ExecuteQuery[[]objects.Extension](cfg.PgMetaUrl, q, nil, DefaultAuthInterceptor(cfg.JwtToken), nil)
+	if err != nil {
+		err = fmt.Errorf("get extensions error : %s", err)
+	}
+	MetaLogger.Trace("finish fetching extensions from meta")
+	return rs, err
+}
+
+func GetExtensionByName(cfg *raiden.Config, name string) (result objects.Extension, err error) {
+	MetaLogger.Trace("start fetching single extension by name")
+	q := sql.GenerateExtensionQuery(name) + " limit 1"
+	rs, err := ExecuteQuery[[]objects.Extension](cfg.PgMetaUrl, q, nil, DefaultAuthInterceptor(cfg.JwtToken), nil)
+	if err != nil {
+		err = fmt.Errorf("get extension error : %s", err)
+		return
+	}
+
+	if len(rs) == 0 {
+		err = fmt.Errorf("get extension %s is not found", name)
+		return
+	}
+	MetaLogger.Trace("finish fetching single extension by name")
+	return rs[0], nil
+}
+
+func CreateExtension(cfg *raiden.Config, e objects.Extension) (objects.Extension, error) {
+	MetaLogger.Trace("start create extension", "name", e.Name)
+	sql, err := query.BuildExtensionQuery(query.ExtensionActionCreate, &e)
+	if err != nil {
+		return objects.Extension{}, err
+	}
+
+	_, err = ExecuteQuery[any](cfg.PgMetaUrl, sql, nil, DefaultAuthInterceptor(cfg.JwtToken), nil)
+	if err != nil {
+		return objects.Extension{}, fmt.Errorf("create new extension %s error : %s", e.Name, err)
+	}
+
+	MetaLogger.Trace("finish create extension", "name", e.Name)
+	return GetExtensionByName(cfg, e.Name)
+}
+
+func UpdateExtension(cfg *raiden.Config, e objects.Extension, updateItem objects.UpdateExtensionParam) error {
+	MetaLogger.Trace("start update extension", "name", e.Name)
+	sql := query.BuildUpdateExtensionQuery(e, updateItem)
+	_, err := ExecuteQuery[any](cfg.PgMetaUrl, sql, nil, DefaultAuthInterceptor(cfg.JwtToken), nil)
+	if err != nil {
+		return fmt.Errorf("update extension %s error : %s", e.Name, err)
+	}
+	MetaLogger.Trace("finish update extension", "name", e.Name)
+	return nil
+}
+
+func DeleteExtension(cfg *raiden.Config, e objects.Extension) error {
+	MetaLogger.Trace("start delete extension", "name", e.Name)
+	sql, err := query.BuildExtensionQuery(query.ExtensionActionDelete, &e)
+	if err != nil {
+		return err
+	}
+
+	_, err = ExecuteQuery[any](cfg.PgMetaUrl, sql, nil, DefaultAuthInterceptor(cfg.JwtToken), nil)
+	if err != nil {
+		return fmt.Errorf("delete extension %s error : %s", e.Name, err)
+	}
+	MetaLogger.Trace("finish delete extension", "name", e.Name)
+	return nil
+}
@@ -2,6 +2,8 @@ package pgmeta
 
 import (
 	"fmt"
+	"net/http"
+	"strings"
 
 	"github.com/sev-2/raiden"
 	"github.com/sev-2/raiden/pkg/client/net"
@@ -10,11 +12,20 @@ import (
 	"github.com/sev-2/raiden/pkg/supabase/query/sql"
 )
 
-func GetFunctions(cfg *raiden.Config) ([]objects.Function, error) {
+func GetFunctions(cfg *raiden.Config, includedSchemas []string) ([]objects.Function, error) {
 	MetaLogger.Trace("start fetching functions from pg meta")
 
 	url := fmt.Sprintf("%s/functions", cfg.PgMetaUrl)
-	rs, err := net.Get[[]objects.Function](url, net.DefaultTimeout, DefaultAuthInterceptor(cfg.JwtToken), nil)
+	reqInterceptor := func(req *http.Request) error {
+		if len(includedSchemas) > 0 {
+			reqQuery := req.URL.Query()
+			reqQuery.Set("included_schemas", strings.Join(includedSchemas, ","))
+			req.URL.RawQuery = reqQuery.Encode()
+		}
+		return DefaultAuthInterceptor(cfg.JwtToken)(req)
+	}
+
+	rs, err := net.Get[[]objects.Function](url, net.DefaultTimeout, reqInterceptor, nil)
 	if err != nil {
 		err = fmt.Errorf("get functions error : %s", err)
 	}
@@ -40,6 +51,23 @@ func GetFunctionByName(cfg *raiden.Config, schema, name string) (result objects.
 	return rs[0], nil
 }
 
+func GetFunctionBySignature(cfg *raiden.Config, schema, name, identityArgumentTypes string) (result objects.Function, err error) {
+	MetaLogger.Trace("start fetching single function by signature from meta")
+	sql := sql.GenerateFunctionBySignatureQuery(schema, name, identityArgumentTypes) + " limit 1"
+	rs, err := ExecuteQuery[[]objects.Function](cfg.PgMetaUrl, sql, nil, DefaultAuthInterceptor(cfg.JwtToken), nil)
+	if err != nil {
+		err = fmt.Errorf("get function error : %s", err)
+		return
+	}
+
+	if len(rs) == 0 {
+		err = fmt.Errorf("get function %s(%s) is not found", name, identityArgumentTypes)
+		return
+	}
+	MetaLogger.Trace("finish fetching single function by signature from meta")
+	return rs[0], nil
+}
+
 func CreateFunction(cfg *raiden.Config, fn objects.Function) (objects.Function, error) {
 	MetaLogger.Trace("start create function", "name", fn.Name)
 	// Execute SQL Query
@@ -54,7 +82,7 @@ func CreateFunction(cfg *raiden.Config, fn objects.Function) (objects.Function,
 	}
 
 	MetaLogger.Trace("finish create function", "name", fn.Name)
-	return GetFunctionByName(cfg, fn.Schema, fn.Name)
+	return GetFunctionBySignature(cfg, fn.Schema, fn.Name, fn.IdentityArgumentTypes)
 }
 
 func DeleteFunction(cfg *raiden.Config, fn objects.Function) error {
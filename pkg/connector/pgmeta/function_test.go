@@ -116,20 +116,23 @@ func TestGetFunctions(t *testing.T) {
 	mockedFunctionsResponse := []objects.Function{
 		mockFunctionData,
 	}
+	var includedSchemas string
 	httpmock.RegisterResponder("GET", "http://example.com/functions",
 		func(req *http.Request) (*http.Response, error) {
+			includedSchemas = req.URL.Query().Get("included_schemas")
 			return httpmock.NewJsonResponse(200, mockedFunctionsResponse)
 		},
 	)
 
 	// Call the function under test
-	result, err := pgmeta.GetFunctions(cfg)
+	result, err := pgmeta.GetFunctions(cfg, []string{"public", "api"})
 
 	// Assertions
 	assert.NoError(t, err)
 	assert.Equal(t, 1, len(result))
 	assert.Equal(t, "get_next_checkout_id", result[0].Name)
 	assert.Equal(t, "public", result[0].Schema)
+	assert.Equal(t, "public,api", includedSchemas)
 }
 
 func TestGetFunctions_Empty(t *testing.T) {
@@ -150,7 +153,7 @@ func TestGetFunctions_Empty(t *testing.T) {
 	)
 
 	// Call the function under test
-	result, err := pgmeta.GetFunctions(cfg)
+	result, err := pgmeta.GetFunctions(cfg, []string{"public"})
 
 	// Assertions
 	assert.Error(t, err)
@@ -175,7 +178,7 @@ func TestGetFunctions_Err(t *testing.T) {
 	)
 
 	// Call the function under test
-	result, err := pgmeta.GetFunctions(cfg)
+	result, err := pgmeta.GetFunctions(cfg, []string{"public"})
 
 	// Assertions
 	assert.Error(t, err)
@@ -232,6 +235,35 @@ func TestGetFunctionsByName_Empty(t *testing.T) {
 	assert.Equal(t, result.Name, "")
 }
 
+func TestGetFunctionBySignature(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	cfg := &raiden.Config{
+		PgMetaUrl: "http://example.com",
+		ProjectId: "test_project",
+		JwtToken:  "meta token",
+	}
+
+	overloadFunction := mockFunctionData
+	overloadFunction.IdentityArgumentTypes = "in_id integer"
+
+	httpmock.RegisterResponder("POST", "http://example.com/query",
+		func(req *http.Request) (*http.Response, error) {
+			var payload pgmeta.ExecuteQueryParam
+			if err := json.NewDecoder(req.Body).Decode(&payload); err != nil {
+				return httpmock.NewStringResponse(400, "Bad Request"), nil
+			}
+			assert.Contains(t, payload.Query, "pg_get_function_identity_arguments(f.oid) = 'in_id integer'")
+			return httpmock.NewJsonResponse(200, []objects.Function{overloadFunction})
+		},
+	)
+
+	result, err := pgmeta.GetFunctionBySignature(cfg, "public", overloadFunction.Name, "in_id integer")
+	assert.NoError(t, err)
+	assert.Equal(t, "in_id integer", result.IdentityArgumentTypes)
+}
+
 func TestUpdateFunction(t *testing.T) {
 	httpmock.Activate()
 	defer httpmock.DeactivateAndReset()
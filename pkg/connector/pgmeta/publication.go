@@ -0,0 +1,41 @@
+package pgmeta
+
+import (
+	"fmt"
+
+	"github.com/sev-2/raiden"
+	"github.com/sev-2/raiden/pkg/supabase/objects"
+	"github.com/sev-2/raiden/pkg/supabase/query"
+	"github.com/sev-2/raiden/pkg/supabase/query/sql"
+)
+
+func GetPublications(cfg *raiden.Config) ([]objects.Publication, error) {
+	MetaLogger.Trace("start fetching publications from meta")
+	rs, err := ExecuteQuery[[]objects.Publication](cfg.PgMetaUrl, sql.GetPublicationsQuery, nil, DefaultAuthInterceptor(cfg.JwtToken), nil)
+	if err != nil {
+		err = fmt.Errorf("get publications error : %s", err)
+		return []objects.Publication{}, err
+	}
+	MetaLogger.Trace("finish fetching publications from meta")
+	return rs, nil
+}
+
+func updateTablePublications(cfg *raiden.Config, table objects.Table, items []objects.UpdatePublicationItem) error {
+	MetaLogger.Trace("start update table publication")
+	var publicationSql string
+	for i := range items {
+		item := items[i]
+		itemSql, err := query.BuildTablePublicationQuery(item.Type, table.Schema, table.Name, &item.Data)
+		if err != nil {
+			return err
+		}
+		publicationSql += itemSql
+	}
+
+	_, err := ExecuteQuery[any](cfg.PgMetaUrl, publicationSql, nil, DefaultAuthInterceptor(cfg.JwtToken), nil)
+	if err != nil {
+		return fmt.Errorf("update table publication error : %s", err)
+	}
+	MetaLogger.Trace("finish update table publication")
+	return nil
+}
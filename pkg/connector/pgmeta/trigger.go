@@ -0,0 +1,97 @@
+package pgmeta
+
+import (
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/sev-2/raiden"
+	"github.com/sev-2/raiden/pkg/client/net"
+	"github.com/sev-2/raiden/pkg/supabase/objects"
+	"github.com/sev-2/raiden/pkg/supabase/query"
+	"github.com/sev-2/raiden/pkg/supabase/query/sql"
+)
+
+func GetTriggers(cfg *raiden.Config, includedSchemas []string) ([]objects.Trigger, error) {
+	MetaLogger.Trace("start fetching triggers from meta")
+	url := fmt.Sprintf("%s/triggers", cfg.PgMetaUrl)
+	reqInterceptor := func(req *http.Request) error {
+		if len(includedSchemas) > 0 {
+			reqQuery := req.URL.Query()
+			reqQuery.Set("included_schemas", strings.Join(includedSchemas, ","))
+			req.URL.RawQuery = reqQuery.Encode()
+		}
+
+		if len(cfg.JwtToken) > 0 {
+			req.Header.Set("Authorization", fmt.Sprintf("Bearer %s", cfg.JwtToken))
+		}
+
+		return nil
+	}
+
+	rs, err := net.Get[[]objects.Trigger](url, net.DefaultTimeout, reqInterceptor, nil)
+	if err != nil {
+		err = fmt.Errorf("get triggers error : %s", err)
+	}
+	MetaLogger.Trace("finish fetching triggers from meta")
+	return rs, err
+}
+
+func GetTriggerByName(cfg *raiden.Config, schema, table, name string) (result objects.Trigger, err error) {
+	MetaLogger.Trace("start fetching trigger by name from meta")
+	q := sql.GenerateTriggerQuery(schema, table, name) + " limit 1"
+	rs, err := ExecuteQuery[[]objects.Trigger](cfg.PgMetaUrl, q, nil, DefaultAuthInterceptor(cfg.JwtToken), nil)
+	if err != nil {
+		err = fmt.Errorf("get trigger error : %s", err)
+		return
+	}
+
+	if len(rs) == 0 {
+		err = fmt.Errorf("get trigger %s on table %s is not found", name, table)
+		return
+	}
+	MetaLogger.Trace("finish fetching trigger by name from meta")
+	return rs[0], nil
+}
+
+func CreateTrigger(cfg *raiden.Config, t objects.Trigger) (objects.Trigger, error) {
+	MetaLogger.Trace("start create trigger", "name", t.Name, "table", t.Table)
+	sql, err := query.BuildTriggerQuery(query.TriggerActionCreate, &t)
+	if err != nil {
+		return objects.Trigger{}, err
+	}
+
+	_, err = ExecuteQuery[any](cfg.PgMetaUrl, sql, nil, DefaultAuthInterceptor(cfg.JwtToken), nil)
+	if err != nil {
+		return objects.Trigger{}, fmt.Errorf("create new trigger %s error : %s", t.Name, err)
+	}
+
+	MetaLogger.Trace("finish create trigger", "name", t.Name, "table", t.Table)
+	return GetTriggerByName(cfg, t.Schema, t.Table, t.Name)
+}
+
+func UpdateTrigger(cfg *raiden.Config, t objects.Trigger, updateItem objects.UpdateTriggerParam) error {
+	MetaLogger.Trace("start update trigger", "name", t.Name, "table", t.Table)
+	sql := query.BuildUpdateTriggerQuery(t, updateItem)
+	_, err := ExecuteQuery[any](cfg.PgMetaUrl, sql, nil, DefaultAuthInterceptor(cfg.JwtToken), nil)
+	if err != nil {
+		return fmt.Errorf("update trigger %s error : %s", t.Name, err)
+	}
+	MetaLogger.Trace("finish update trigger", "name", t.Name, "table", t.Table)
+	return nil
+}
+
+func DeleteTrigger(cfg *raiden.Config, t objects.Trigger) error {
+	MetaLogger.Trace("start delete trigger", "name", t.Name, "table", t.Table)
+	sql, err := query.BuildTriggerQuery(query.TriggerActionDelete, &t)
+	if err != nil {
+		return err
+	}
+
+	_, err = ExecuteQuery[any](cfg.PgMetaUrl, sql, nil, DefaultAuthInterceptor(cfg.JwtToken), nil)
+	if err != nil {
+		return fmt.Errorf("delete trigger %s error : %s", t.Name, err)
+	}
+	MetaLogger.Trace("finish delete trigger", "name", t.Name, "table", t.Table)
+	return nil
+}
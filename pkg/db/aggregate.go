@@ -30,6 +30,11 @@ func (q *Query) Count(opts ...CountOptions) (int, error) {
 		}
 	}
 
+	// postgres driver always return exact count
+	if usePostgresDriver() {
+		return q.postgresCount()
+	}
+
 	url := q.GetUrl()
 
 	headers := make(map[string]string)
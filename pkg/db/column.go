@@ -0,0 +1,170 @@
+package db
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+	"time"
+)
+
+// Column is column descriptor of model T with value type V, descriptor is generated
+// with the model so wrong column name or value type fail at compile time. nullable
+// column use pointer value type so nil value is filtered with is null
+type Column[T any, V any] struct {
+	name string
+}
+
+// StringColumn is column descriptor of text column, pattern
+// filter is only available for text column
+type StringColumn[T any, V interface{ string | *string }] struct {
+	Column[T, V]
+}
+
+// ModelColumn is column of model T regardless of the value type
+type ModelColumn[T any] interface {
+	Name() string
+	modelColumn(T)
+}
+
+// Filter is where condition of model T
+type Filter[T any] struct {
+	condition Condition
+}
+
+func NewColumn[T any, V any](name string) Column[T, V] {
+	return Column[T, V]{name: name}
+}
+
+func NewStringColumn[T any, V interface{ string | *string }](name string) StringColumn[T, V] {
+	return StringColumn[T, V]{Column: NewColumn[T, V](name)}
+}
+
+func (c Column[T, V]) Name() string {
+	return c.name
+}
+
+// modelColumn bind column to model T, so column of other model can not be used
+func (c Column[T, V]) modelColumn(T) {}
+
+// Eq filter column that equal to value, nil value of nullable column is filtered with is null
+func (c Column[T, V]) Eq(value V) Filter[T] {
+	if isNilColumnValue(value) {
+		return c.IsNull()
+	}
+	return c.filter("eq", formatColumnValue(value), formatColumnValue(value))
+}
+
+// Neq filter column that not equal to value, nil value of nullable column is filtered with not is null
+func (c Column[T, V]) Neq(value V) Filter[T] {
+	if isNilColumnValue(value) {
+		return c.IsNotNull()
+	}
+	return c.filter("neq", formatColumnValue(value), formatColumnValue(value))
+}
+
+func (c Column[T, V]) Lt(value V) Filter[T] {
+	return c.filter("lt", formatColumnValue(value), formatColumnValue(value))
+}
+
+func (c Column[T, V]) Lte(value V) Filter[T] {
+	return c.filter("lte", formatColumnValue(value), formatColumnValue(value))
+}
+
+func (c Column[T, V]) Gt(value V) Filter[T] {
+	return c.filter("gt", formatColumnValue(value), formatColumnValue(value))
+}
+
+func (c Column[T, V]) Gte(value V) Filter[T] {
+	return c.filter("gte", formatColumnValue(value), formatColumnValue(value))
+}
+
+func (c Column[T, V]) In(values ...V) Filter[T] {
+	args, strValues := make([]string, 0, len(values)), make([]string, 0, len(values))
+	for _, v := range values {
+		args = append(args, formatColumnValue(v))
+		strValues = append(strValues, quoteColumnValue(formatColumnValue(v)))
+	}
+	return c.filter("in", fmt.Sprintf("(%s)", strings.Join(strValues, ",")), args)
+}
+
+func (c Column[T, V]) IsNull() Filter[T] {
+	return c.filter("is", "null", nil)
+}
+
+func (c Column[T, V]) IsNotNull() Filter[T] {
+	return c.filter("not.is", "null", nil)
+}
+
+// Like filter column with pattern, % is used as wildcard
+func (c StringColumn[T, V]) Like(pattern string) Filter[T] {
+	return c.filter("like", getStringWithSpace(strings.ReplaceAll(pattern, "%", "*")), pattern)
+}
+
+// Ilike filter column with case insensitive pattern, % is used as wildcard
+func (c StringColumn[T, V]) Ilike(pattern string) Filter[T] {
+	return c.filter("ilike", getStringWithSpace(strings.ReplaceAll(pattern, "%", "*")), pattern)
+}
+
+func (c Column[T, V]) filter(operator string, value string, arg any) Filter[T] {
+	return Filter[T]{condition: newCondition(c.name, operator, value, arg)}
+}
+
+// Not negate the filter, for example not.eq
+func (f Filter[T]) Not() Filter[T] {
+	return Filter[T]{condition: Not(f.condition)}
+}
+
+// andQuery return filter as query param, for example email=eq.john@mail.com
+func (f Filter[T]) andQuery() string {
+	return f.condition.param()
+}
+
+// orQuery return filter as item of or param, for example email.eq.john@mail.com
+func (f Filter[T]) orQuery() string {
+	return f.condition.item()
+}
+
+// isNilColumnValue return true when value of nullable column is nil
+func isNilColumnValue(value any) bool {
+	rv := reflect.ValueOf(value)
+	return !rv.IsValid() || (rv.Kind() == reflect.Ptr && rv.IsNil())
+}
+
+// formatColumnValue convert value to filter value, time is formatted
+// in utc so the value does not contain + that is decoded as space.
+// value of nullable column is dereferenced
+func formatColumnValue(value any) string {
+	if rv := reflect.ValueOf(value); rv.Kind() == reflect.Ptr {
+		if rv.IsNil() {
+			return "null"
+		}
+
+		if _, isStringer := value.(fmt.Stringer); !isStringer || rv.Elem().Type() == reflect.TypeOf(time.Time{}) {
+			return formatColumnValue(rv.Elem().Interface())
+		}
+	}
+
+	switch v := value.(type) {
+	case time.Time:
+		return v.UTC().Format(time.RFC3339Nano)
+	case fmt.Stringer:
+		return v.String()
+	default:
+		return getStringValue(value)
+	}
+}
+
+// quoteColumnValue quote value of in filter that contain reserved character
+func quoteColumnValue(value string) string {
+	if strings.ContainsAny(value, ",()\"") {
+		return quoteFilterValue(value)
+	}
+	return value
+}
+
+// quoteFilterValue quote value with double quote, so reserved character
+// of the value such as , and ) is not parsed as part of the group
+func quoteFilterValue(value string) string {
+	value = strings.ReplaceAll(strings.ReplaceAll(value, `\`, `\\`), `"`, `\"`)
+	return fmt.Sprintf(`"%s"`, value)
+}
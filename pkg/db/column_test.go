@@ -0,0 +1,61 @@
+package db
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+var articleMockCols = struct {
+	Id         Column[ArticleMockModel, int64]
+	Title      StringColumn[ArticleMockModel, string]
+	Rating     Column[ArticleMockModel, *int64]
+	IsFeatured Column[ArticleMockModel, bool]
+	CreatedAt  Column[ArticleMockModel, time.Time]
+	Body       StringColumn[ArticleMockModel, *string]
+}{
+	Id:         NewColumn[ArticleMockModel, int64]("id"),
+	Title:      NewStringColumn[ArticleMockModel, string]("title"),
+	Rating:     NewColumn[ArticleMockModel, *int64]("rating"),
+	IsFeatured: NewColumn[ArticleMockModel, bool]("is_featured"),
+	CreatedAt:  NewColumn[ArticleMockModel, time.Time]("created_at"),
+	Body:       NewStringColumn[ArticleMockModel, *string]("body"),
+}
+
+func TestColumnFilter(t *testing.T) {
+	createdAt := time.Date(2024, 1, 2, 10, 0, 0, 0, time.FixedZone("WIB", 7*3600))
+
+	assert.Equal(t, "id=eq.1", articleMockCols.Id.Eq(1).andQuery())
+	assert.Equal(t, "id=neq.1", articleMockCols.Id.Neq(1).andQuery())
+	assert.Equal(t, "id=lt.1", articleMockCols.Id.Lt(1).andQuery())
+	assert.Equal(t, "id=lte.1", articleMockCols.Id.Lte(1).andQuery())
+	assert.Equal(t, "id=gt.1", articleMockCols.Id.Gt(1).andQuery())
+	assert.Equal(t, "id=gte.1", articleMockCols.Id.Gte(1).andQuery())
+	assert.Equal(t, "id=in.(1,2,3)", articleMockCols.Id.In(1, 2, 3).andQuery())
+	assert.Equal(t, `title=in.(hello,"hello, world")`, articleMockCols.Title.In("hello", "hello, world").andQuery())
+	assert.Equal(t, "title=like.*hello%20world*", articleMockCols.Title.Like("%hello world%").andQuery())
+	assert.Equal(t, "title=ilike.*hello*", articleMockCols.Title.Ilike("%hello%").andQuery())
+	assert.Equal(t, "body=is.null", articleMockCols.Body.IsNull().andQuery())
+	assert.Equal(t, "body=not.is.null", articleMockCols.Body.IsNotNull().andQuery())
+	assert.Equal(t, "is_featured=not.eq.true", articleMockCols.IsFeatured.Eq(true).Not().andQuery())
+	assert.Equal(t, "body=is.null", articleMockCols.Body.IsNotNull().Not().andQuery())
+	assert.Equal(t, "created_at=gt.2024-01-02T03:00:00Z", articleMockCols.CreatedAt.Gt(createdAt).andQuery())
+	assert.Equal(t, "title.eq.hello", articleMockCols.Title.Eq("hello").orQuery())
+}
+
+func TestColumnFilter_Nullable(t *testing.T) {
+	body, rating := "hello", int64(0)
+	createdAt := time.Date(2024, 1, 2, 10, 0, 0, 0, time.FixedZone("WIB", 7*3600))
+
+	assert.Equal(t, "body=eq.hello", articleMockCols.Body.Eq(&body).andQuery())
+	assert.Equal(t, "body=is.null", articleMockCols.Body.Eq(nil).andQuery())
+	assert.Equal(t, "body=not.is.null", articleMockCols.Body.Neq(nil).andQuery())
+	assert.Equal(t, "body=like.*hello*", articleMockCols.Body.Like("%hello%").andQuery())
+	assert.Equal(t, "rating=eq.0", articleMockCols.Rating.Eq(&rating).andQuery())
+	assert.Equal(t, "rating=in.(0)", articleMockCols.Rating.In(&rating).andQuery())
+	assert.Equal(t, "created_at=gt.2024-01-02T03:00:00Z", NewColumn[ArticleMockModel, *time.Time]("created_at").Gt(&createdAt).andQuery())
+
+	c := articleMockCols.Rating.Eq(&rating).condition
+	assert.Equal(t, "0", c.arg)
+}
@@ -0,0 +1,226 @@
+package db
+
+import (
+	"fmt"
+	"strings"
+)
+
+// Condition is filter that can be nested with And and Or, for example
+// (a = 1 and (b > 2 or c < 3)) or d = 4 is written as :
+//
+//	db.Or(db.And(db.Eq("a", 1), db.Or(db.Gt("b", 2), db.Lt("c", 3))), db.Eq("d", 4))
+type Condition struct {
+	column     string
+	operator   string
+	value      string
+	scalar     bool
+	literal    bool
+	arg        any
+	config     string
+	group      string
+	conditions []Condition
+	negate     bool
+	relation   string
+}
+
+// Where add condition that all of them must be matched
+func (q *Query) Where(conditions ...Condition) *Query {
+	return q.WhereRelation("", conditions...)
+}
+
+// WhereRelation add condition to embedded resource, column of the condition
+// is column of the relation, for example relation.column=eq.x or relation.or=(...)
+func (q *Query) WhereRelation(relation string, conditions ...Condition) *Query {
+	if q.WhereAndList == nil {
+		q.WhereAndList = &[]string{}
+	}
+
+	prefix := ""
+	if relation != "" {
+		prefix = relation + "."
+	}
+
+	for _, c := range conditions {
+		*q.WhereAndList = append(*q.WhereAndList, prefix+c.param())
+
+		c.relation = relation
+		q.andConditions = append(q.andConditions, c)
+	}
+	return q
+}
+
+// And group condition that all of them must be matched
+func And(conditions ...Condition) Condition {
+	return Condition{group: "and", conditions: conditions}
+}
+
+// Or group condition that one of them must be matched
+func Or(conditions ...Condition) Condition {
+	return Condition{group: "or", conditions: conditions}
+}
+
+// Not negate condition or group of condition
+func Not(c Condition) Condition {
+	if c.group != "" {
+		c.negate = !c.negate
+		return c
+	}
+
+	if strings.HasPrefix(c.operator, "not.") {
+		c.operator = strings.TrimPrefix(c.operator, "not.")
+		return c
+	}
+	c.operator = "not." + c.operator
+	return c
+}
+
+func Eq(column string, value any) Condition {
+	return newScalarCondition(column, "eq", formatColumnValue(value), value)
+}
+
+func Neq(column string, value any) Condition {
+	return newScalarCondition(column, "neq", formatColumnValue(value), value)
+}
+
+func Lt(column string, value any) Condition {
+	return newScalarCondition(column, "lt", formatColumnValue(value), value)
+}
+
+func Lte(column string, value any) Condition {
+	return newScalarCondition(column, "lte", formatColumnValue(value), value)
+}
+
+func Gt(column string, value any) Condition {
+	return newScalarCondition(column, "gt", formatColumnValue(value), value)
+}
+
+func Gte(column string, value any) Condition {
+	return newScalarCondition(column, "gte", formatColumnValue(value), value)
+}
+
+// Like filter column with pattern, % is used as wildcard
+func Like(column string, pattern string) Condition {
+	return newScalarCondition(column, "like", getStringWithSpace(strings.ReplaceAll(pattern, "%", "*")), pattern)
+}
+
+// Ilike filter column with case insensitive pattern, % is used as wildcard
+func Ilike(column string, pattern string) Condition {
+	return newScalarCondition(column, "ilike", getStringWithSpace(strings.ReplaceAll(pattern, "%", "*")), pattern)
+}
+
+func Match(column string, pattern string) Condition {
+	return newScalarCondition(column, "match", escapeFilterValue(pattern), pattern)
+}
+
+func Imatch(column string, pattern string) Condition {
+	return newScalarCondition(column, "imatch", escapeFilterValue(pattern), pattern)
+}
+
+func Is(column string, value any) Condition {
+	if getWhitelistIsValue(value) == "" {
+		panic("getWhitelistIsValue: only \"true\", \"false\", \"nil\", \"null\", or \"unknown\" are allowed")
+	}
+	return newCondition(column, "is", getWhitelistIsValue(value), nil)
+}
+
+// In filter column with one of the value, value must be slice
+func In(column string, value any) Condition {
+	values := SliceToStringSlice(value)
+	for i := range values {
+		values[i] = quoteColumnValue(values[i])
+	}
+	return newCondition(column, "in", fmt.Sprintf("(%s)", strings.Join(values, ",")), value)
+}
+
+// Fts filter tsvector column with to_tsquery, empty config use database default
+func Fts(column string, value string, config string) Condition {
+	return newFtsCondition(column, "fts", value, config)
+}
+
+// Cs filter array, range or json column that contains value
+func Cs(column string, value any) Condition {
+	return newArrayOrRangeCondition(column, "cs", value)
+}
+
+// Cd filter array, range or json column that contained in value
+func Cd(column string, value any) Condition {
+	return newArrayOrRangeCondition(column, "cd", value)
+}
+
+// Ov filter array or range column that overlap with value
+func Ov(column string, value any) Condition {
+	return newArrayOrRangeCondition(column, "ov", value)
+}
+
+// newCondition create condition, value is encoded value for filter param
+// and arg is original value that is used as parameter of sql query
+func newCondition(column string, operator string, value string, arg any) Condition {
+	return Condition{column: column, operator: operator, value: value, arg: arg}
+}
+
+// newFtsCondition create full text search condition, operator is fts, plfts, phfts or wfts
+func newFtsCondition(column string, operator string, value string, config string) Condition {
+	c := newScalarCondition(column, getFtsOperator(operator, config), escapeFilterValue(value), value)
+	c.config = config
+	return c
+}
+
+// newArrayOrRangeCondition create condition of array or range operator, value is sent
+// as postgres literal in sql query and always quoted when it is used in group
+func newArrayOrRangeCondition(column string, operator string, value any) Condition {
+	c := newCondition(column, operator, getArrayOrRangeValue(value), getArrayOrRangeLiteral(value))
+	c.literal = true
+	return c
+}
+
+// newDistinctCondition create is distinct from condition, nil value is compared with null
+func newDistinctCondition(column string, value any) Condition {
+	if value == nil {
+		return newCondition(column, "isdistinct", getDistinctValue(value), nil)
+	}
+	return newScalarCondition(column, "isdistinct", getDistinctValue(value), formatColumnValue(value))
+}
+
+// newScalarCondition create condition with single value, the value is quoted
+// when it is used in group and contain reserved character of the group
+func newScalarCondition(column string, operator string, value string, arg any) Condition {
+	return Condition{column: column, operator: operator, value: value, scalar: true, arg: arg}
+}
+
+// param return condition as query param, for example a=eq.1 or or=(a.eq.1,b.eq.2)
+func (c Condition) param() string {
+	if c.group == "" {
+		return fmt.Sprintf("%s=%s.%s", c.column, c.operator, c.value)
+	}
+	return fmt.Sprintf("%s=(%s)", c.groupOperator(), c.items())
+}
+
+// item return condition as item of group, for example a.eq.1 or and(a.eq.1,b.eq.2)
+func (c Condition) item() string {
+	if c.group == "" {
+		value := c.value
+		switch {
+		case c.scalar:
+			value = quoteColumnValue(value)
+		case c.literal:
+			value = quoteFilterValue(value)
+		}
+		return fmt.Sprintf("%s.%s.%s", c.column, c.operator, value)
+	}
+	return fmt.Sprintf("%s(%s)", c.groupOperator(), c.items())
+}
+
+func (c Condition) groupOperator() string {
+	if c.negate {
+		return "not." + c.group
+	}
+	return c.group
+}
+
+func (c Condition) items() string {
+	items := make([]string, 0, len(c.conditions))
+	for _, child := range c.conditions {
+		items = append(items, child.item())
+	}
+	return strings.Join(items, ",")
+}
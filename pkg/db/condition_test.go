@@ -0,0 +1,81 @@
+package db
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestWhereCondition(t *testing.T) {
+	t.Run("single condition", func(t *testing.T) {
+		q := NewQuery(&mockRaidenContext).Model(articleMockModel).Where(Eq("id", 1), Not(Like("title", "%supa%")))
+		assert.Equalf(t, "articles?select=*&id=eq.1&title=not.like.*supa*", buildQueryURI(*q), "the url should match")
+	})
+
+	t.Run("nested group", func(t *testing.T) {
+		q := NewQuery(&mockRaidenContext).
+			Model(articleMockModel).
+			Where(Or(Eq("a", 1), And(Gt("b", 2), Lt("c", 3))))
+
+		assert.Equalf(t, "articles?select=*&or=(a.eq.1,and(b.gt.2,c.lt.3))", buildQueryURI(*q), "the url should match")
+	})
+
+	t.Run("group of group", func(t *testing.T) {
+		q := NewQuery(&mockRaidenContext).
+			Model(articleMockModel).
+			Where(Or(And(Eq("a", 1), Or(Gte("b", 2), Lte("c", 3))), Eq("d", 4)))
+
+		assert.Equalf(t, "articles?select=*&or=(and(a.eq.1,or(b.gte.2,c.lte.3)),d.eq.4)", buildQueryURI(*q), "the url should match")
+	})
+
+	t.Run("negated group", func(t *testing.T) {
+		q := NewQuery(&mockRaidenContext).
+			Model(articleMockModel).
+			Where(Not(And(Eq("a", 1), Not(Or(Neq("b", 2), Is("c", nil))))))
+
+		assert.Equalf(t, "articles?select=*&not.and=(a.eq.1,not.or(b.neq.2,c.is.null))", buildQueryURI(*q), "the url should match")
+	})
+
+	t.Run("quote value in group", func(t *testing.T) {
+		q := NewQuery(&mockRaidenContext).
+			Model(articleMockModel).
+			Where(Eq("title", "a,b"), Or(Eq("title", "a,b"), In("id", []int{1, 2}), Cs("tags", []string{"x", "y"})))
+
+		assert.Equalf(t, "articles?select=*&title=eq.a,b&or=(title.eq.\"a,b\",id.in.(1,2),tags.cs.\"{x,y}\")", buildQueryURI(*q), "the url should match")
+	})
+
+	t.Run("range in group", func(t *testing.T) {
+		r := Range{Lower: 1, Upper: 10, LowerInclusive: true}
+		q := NewQuery(&mockRaidenContext).
+			Model(articleMockModel).
+			Where(Ov("during", r), Or(Ov("during", r), And(Cd("during", Range{Lower: 1, Upper: 10}), Not(Cs("tags", []string{"a", "b"})))))
+
+		assert.Equalf(t, `articles?select=*&during=ov.[1,10)&or=(during.ov."[1,10)",and(during.cd."(1,10)",tags.not.cs."{a,b}"))`, buildQueryURI(*q), "the url should match")
+	})
+
+	t.Run("distinct in group", func(t *testing.T) {
+		q := NewQuery(&mockRaidenContext).Model(articleMockModel).OrIsDistinct("title", "a,b").OrIsDistinct("body", nil)
+		assert.Equalf(t, `articles?select=*&or=(title.isdistinct."a,b",body.isdistinct.null)`, buildQueryURI(*q), "the url should match")
+	})
+
+	t.Run("combine with existing filter", func(t *testing.T) {
+		q := NewQuery(&mockRaidenContext).
+			Model(articleMockModel).
+			Eq("id", 1).
+			Where(Or(Ilike("title", "%go%"), Fts("body", "go", "english")))
+
+		assert.Equalf(t, "articles?select=*&id=eq.1&or=(title.ilike.*go*,body.fts(english).go)", buildQueryURI(*q), "the url should match")
+	})
+
+	t.Run("embedded resource", func(t *testing.T) {
+		q := NewQuery(&mockRaidenContext).
+			Model(articleMockModel).
+			WhereRelation("users", Eq("name", "john"), Or(Eq("role", "admin"), Not(Eq("active", false))))
+
+		assert.Equalf(t, "articles?select=*&users.name=eq.john&users.or=(role.eq.admin,active.not.eq.false)", buildQueryURI(*q), "the url should match")
+	})
+
+	t.Run("invalid is value", func(t *testing.T) {
+		assert.Panics(t, func() { Is("is_featured", "yes") })
+	})
+}
@@ -29,6 +29,11 @@ type Query struct {
 	Errors       []error
 	ByPass       bool
 	credential   Credential
+
+	// andConditions and orConditions is structured filter of filter list,
+	// postgres driver compile it to sql so filter value is not parsed again
+	andConditions []Condition
+	orConditions  []Condition
 }
 
 type ModelBase struct {
@@ -89,6 +94,9 @@ func (m *ModelBase) Execute() (model *ModelBase) {
 }
 
 func (q Query) Get(collection interface{}) error {
+	if usePostgresDriver() {
+		return q.postgresGet(collection)
+	}
 
 	url := q.GetUrl()
 
@@ -105,6 +113,10 @@ func (q Query) Get(collection interface{}) error {
 }
 
 func (q Query) Single(model interface{}) error {
+	if usePostgresDriver() {
+		return q.Limit(1).postgresSingle(model)
+	}
+
 	url := q.Limit(1).GetUrl()
 
 	headers := make(map[string]string)
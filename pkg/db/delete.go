@@ -5,6 +5,10 @@ import (
 )
 
 func (q *Query) Delete() error {
+	if usePostgresDriver() {
+		return q.postgresDelete()
+	}
+
 	url := q.GetUrl()
 
 	headers := make(map[string]string)
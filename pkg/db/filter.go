@@ -0,0 +1,28 @@
+package db
+
+import (
+	"errors"
+)
+
+// getQueryConditions return structured filter of query, the filter is kept when it is
+// added to filter list so value is sent as query parameter without parsing the filter param
+func (q Query) getQueryConditions() ([]Condition, error) {
+	if len(q.andConditions) != getFilterListLen(q.WhereAndList)+getFilterListLen(q.IsList) ||
+		len(q.orConditions) != getFilterListLen(q.WhereOrList) {
+		return nil, errors.New("filter that is added directly to filter list is not supported by postgres driver")
+	}
+
+	conditions := make([]Condition, 0, len(q.andConditions)+1)
+	conditions = append(conditions, q.andConditions...)
+	if len(q.orConditions) > 0 {
+		conditions = append(conditions, Or(q.orConditions...))
+	}
+	return conditions, nil
+}
+
+func getFilterListLen(list *[]string) int {
+	if list == nil {
+		return 0
+	}
+	return len(*list)
+}
@@ -0,0 +1,61 @@
+package db
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestQueryConditions(t *testing.T) {
+	q := NewQuery(&mockRaidenContext).
+		Model(articleMockModel).
+		Neq("id", 1).
+		Sl("during", Range{Lower: 1, Upper: 10, LowerInclusive: true}).
+		OrLike("title", "%a%").
+		OrIsDistinct("rating", 2)
+
+	conditions, err := q.getQueryConditions()
+	assert.NoError(t, err)
+	assert.Len(t, conditions, 3)
+
+	var args []any
+	clause, err := And(conditions...).sql("t", &args)
+	assert.NoError(t, err)
+	assert.Equal(t, `(t."id" <> $1 and t."during" << $2 and (t."title" like $3 or t."rating" is distinct from $4))`, clause)
+	assert.Equal(t, []any{"1", "[1,10)", "%a%", "2"}, args)
+}
+
+func TestQueryConditions_ReservedCharacter(t *testing.T) {
+	q := NewQuery(&mockRaidenContext).
+		Model(articleMockModel).
+		In("title", []string{"a,b", "(c)", "d.e"}).
+		Wfts("body", "fat & (rat)", "english").
+		OrEq("title", "x,y").
+		OrIlike("title", "%1.5 (beta)%")
+
+	conditions, err := q.getQueryConditions()
+	assert.NoError(t, err)
+
+	var args []any
+	clause, err := And(conditions...).sql("t", &args)
+	assert.NoError(t, err)
+	assert.Equal(t, `(t."title" = any($1) and t."body" @@ websearch_to_tsquery($2::regconfig, $3) and (t."title" = $4 or t."title" ilike $5))`, clause)
+	assert.Len(t, args, 5)
+	assert.Equal(t, []any{"english", "fat & (rat)", "x,y", "%1.5 (beta)%"}, args[1:])
+}
+
+func TestQueryConditions_Unsupported(t *testing.T) {
+	q := NewQuery(&mockRaidenContext).Model(articleMockModel).Eq("id", 1)
+	*q.WhereAndList = append(*q.WhereAndList, "title=eq.a")
+
+	_, err := q.getQueryConditions()
+	assert.EqualError(t, err, "filter that is added directly to filter list is not supported by postgres driver")
+
+	q = NewQuery(&mockRaidenContext).Model(articleMockModel).WhereRelation("users", Eq("name", "john"))
+	conditions, err := q.getQueryConditions()
+	assert.NoError(t, err)
+
+	var args []any
+	_, err = And(conditions...).sql("t", &args)
+	assert.EqualError(t, err, "filter of embedded resource users.name is not supported by postgres driver")
+}
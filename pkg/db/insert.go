@@ -12,6 +12,10 @@ func (q *Query) Insert(payload interface{}, model interface{}) error {
 		return err
 	}
 
+	if usePostgresDriver() {
+		return q.postgresInsert(jsonData, "", model)
+	}
+
 	url := q.GetUrl()
 
 	headers := make(map[string]string)
@@ -0,0 +1,74 @@
+package db
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"crypto/sha512"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"hash"
+	"strings"
+	"time"
+)
+
+var errInvalidJwt = errors.New("invalid jwt token")
+
+// getJwtClaims verify hmac signed token with secret and return the claims,
+// token that is expired or signed with other algorithm is rejected
+func getJwtClaims(token string, secret string) (map[string]any, error) {
+	parts := strings.Split(token, ".")
+	if len(parts) != 3 {
+		return nil, errInvalidJwt
+	}
+
+	headerByte, err := base64.RawURLEncoding.DecodeString(parts[0])
+	if err != nil {
+		return nil, errInvalidJwt
+	}
+
+	var header struct {
+		Alg string `json:"alg"`
+	}
+	if err := json.Unmarshal(headerByte, &header); err != nil {
+		return nil, errInvalidJwt
+	}
+
+	var hashFn func() hash.Hash
+	switch header.Alg {
+	case "HS256":
+		hashFn = sha256.New
+	case "HS384":
+		hashFn = sha512.New384
+	case "HS512":
+		hashFn = sha512.New
+	default:
+		return nil, errors.New("unsupported jwt algorithm : " + header.Alg)
+	}
+
+	signature, err := base64.RawURLEncoding.DecodeString(parts[2])
+	if err != nil {
+		return nil, errInvalidJwt
+	}
+
+	mac := hmac.New(hashFn, []byte(secret))
+	mac.Write([]byte(parts[0] + "." + parts[1]))
+	if !hmac.Equal(signature, mac.Sum(nil)) {
+		return nil, errInvalidJwt
+	}
+
+	payloadByte, err := base64.RawURLEncoding.DecodeString(parts[1])
+	if err != nil {
+		return nil, errInvalidJwt
+	}
+
+	var claims map[string]any
+	if err := json.Unmarshal(payloadByte, &claims); err != nil {
+		return nil, errInvalidJwt
+	}
+
+	if exp, ok := claims["exp"].(float64); ok && time.Now().Unix() >= int64(exp) {
+		return nil, errors.New("jwt token is expired")
+	}
+	return claims, nil
+}
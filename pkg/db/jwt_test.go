@@ -0,0 +1,51 @@
+package db
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func signMockJwt(header string, payload string, secret string) string {
+	unsigned := base64.RawURLEncoding.EncodeToString([]byte(header)) + "." + base64.RawURLEncoding.EncodeToString([]byte(payload))
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(unsigned))
+	return unsigned + "." + base64.RawURLEncoding.EncodeToString(mac.Sum(nil))
+}
+
+func TestGetJwtClaims(t *testing.T) {
+	header := `{"alg":"HS256","typ":"JWT"}`
+
+	t.Run("valid token", func(t *testing.T) {
+		claims, err := getJwtClaims(signMockJwt(header, `{"role":"authenticated","sub":"user-1"}`, "secret"), "secret")
+		assert.NoError(t, err)
+		assert.Equal(t, "authenticated", claims["role"])
+		assert.Equal(t, "user-1", claims["sub"])
+	})
+
+	t.Run("invalid signature", func(t *testing.T) {
+		_, err := getJwtClaims(signMockJwt(header, `{"role":"service_role"}`, "other"), "secret")
+		assert.EqualError(t, err, "invalid jwt token")
+	})
+
+	t.Run("expired token", func(t *testing.T) {
+		payload := fmt.Sprintf(`{"role":"authenticated","exp":%d}`, time.Now().Add(-time.Minute).Unix())
+		_, err := getJwtClaims(signMockJwt(header, payload, "secret"), "secret")
+		assert.EqualError(t, err, "jwt token is expired")
+	})
+
+	t.Run("unsupported algorithm", func(t *testing.T) {
+		_, err := getJwtClaims(signMockJwt(`{"alg":"none"}`, `{"role":"service_role"}`, "secret"), "secret")
+		assert.EqualError(t, err, "unsupported jwt algorithm : none")
+	})
+
+	t.Run("malformed token", func(t *testing.T) {
+		_, err := getJwtClaims("token", "secret")
+		assert.EqualError(t, err, "invalid jwt token")
+	})
+}
@@ -0,0 +1,272 @@
+package db
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+	"sync"
+
+	"github.com/lib/pq"
+	"github.com/sev-2/raiden"
+)
+
+var (
+	postgresPool    *sql.DB
+	postgresPoolUrl string
+	postgresPoolMu  sync.Mutex
+)
+
+// getPostgresPool return connection pool of DATABASE_URL, pool is created
+// once and shared by every query that run with direct postgres connection
+func getPostgresPool() (*sql.DB, error) {
+	config := getConfig()
+	if config == nil || config.DatabaseUrl == "" {
+		return nil, errors.New("database url is not configured, set DATABASE_URL in config file or RAIDEN_DATABASE_URL environment variable to use direct postgres connection")
+	}
+
+	postgresPoolMu.Lock()
+	defer postgresPoolMu.Unlock()
+
+	if postgresPool != nil && postgresPoolUrl == config.DatabaseUrl {
+		return postgresPool, nil
+	}
+
+	pool, err := sql.Open("postgres", config.DatabaseUrl)
+	if err != nil {
+		return nil, err
+	}
+
+	if postgresPool != nil {
+		postgresPool.Close()
+	}
+
+	postgresPool, postgresPoolUrl = pool, config.DatabaseUrl
+	return postgresPool, nil
+}
+
+// postgresAuth is role and jwt claims that applied to transaction,
+// empty role mean the transaction run as database user of the connection
+type postgresAuth struct {
+	role   string
+	claims []byte
+}
+
+// usePostgresDriver return true when query run with direct postgres connection instead of postgrest
+func usePostgresDriver() bool {
+	config := getConfig()
+	return config != nil && config.Mode == raiden.SvcMode && config.QueryDriver == raiden.QueryDriverPostgres
+}
+
+// getPostgresAuth verify request token with JWT_SECRET, role and claims of
+// the token is applied to transaction so row level security still hold
+func getPostgresAuth(ctx raiden.Context, credential Credential, bypass bool) (postgresAuth, error) {
+	if bypass {
+		return postgresAuth{}, nil
+	}
+
+	var token string
+	if ctx != nil {
+		token = string(ctx.RequestContext().Request.Header.Peek("Authorization"))
+		if strings.HasPrefix(token, "Bearer ") {
+			token = strings.TrimPrefix(token, "Bearer ")
+		} else {
+			token = string(ctx.RequestContext().Request.Header.Peek("apikey"))
+		}
+	} else {
+		token = strings.TrimPrefix(credential.Token, "Bearer ")
+		if token == "" {
+			token = credential.ApiKey
+		}
+	}
+
+	if token == "" {
+		return postgresAuth{}, errors.New("missing jwt token, request without token can only run as system")
+	}
+
+	config := getConfig()
+	if config == nil || config.JwtSecret == "" {
+		return postgresAuth{}, errors.New("jwt secret is not configured, set JWT_SECRET in config file or RAIDEN_JWT_SECRET environment variable to verify request token")
+	}
+
+	claims, err := getJwtClaims(token, config.JwtSecret)
+	if err != nil {
+		return postgresAuth{}, err
+	}
+
+	role, _ := claims["role"].(string)
+	if role == "" {
+		role = "anon"
+	}
+
+	claimsByte, err := json.Marshal(claims)
+	if err != nil {
+		return postgresAuth{}, err
+	}
+	return postgresAuth{role: role, claims: claimsByte}, nil
+}
+
+// getRequestContext return context of request, so query is cancelled
+// when the request is cancelled or reach the deadline
+func getRequestContext(ctx raiden.Context) context.Context {
+	if ctx == nil {
+		return context.Background()
+	}
+
+	if c := ctx.Ctx(); c != nil {
+		return c
+	}
+
+	if c := ctx.RequestContext(); c != nil {
+		return c
+	}
+	return context.Background()
+}
+
+// runPostgresTx run fn in transaction with role and claims of auth, setting is local
+// so it is reset when the connection is returned to the pool
+func runPostgresTx(ctx context.Context, auth postgresAuth, fn func(tx *Tx) error) (err error) {
+	pool, err := getPostgresPool()
+	if err != nil {
+		return err
+	}
+
+	sqlTx, err := pool.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+
+	defer func() {
+		if p := recover(); p != nil {
+			sqlTx.Rollback()
+			panic(p)
+		}
+	}()
+
+	if auth.role != "" {
+		if _, err := sqlTx.ExecContext(ctx, "select set_config('role', $1, true), set_config('request.jwt.claims', $2, true)", auth.role, string(auth.claims)); err != nil {
+			sqlTx.Rollback()
+			return err
+		}
+	}
+
+	if err := fn(&Tx{ctx: ctx, tx: sqlTx}); err != nil {
+		if rollbackErr := sqlTx.Rollback(); rollbackErr != nil {
+			return fmt.Errorf("%s, failed rollback transaction : %s", err, rollbackErr)
+		}
+		return err
+	}
+	return sqlTx.Commit()
+}
+
+// quoteSqlTable return quoted table of model, for example "public"."articles"
+func quoteSqlTable(model any) string {
+	return pq.QuoteIdentifier(getModelSchema(model)) + "." + pq.QuoteIdentifier(GetTable(model))
+}
+
+// ----- query with postgres driver -----
+
+// errSingleRow is the same error as postgrest when single row is requested
+var errSingleRow = errors.New("JSON object requested, multiple (or no) rows returned")
+
+func (q Query) runPostgres(fn func(tx *Tx) error) error {
+	auth, err := getPostgresAuth(q.Context, q.credential, q.ByPass)
+	if err != nil {
+		return err
+	}
+	return runPostgresTx(getRequestContext(q.Context), auth, fn)
+}
+
+func (q Query) postgresGet(result any) error {
+	query, args, err := buildSelectSql(q)
+	if err != nil {
+		return err
+	}
+
+	return q.runPostgres(func(tx *Tx) error {
+		return tx.queryResult(query, args, result)
+	})
+}
+
+func (q Query) postgresSingle(result any) error {
+	query, args, err := buildSelectSql(q)
+	if err != nil {
+		return err
+	}
+
+	return q.runPostgres(func(tx *Tx) error {
+		data, err := tx.queryJson(query, args)
+		if err != nil {
+			return err
+		}
+
+		var rows []json.RawMessage
+		if err := json.Unmarshal(data, &rows); err != nil {
+			return err
+		}
+
+		if len(rows) != 1 {
+			return errSingleRow
+		}
+		return json.Unmarshal(rows[0], result)
+	})
+}
+
+func (q Query) postgresCount() (int, error) {
+	query, args, err := buildCountSql(q)
+	if err != nil {
+		return 0, err
+	}
+
+	var count int
+	err = q.runPostgres(func(tx *Tx) error {
+		raiden.Debug("db.transaction", "query", query)
+		return tx.tx.QueryRowContext(tx.ctx, query, args...).Scan(&count)
+	})
+	return count, err
+}
+
+func (q Query) postgresInsert(payload []byte, conflict string, result any) error {
+	query, args, err := buildInsertSql(q.model, payload, conflict)
+	if err != nil {
+		return err
+	}
+
+	return q.runPostgres(func(tx *Tx) error {
+		return tx.queryResult(query, args, result)
+	})
+}
+
+func (q Query) postgresUpdate(payload []byte, result any) error {
+	conditions, err := q.getQueryConditions()
+	if err != nil {
+		return err
+	}
+
+	query, args, err := buildUpdateSql(q.model, payload, conditions)
+	if err != nil {
+		return err
+	}
+
+	return q.runPostgres(func(tx *Tx) error {
+		return tx.queryResult(query, args, result)
+	})
+}
+
+func (q Query) postgresDelete() error {
+	conditions, err := q.getQueryConditions()
+	if err != nil {
+		return err
+	}
+
+	query, args, err := buildDeleteSql(q.model, conditions)
+	if err != nil {
+		return err
+	}
+
+	return q.runPostgres(func(tx *Tx) error {
+		return tx.Exec(query, args...)
+	})
+}
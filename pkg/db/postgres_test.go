@@ -0,0 +1,95 @@
+package db
+
+import (
+	"testing"
+
+	"github.com/sev-2/raiden"
+	"github.com/stretchr/testify/assert"
+	"github.com/valyala/fasthttp"
+)
+
+func TestUsePostgresDriver(t *testing.T) {
+	defer SetConfig(nil)
+
+	SetConfig(&raiden.Config{Mode: raiden.SvcMode, QueryDriver: raiden.QueryDriverPostgres})
+	assert.True(t, usePostgresDriver())
+
+	SetConfig(&raiden.Config{Mode: raiden.BffMode, QueryDriver: raiden.QueryDriverPostgres})
+	assert.False(t, usePostgresDriver())
+
+	SetConfig(&raiden.Config{Mode: raiden.SvcMode, QueryDriver: raiden.QueryDriverPostgrest})
+	assert.False(t, usePostgresDriver())
+}
+
+func TestQuery_PostgresDriverWithoutDatabaseUrl(t *testing.T) {
+	SetConfig(&raiden.Config{Mode: raiden.SvcMode, QueryDriver: raiden.QueryDriverPostgres})
+	defer SetConfig(nil)
+
+	var articles []ArticleMockModel
+	err := NewQuery(nil).AsSystem().Model(articleMockModel).Eq("id", 1).Get(&articles)
+	assert.EqualError(t, err, "database url is not configured, set DATABASE_URL in config file or RAIDEN_DATABASE_URL environment variable to use direct postgres connection")
+
+	err = NewQuery(nil).Model(articleMockModel).Delete()
+	assert.EqualError(t, err, "missing jwt token, request without token can only run as system")
+}
+
+func TestGetPostgresAuth(t *testing.T) {
+	SetConfig(&raiden.Config{JwtSecret: "secret"})
+	defer SetConfig(nil)
+
+	t.Run("system", func(t *testing.T) {
+		auth, err := getPostgresAuth(nil, Credential{}, true)
+		assert.NoError(t, err)
+		assert.Empty(t, auth.role)
+	})
+
+	t.Run("missing token", func(t *testing.T) {
+		_, err := getPostgresAuth(&raiden.Ctx{RequestCtx: &fasthttp.RequestCtx{}}, Credential{}, false)
+		assert.EqualError(t, err, "missing jwt token, request without token can only run as system")
+	})
+
+	t.Run("bearer token", func(t *testing.T) {
+		ctx := &raiden.Ctx{RequestCtx: &fasthttp.RequestCtx{}}
+		ctx.RequestContext().Request.Header.Set("Authorization", "Bearer "+signMockJwt(`{"alg":"HS256"}`, `{"role":"authenticated","sub":"user-1"}`, "secret"))
+
+		auth, err := getPostgresAuth(ctx, Credential{}, false)
+		assert.NoError(t, err)
+		assert.Equal(t, "authenticated", auth.role)
+		assert.JSONEq(t, `{"role":"authenticated","sub":"user-1"}`, string(auth.claims))
+	})
+
+	t.Run("apikey without role", func(t *testing.T) {
+		ctx := &raiden.Ctx{RequestCtx: &fasthttp.RequestCtx{}}
+		ctx.RequestContext().Request.Header.Set("apikey", signMockJwt(`{"alg":"HS256"}`, `{"iss":"supabase"}`, "secret"))
+
+		auth, err := getPostgresAuth(ctx, Credential{}, false)
+		assert.NoError(t, err)
+		assert.Equal(t, "anon", auth.role)
+	})
+
+	t.Run("credential token", func(t *testing.T) {
+		token := signMockJwt(`{"alg":"HS256"}`, `{"role":"authenticated"}`, "secret")
+
+		auth, err := getPostgresAuth(nil, Credential{Token: "Bearer " + token}, false)
+		assert.NoError(t, err)
+		assert.Equal(t, "authenticated", auth.role)
+
+		auth, err = getPostgresAuth(nil, Credential{ApiKey: token}, false)
+		assert.NoError(t, err)
+		assert.Equal(t, "authenticated", auth.role)
+	})
+
+	t.Run("bypass", func(t *testing.T) {
+		auth, err := getPostgresAuth(&raiden.Ctx{RequestCtx: &fasthttp.RequestCtx{}}, Credential{}, true)
+		assert.NoError(t, err)
+		assert.Empty(t, auth.role)
+	})
+
+	t.Run("invalid token", func(t *testing.T) {
+		ctx := &raiden.Ctx{RequestCtx: &fasthttp.RequestCtx{}}
+		ctx.RequestContext().Request.Header.Set("Authorization", "Bearer "+signMockJwt(`{"alg":"HS256"}`, `{"role":"service_role"}`, "wrong"))
+
+		_, err := getPostgresAuth(ctx, Credential{}, false)
+		assert.EqualError(t, err, "invalid jwt token")
+	})
+}
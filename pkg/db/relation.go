@@ -137,6 +137,10 @@ func (q *Query) Preload(table string, args ...string) *Query {
 			*q.WhereAndList,
 			fmt.Sprintf("%s=%s.%s", fmt.Sprintf("%s.%s", relatedFieldPrefix, field), operator, getStringValue(value)),
 		)
+
+		c := newCondition(field, operator, getStringValue(value), formatColumnValue(value))
+		c.relation = relatedFieldPrefix
+		q.andConditions = append(q.andConditions, c)
 	}
 
 	return q
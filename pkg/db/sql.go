@@ -0,0 +1,479 @@
+package db
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"reflect"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/lib/pq"
+	"github.com/sev-2/raiden"
+)
+
+// sqlResultQuery wrap data modifying statement, so affected row is returned as one json array
+const sqlResultQuery = "with rows as (%s) select coalesce(json_agg(rows), '[]') from rows"
+
+// sqlColumnRegex match select column of query, for example name, alias:name or total:amount.sum()
+var sqlColumnRegex = regexp.MustCompile(`^(?:([a-zA-Z_][a-zA-Z0-9_]*):)?([a-zA-Z_][a-zA-Z0-9_]*|\*)(?:\.(sum|avg|min|max|count)\(\))?$`)
+
+// buildSelectSql compile query to select query, row is returned as one json array
+// and non aggregate column is used as group when query has aggregate column
+func buildSelectSql(q Query) (string, []any, error) {
+	if len(q.Relations) > 0 {
+		return "", nil, fmt.Errorf("relation %s is not supported by postgres driver", strings.Join(q.Relations, ","))
+	}
+
+	columns, groups, hasAggregate := []string{}, []string{}, false
+	for _, c := range q.Columns {
+		match := sqlColumnRegex.FindStringSubmatch(c)
+		if match == nil {
+			return "", nil, fmt.Errorf("column %s is not supported by postgres driver", c)
+		}
+
+		alias, name, aggregate := match[1], match[2], match[3]
+		column := "t.*"
+		if name != "*" {
+			column = "t." + pq.QuoteIdentifier(name)
+		}
+
+		if aggregate != "" {
+			hasAggregate = true
+			column = fmt.Sprintf("%s(%s)", aggregate, column)
+			if alias == "" {
+				alias = aggregate
+			}
+		} else {
+			groups = append(groups, column)
+		}
+
+		if alias != "" {
+			column += " as " + pq.QuoteIdentifier(alias)
+		}
+		columns = append(columns, column)
+	}
+
+	if len(columns) == 0 {
+		columns = append(columns, "t.*")
+	}
+
+	conditions, err := q.getQueryConditions()
+	if err != nil {
+		return "", nil, err
+	}
+
+	var args []any
+	query, err := buildWhereSql("t", conditions, &args)
+	if err != nil {
+		return "", nil, err
+	}
+
+	if hasAggregate && len(groups) > 0 {
+		query += " group by " + strings.Join(groups, ", ")
+	}
+
+	if q.OrderList != nil && len(*q.OrderList) > 0 {
+		orders := make([]string, 0, len(*q.OrderList))
+		for _, o := range *q.OrderList {
+			order, err := buildOrderSql("t", o)
+			if err != nil {
+				return "", nil, err
+			}
+			orders = append(orders, order)
+		}
+		query += " order by " + strings.Join(orders, ", ")
+	}
+
+	if q.LimitValue > 0 {
+		query += fmt.Sprintf(" limit %d", q.LimitValue)
+	}
+
+	if q.OffsetValue > 0 {
+		query += fmt.Sprintf(" offset %d", q.OffsetValue)
+	}
+
+	return fmt.Sprintf(
+		"select coalesce(json_agg(rows), '[]') from (select %s from %s as t%s) as rows",
+		strings.Join(columns, ", "), quoteSqlTable(q.model), query,
+	), args, nil
+}
+
+// buildOrderSql compile order of postgrest, the order is written as
+// column[.asc|.desc][.nullsfirst|.nullslast], for example created_at.desc.nullslast
+func buildOrderSql(alias string, order string) (string, error) {
+	parts := strings.Split(order, ".")
+	column, modifiers := parts[0], parts[1:]
+	if column == "" || len(modifiers) > 2 {
+		return "", fmt.Errorf("order %s is not supported by postgres driver", order)
+	}
+
+	var direction, nulls string
+	for _, m := range modifiers {
+		switch {
+		case (m == "asc" || m == "desc") && direction == "" && nulls == "":
+			direction = m
+		case (m == "nullsfirst" || m == "nullslast") && nulls == "":
+			nulls = m
+		default:
+			return "", fmt.Errorf("order %s is not supported by postgres driver", order)
+		}
+	}
+
+	clause := fmt.Sprintf("%s.%s", alias, pq.QuoteIdentifier(column))
+	if direction != "" {
+		clause += " " + direction
+	}
+
+	switch nulls {
+	case "nullsfirst":
+		clause += " nulls first"
+	case "nullslast":
+		clause += " nulls last"
+	}
+	return clause, nil
+}
+
+func buildCountSql(q Query) (string, []any, error) {
+	conditions, err := q.getQueryConditions()
+	if err != nil {
+		return "", nil, err
+	}
+
+	var args []any
+	where, err := buildWhereSql("t", conditions, &args)
+	if err != nil {
+		return "", nil, err
+	}
+	return fmt.Sprintf("select count(*) from %s as t%s", quoteSqlTable(q.model), where), args, nil
+}
+
+// buildInsertSql create insert query of model, payload is json object or array and only column
+// that exist in the payload is inserted so omitted column use default value. conflict is
+// upsert resolution of primary key, empty conflict create plain insert
+func buildInsertSql(model any, payload []byte, conflict string) (string, []any, error) {
+	var rows []map[string]json.RawMessage
+	if err := json.Unmarshal(payload, &rows); err != nil {
+		var row map[string]json.RawMessage
+		if err := json.Unmarshal(payload, &row); err != nil {
+			return "", nil, err
+		}
+		rows = append(rows, row)
+		payload = []byte("[" + string(payload) + "]")
+	}
+
+	columns := getSqlColumns(model, rows...)
+	if len(columns) == 0 {
+		return "", nil, fmt.Errorf("no column to insert to table %s", GetTable(model))
+	}
+
+	var onConflict string
+	switch conflict {
+	case "":
+	case IgnoreDuplicates:
+		onConflict = " on conflict do nothing"
+	case MergeDuplicates:
+		primaryKeys := getSqlPrimaryKeys(model)
+		if len(primaryKeys) == 0 {
+			return "", nil, fmt.Errorf("table %s does not have primary key for upsert", GetTable(model))
+		}
+
+		sets := make([]string, 0, len(columns))
+		for _, c := range columns {
+			sets = append(sets, fmt.Sprintf("%s = excluded.%s", c, c))
+		}
+		onConflict = fmt.Sprintf(" on conflict (%s) do update set %s", strings.Join(primaryKeys, ", "), strings.Join(sets, ", "))
+	default:
+		return "", nil, fmt.Errorf("unsupported upsert resolution %s", conflict)
+	}
+
+	table := quoteSqlTable(model)
+	query := fmt.Sprintf(
+		"insert into %s (%s) select %s from json_populate_recordset(null::%s, $1)%s returning *",
+		table, strings.Join(columns, ", "), strings.Join(columns, ", "), table, onConflict,
+	)
+	return fmt.Sprintf(sqlResultQuery, query), []any{string(payload)}, nil
+}
+
+// buildUpdateSql create update query of model, every column in json object payload is updated
+func buildUpdateSql(model any, payload []byte, conditions []Condition) (string, []any, error) {
+	var row map[string]json.RawMessage
+	if err := json.Unmarshal(payload, &row); err != nil {
+		return "", nil, errors.New("update data must be single model")
+	}
+
+	columns := getSqlColumns(model, row)
+	if len(columns) == 0 {
+		return "", nil, fmt.Errorf("no column to update in table %s", GetTable(model))
+	}
+
+	sets := make([]string, 0, len(columns))
+	for _, c := range columns {
+		sets = append(sets, fmt.Sprintf("%s = r.%s", c, c))
+	}
+
+	args := []any{string(payload)}
+	where, err := buildWhereSql("t", conditions, &args)
+	if err != nil {
+		return "", nil, err
+	}
+
+	table := quoteSqlTable(model)
+	query := fmt.Sprintf(
+		"update %s as t set %s from json_populate_record(null::%s, $1) as r%s returning t.*",
+		table, strings.Join(sets, ", "), table, where,
+	)
+	return fmt.Sprintf(sqlResultQuery, query), args, nil
+}
+
+func buildDeleteSql(model any, conditions []Condition) (string, []any, error) {
+	var args []any
+	where, err := buildWhereSql("t", conditions, &args)
+	if err != nil {
+		return "", nil, err
+	}
+	return fmt.Sprintf("delete from %s as t%s", quoteSqlTable(model), where), args, nil
+}
+
+// buildWhereSql join condition as where clause, value of condition is added to args
+func buildWhereSql(alias string, conditions []Condition, args *[]any) (string, error) {
+	if len(conditions) == 0 {
+		return "", nil
+	}
+
+	clause, err := And(conditions...).sql(alias, args)
+	if err != nil {
+		return "", err
+	}
+	return " where " + clause, nil
+}
+
+// sql return condition as sql expression, column is qualified with alias
+// and value is sent as query parameter
+func (c Condition) sql(alias string, args *[]any) (string, error) {
+	if c.group != "" {
+		items := make([]string, 0, len(c.conditions))
+		for _, child := range c.conditions {
+			item, err := child.sql(alias, args)
+			if err != nil {
+				return "", err
+			}
+			items = append(items, item)
+		}
+
+		separator := " and "
+		if c.group == "or" {
+			separator = " or "
+		}
+
+		clause := fmt.Sprintf("(%s)", strings.Join(items, separator))
+		if c.negate {
+			clause = "not " + clause
+		}
+		return clause, nil
+	}
+
+	if c.relation != "" {
+		return "", fmt.Errorf("filter of embedded resource %s.%s is not supported by postgres driver", c.relation, c.column)
+	}
+
+	column := fmt.Sprintf("%s.%s", alias, pq.QuoteIdentifier(c.column))
+	operator, negate := strings.TrimPrefix(c.operator, "not."), strings.HasPrefix(c.operator, "not.")
+	if i := strings.Index(operator, "("); i > 0 {
+		operator = operator[:i]
+	}
+
+	param := func(value any) string {
+		*args = append(*args, value)
+		return fmt.Sprintf("$%d", len(*args))
+	}
+
+	var clause string
+	switch operator {
+	case "eq", "neq", "lt", "lte", "gt", "gte", "like", "ilike", "match", "imatch", "cs", "cd", "ov", "sl", "sr", "nxl", "nxr", "adj":
+		clause = fmt.Sprintf("%s %s %s", column, sqlOperators[operator], param(c.arg))
+	case "is":
+		clause = fmt.Sprintf("%s is %s", column, c.value)
+	case "isdistinct":
+		if c.arg == nil {
+			clause = fmt.Sprintf("%s is distinct from null", column)
+		} else {
+			clause = fmt.Sprintf("%s is distinct from %s", column, param(c.arg))
+		}
+	case "in":
+		clause = fmt.Sprintf("%s = any(%s)", column, param(pq.Array(c.arg)))
+	case "fts", "plfts", "phfts", "wfts":
+		if c.config == "" {
+			clause = fmt.Sprintf("%s @@ %s(%s)", column, sqlFtsFunctions[operator], param(c.arg))
+		} else {
+			clause = fmt.Sprintf("%s @@ %s(%s::regconfig, %s)", column, sqlFtsFunctions[operator], param(c.config), param(c.arg))
+		}
+	default:
+		return "", fmt.Errorf("operator %s is not supported in sql query", operator)
+	}
+
+	if negate {
+		clause = fmt.Sprintf("not (%s)", clause)
+	}
+	return clause, nil
+}
+
+var sqlOperators = map[string]string{
+	"eq":     "=",
+	"neq":    "<>",
+	"lt":     "<",
+	"lte":    "<=",
+	"gt":     ">",
+	"gte":    ">=",
+	"like":   "like",
+	"ilike":  "ilike",
+	"match":  "~",
+	"imatch": "~*",
+	"cs":     "@>",
+	"cd":     "<@",
+	"ov":     "&&",
+	"sl":     "<<",
+	"sr":     ">>",
+	"nxl":    "&>",
+	"nxr":    "&<",
+	"adj":    "-|-",
+}
+
+var sqlFtsFunctions = map[string]string{
+	"fts":   "to_tsquery",
+	"plfts": "plainto_tsquery",
+	"phfts": "phraseto_tsquery",
+	"wfts":  "websearch_to_tsquery",
+}
+
+// bindSqlResult decode json array result, when result is not slice
+// the first row is decoded
+func bindSqlResult(data []byte, result any) error {
+	if result == nil {
+		return nil
+	}
+
+	rt := reflect.TypeOf(result)
+	if rt.Kind() != reflect.Ptr {
+		return errors.New("result must be pointer")
+	}
+
+	if rt.Elem().Kind() == reflect.Slice {
+		return json.Unmarshal(data, result)
+	}
+
+	var rows []json.RawMessage
+	if err := json.Unmarshal(data, &rows); err != nil {
+		return err
+	}
+
+	if len(rows) == 0 {
+		return nil
+	}
+	return json.Unmarshal(rows[0], result)
+}
+
+// getSqlModel return new model of data, data can be model,
+// pointer of model or slice of model
+func getSqlModel(data any) (any, bool, error) {
+	rt := reflect.TypeOf(data)
+	if rt == nil {
+		return nil, false, errors.New("model is required")
+	}
+
+	if rt.Kind() == reflect.Ptr {
+		rt = rt.Elem()
+	}
+
+	isSlice := rt.Kind() == reflect.Slice
+	if isSlice {
+		rt = rt.Elem()
+		if rt.Kind() == reflect.Ptr {
+			rt = rt.Elem()
+		}
+	}
+
+	if rt.Kind() != reflect.Struct {
+		return nil, false, fmt.Errorf("invalid model type %s", rt.Kind())
+	}
+
+	model := reflect.New(rt).Interface()
+	if GetTable(model) == "" {
+		return nil, false, fmt.Errorf("model %s does not have table name", rt.Name())
+	}
+	return model, isSlice, nil
+}
+
+// getSqlColumns return sorted and quoted column of model that exist in one of the rows
+func getSqlColumns(model any, rows ...map[string]json.RawMessage) []string {
+	rt := reflect.TypeOf(model)
+	if rt.Kind() == reflect.Ptr {
+		rt = rt.Elem()
+	}
+
+	mapColumn := make(map[string]bool)
+	for i := 0; i < rt.NumField(); i++ {
+		tag := rt.Field(i).Tag.Get("column")
+		if tag == "" {
+			continue
+		}
+
+		if ct := raiden.UnmarshalColumnTag(tag); ct.Name != "" {
+			mapColumn[ct.Name] = true
+		}
+	}
+
+	mapExist := make(map[string]bool)
+	for _, row := range rows {
+		for key := range row {
+			if mapColumn[key] {
+				mapExist[key] = true
+			}
+		}
+	}
+
+	columns := make([]string, 0, len(mapExist))
+	for key := range mapExist {
+		columns = append(columns, key)
+	}
+	sort.Strings(columns)
+
+	for i := range columns {
+		columns[i] = pq.QuoteIdentifier(columns[i])
+	}
+	return columns
+}
+
+func getSqlPrimaryKeys(model any) (primaryKeys []string) {
+	rt := reflect.TypeOf(model)
+	if rt.Kind() == reflect.Ptr {
+		rt = rt.Elem()
+	}
+
+	for i := 0; i < rt.NumField(); i++ {
+		tag := rt.Field(i).Tag.Get("column")
+		if tag == "" {
+			continue
+		}
+
+		if ct := raiden.UnmarshalColumnTag(tag); ct.PrimaryKey && ct.Name != "" {
+			primaryKeys = append(primaryKeys, pq.QuoteIdentifier(ct.Name))
+		}
+	}
+	return
+}
+
+func getModelSchema(model any) string {
+	rt := reflect.TypeOf(model)
+	if rt.Kind() == reflect.Ptr {
+		rt = rt.Elem()
+	}
+
+	if field, found := rt.FieldByName("Metadata"); found {
+		if s := field.Tag.Get("schema"); s != "" {
+			return s
+		}
+	}
+	return "public"
+}
@@ -0,0 +1,181 @@
+package db
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBuildInsertSql(t *testing.T) {
+	t.Run("single row", func(t *testing.T) {
+		query, args, err := buildInsertSql(&ArticleMockModel{}, []byte(`{"title":"supabase","user_id":1,"user":null}`), "")
+		assert.NoError(t, err)
+		assert.Equal(t, `with rows as (insert into "public"."articles" ("title", "user_id") select "title", "user_id" from json_populate_recordset(null::"public"."articles", $1) returning *) select coalesce(json_agg(rows), '[]') from rows`, query)
+		assert.Equal(t, []any{`[{"title":"supabase","user_id":1,"user":null}]`}, args)
+	})
+
+	t.Run("multiple row", func(t *testing.T) {
+		query, args, err := buildInsertSql(ArticleMockModel{}, []byte(`[{"title":"a"},{"title":"b","rating":5}]`), "")
+		assert.NoError(t, err)
+		assert.Contains(t, query, `insert into "public"."articles" ("rating", "title") select "rating", "title"`)
+		assert.Equal(t, []any{`[{"title":"a"},{"title":"b","rating":5}]`}, args)
+	})
+
+	t.Run("merge duplicate", func(t *testing.T) {
+		query, _, err := buildInsertSql(&ArticleMockModel{}, []byte(`[{"id":1,"title":"a"}]`), MergeDuplicates)
+		assert.NoError(t, err)
+		assert.Contains(t, query, `from json_populate_recordset(null::"public"."articles", $1) on conflict ("id") do update set "id" = excluded."id", "title" = excluded."title" returning *`)
+	})
+
+	t.Run("ignore duplicate", func(t *testing.T) {
+		query, _, err := buildInsertSql(&ArticleMockModel{}, []byte(`[{"id":1,"title":"a"}]`), IgnoreDuplicates)
+		assert.NoError(t, err)
+		assert.Contains(t, query, `on conflict do nothing returning *`)
+	})
+
+	t.Run("no column", func(t *testing.T) {
+		_, _, err := buildInsertSql(&ArticleMockModel{}, []byte(`{"unknown":1}`), "")
+		assert.EqualError(t, err, "no column to insert to table articles")
+	})
+
+	t.Run("invalid payload", func(t *testing.T) {
+		_, _, err := buildInsertSql(&ArticleMockModel{}, []byte(`"a"`), "")
+		assert.Error(t, err)
+	})
+}
+
+func TestBuildUpdateSql(t *testing.T) {
+	t.Run("update with condition", func(t *testing.T) {
+		payload := []byte(`{"title":"raiden","is_featured":true}`)
+		query, args, err := buildUpdateSql(&ArticleMockModel{}, payload, []Condition{Eq("id", 1), Or(Is("body", nil), Not(Like("title", "%go%")))})
+		assert.NoError(t, err)
+		assert.Equal(t, `with rows as (update "public"."articles" as t set "is_featured" = r."is_featured", "title" = r."title" from json_populate_record(null::"public"."articles", $1) as r where (t."id" = $2 and (t."body" is null or not (t."title" like $3))) returning t.*) select coalesce(json_agg(rows), '[]') from rows`, query)
+		assert.Equal(t, []any{string(payload), 1, "%go%"}, args)
+	})
+
+	t.Run("multiple row is not allowed", func(t *testing.T) {
+		_, _, err := buildUpdateSql(&ArticleMockModel{}, []byte(`[{"title":"a"}]`), nil)
+		assert.EqualError(t, err, "update data must be single model")
+	})
+}
+
+func TestBuildDeleteSql(t *testing.T) {
+	query, args, err := buildDeleteSql(&ArticleMockModel{}, []Condition{In("id", []int{1, 2}), Cs("tags", []string{"go"})})
+	assert.NoError(t, err)
+	assert.Equal(t, `delete from "public"."articles" as t where (t."id" = any($1) and t."tags" @> $2)`, query)
+	assert.Len(t, args, 2)
+	assert.Equal(t, "{go}", args[1])
+
+	query, args, err = buildDeleteSql(&ArticleMockModel{}, nil)
+	assert.NoError(t, err)
+	assert.Equal(t, `delete from "public"."articles" as t`, query)
+	assert.Empty(t, args)
+}
+
+func TestConditionSql(t *testing.T) {
+	var args []any
+	clause, err := Not(Or(Fts("body", "fat & rat", "english"), Fts("body", "cat", ""), Not(Gte("rating", 5)))).sql("t", &args)
+	assert.NoError(t, err)
+	assert.Equal(t, `not (t."body" @@ to_tsquery($1::regconfig, $2) or t."body" @@ to_tsquery($3) or not (t."rating" >= $4))`, clause)
+	assert.Equal(t, []any{"english", "fat & rat", "cat", 5}, args)
+}
+
+func TestBindSqlResult(t *testing.T) {
+	var rows []ArticleMockModel
+	assert.NoError(t, bindSqlResult([]byte(`[{"id":1},{"id":2}]`), &rows))
+	assert.Len(t, rows, 2)
+
+	var row ArticleMockModel
+	assert.NoError(t, bindSqlResult([]byte(`[{"id":1,"title":"a"},{"id":2}]`), &row))
+	assert.Equal(t, int64(1), row.Id)
+	assert.Equal(t, "a", row.Title)
+
+	assert.NoError(t, bindSqlResult([]byte(`[]`), &row))
+	assert.NoError(t, bindSqlResult([]byte(`[]`), nil))
+	assert.Error(t, bindSqlResult([]byte(`[]`), row))
+}
+
+func TestBuildSelectSql(t *testing.T) {
+	t.Run("filter, order and pagination", func(t *testing.T) {
+		q := NewQuery(&mockRaidenContext).
+			Model(articleMockModel).
+			Eq("user_id", 1).
+			In("id", []int{1, 2}).
+			Is("body", nil).
+			OrIlike("title", "%go lang%").
+			OrGt("rating", 3).
+			OrderDesc("created_at").
+			Limit(10).
+			Offset(20)
+
+		query, args, err := buildSelectSql(*q)
+		assert.NoError(t, err)
+		assert.Equal(t, `select coalesce(json_agg(rows), '[]') from (select t.* from "public"."articles" as t where (t."user_id" = $1 and t."id" = any($2) and t."body" is null and (t."title" ilike $3 or t."rating" > $4)) order by t."created_at" desc limit 10 offset 20) as rows`, query)
+		assert.Len(t, args, 4)
+		assert.Equal(t, "1", args[0])
+		assert.Equal(t, "%go lang%", args[2])
+		assert.Equal(t, "3", args[3])
+	})
+
+	t.Run("column and aggregate", func(t *testing.T) {
+		q := NewQuery(&mockRaidenContext).Model(articleMockModel)
+		q.Columns = []string{"user_id", "name:title"}
+		q.Sum("rating", "total").Max("rating", "")
+
+		query, args, err := buildSelectSql(*q)
+		assert.NoError(t, err)
+		assert.Equal(t, `select coalesce(json_agg(rows), '[]') from (select t."user_id", t."title" as "name", sum(t."rating") as "total", max(t."rating") as "max" from "public"."articles" as t group by t."user_id", t."title") as rows`, query)
+		assert.Empty(t, args)
+	})
+
+	t.Run("nested condition", func(t *testing.T) {
+		q := NewQuery(&mockRaidenContext).
+			Model(articleMockModel).
+			Where(Or(Eq("title", "a,b"), And(Cs("tags", []string{"x", "y z"}), Not(Fts("body", "fat & rat", "english")))))
+
+		query, args, err := buildSelectSql(*q)
+		assert.NoError(t, err)
+		assert.Equal(t, `select coalesce(json_agg(rows), '[]') from (select t.* from "public"."articles" as t where ((t."title" = $1 or (t."tags" @> $2 and not (t."body" @@ to_tsquery($3::regconfig, $4)))))) as rows`, query)
+		assert.Equal(t, []any{"a,b", `{x,"y z"}`, "english", "fat & rat"}, args)
+	})
+
+	t.Run("order modifier", func(t *testing.T) {
+		q := NewQuery(&mockRaidenContext).Model(articleMockModel).OrderDesc("created_at")
+		*q.OrderList = append(*q.OrderList, "rating.asc.nullslast", "title", "user_id.nullsfirst")
+
+		query, _, err := buildSelectSql(*q)
+		assert.NoError(t, err)
+		assert.Equal(t, `select coalesce(json_agg(rows), '[]') from (select t.* from "public"."articles" as t order by t."created_at" desc, t."rating" asc nulls last, t."title", t."user_id" nulls first) as rows`, query)
+
+		for _, order := range []string{"title.up", "title.nullslast.desc", "title.asc.desc", ".asc"} {
+			*q.OrderList = []string{order}
+			_, _, err = buildSelectSql(*q)
+			assert.EqualError(t, err, "order "+order+" is not supported by postgres driver")
+		}
+	})
+
+	t.Run("relation is not supported", func(t *testing.T) {
+		q := NewQuery(&mockRaidenContext).Model(articleMockModel)
+		q.Relations = []string{"user:users(*)"}
+
+		_, _, err := buildSelectSql(*q)
+		assert.EqualError(t, err, "relation user:users(*) is not supported by postgres driver")
+	})
+
+	t.Run("invalid column", func(t *testing.T) {
+		q := NewQuery(&mockRaidenContext).Model(articleMockModel)
+		q.Columns = []string{"title;drop table articles"}
+
+		_, _, err := buildSelectSql(*q)
+		assert.EqualError(t, err, "column title;drop table articles is not supported by postgres driver")
+	})
+}
+
+func TestBuildCountSql(t *testing.T) {
+	q := NewQuery(&mockRaidenContext).Model(articleMockModel).Gte("rating", 4).NotIs("is_featured", false)
+
+	query, args, err := buildCountSql(*q)
+	assert.NoError(t, err)
+	assert.Equal(t, `select count(*) from "public"."articles" as t where (t."rating" >= $1 and not (t."is_featured" is false))`, query)
+	assert.Equal(t, []any{"4"}, args)
+}
@@ -0,0 +1,132 @@
+package db
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+
+	"github.com/sev-2/raiden"
+)
+
+// Tx is unit of work that run in one database transaction, every statement
+// is executed directly so the result can be used by the next statement
+type Tx struct {
+	ctx context.Context
+	tx  *sql.Tx
+}
+
+// Transaction run fn in one transaction with direct postgres connection from DATABASE_URL,
+// all statement is rolled back when fn return error or panic. request token of ctx is verified
+// with JWT_SECRET and applied as role and claims so row level security still hold, transaction
+// is cancelled when the request is cancelled, for example :
+//
+//	err := db.Transaction(ctx, func(tx *db.Tx) error {
+//		var order models.Orders
+//		if err := tx.Insert(&models.Orders{UserId: userId}, &order); err != nil {
+//			return err
+//		}
+//		return tx.Insert(&models.OrderItems{OrderId: order.Id, ProductId: productId}, nil)
+//	})
+func Transaction(ctx raiden.Context, fn func(tx *Tx) error) error {
+	if ctx == nil {
+		return errors.New("transaction require request context, use TransactionAsSystem to run as database user")
+	}
+
+	auth, err := getPostgresAuth(ctx, Credential{}, false)
+	if err != nil {
+		return err
+	}
+	return runPostgresTx(getRequestContext(ctx), auth, fn)
+}
+
+// TransactionAsSystem run fn in one transaction as database user of the connection,
+// row level security is bypassed so it is only used by job or internal process
+func TransactionAsSystem(ctx context.Context, fn func(tx *Tx) error) error {
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	return runPostgresTx(ctx, postgresAuth{}, fn)
+}
+
+// Insert create model or slice of model, created row is decoded to result
+func (tx *Tx) Insert(data any, result any) error {
+	model, _, err := getSqlModel(data)
+	if err != nil {
+		return err
+	}
+
+	payload, err := json.Marshal(data)
+	if err != nil {
+		return err
+	}
+
+	query, args, err := buildInsertSql(model, payload, "")
+	if err != nil {
+		return err
+	}
+	return tx.queryResult(query, args, result)
+}
+
+// Update change row that match the conditions, updated row is decoded to result
+func (tx *Tx) Update(data any, result any, conditions ...Condition) error {
+	model, isSlice, err := getSqlModel(data)
+	if err != nil {
+		return err
+	}
+
+	if isSlice {
+		return errors.New("update data must be single model")
+	}
+
+	payload, err := json.Marshal(data)
+	if err != nil {
+		return err
+	}
+
+	query, args, err := buildUpdateSql(model, payload, conditions)
+	if err != nil {
+		return err
+	}
+	return tx.queryResult(query, args, result)
+}
+
+// Delete remove row of model that match the conditions
+func (tx *Tx) Delete(model any, conditions ...Condition) error {
+	model, _, err := getSqlModel(model)
+	if err != nil {
+		return err
+	}
+
+	query, args, err := buildDeleteSql(model, conditions)
+	if err != nil {
+		return err
+	}
+	return tx.Exec(query, args...)
+}
+
+// Exec run raw sql statement in the transaction
+func (tx *Tx) Exec(query string, args ...any) error {
+	raiden.Debug("db.transaction", "query", query)
+	_, err := tx.tx.ExecContext(tx.ctx, query, args...)
+	return err
+}
+
+func (tx *Tx) queryResult(query string, args []any, result any) error {
+	data, err := tx.queryJson(query, args)
+	if err != nil {
+		return err
+	}
+	return bindSqlResult(data, result)
+}
+
+// queryJson run query that return one json value
+func (tx *Tx) queryJson(query string, args []any) ([]byte, error) {
+	raiden.Debug("db.transaction", "query", query)
+
+	var data []byte
+	if err := tx.tx.QueryRowContext(tx.ctx, query, args...).Scan(&data); err != nil {
+		return nil, err
+	}
+	return data, nil
+}
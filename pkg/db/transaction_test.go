@@ -0,0 +1,62 @@
+package db
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/sev-2/raiden"
+	"github.com/stretchr/testify/assert"
+	"github.com/valyala/fasthttp"
+)
+
+func TestTransaction_WithoutContext(t *testing.T) {
+	SetConfig(&raiden.Config{DatabaseUrl: "postgres://localhost/db"})
+	defer SetConfig(nil)
+
+	called := false
+	err := Transaction(nil, func(tx *Tx) error {
+		called = true
+		return nil
+	})
+	assert.EqualError(t, err, "transaction require request context, use TransactionAsSystem to run as database user")
+	assert.False(t, called)
+}
+
+func TestTransaction_WithoutJwtSecret(t *testing.T) {
+	SetConfig(&raiden.Config{DatabaseUrl: "postgres://localhost/db"})
+	defer SetConfig(nil)
+
+	ctx := &raiden.Ctx{RequestCtx: &fasthttp.RequestCtx{}}
+	ctx.RequestContext().Request.Header.Set("Authorization", "Bearer token")
+
+	err := Transaction(ctx, func(tx *Tx) error { return nil })
+	assert.EqualError(t, err, "jwt secret is not configured, set JWT_SECRET in config file or RAIDEN_JWT_SECRET environment variable to verify request token")
+}
+
+func TestTransactionAsSystem_WithoutDatabaseUrl(t *testing.T) {
+	SetConfig(&raiden.Config{})
+	defer SetConfig(nil)
+
+	called := false
+	err := TransactionAsSystem(context.Background(), func(tx *Tx) error {
+		called = true
+		return nil
+	})
+	assert.EqualError(t, err, "database url is not configured, set DATABASE_URL in config file or RAIDEN_DATABASE_URL environment variable to use direct postgres connection")
+	assert.False(t, called)
+}
+
+func TestGetRequestContext(t *testing.T) {
+	assert.Equal(t, context.Background(), getRequestContext(nil))
+
+	requestCtx := &fasthttp.RequestCtx{}
+	assert.Equal(t, requestCtx, getRequestContext(&raiden.Ctx{RequestCtx: requestCtx}))
+
+	deadline, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+
+	ctx := &raiden.Ctx{RequestCtx: requestCtx}
+	ctx.SetCtx(deadline)
+	assert.Equal(t, deadline, getRequestContext(ctx))
+}
@@ -0,0 +1,157 @@
+package db
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+
+	"github.com/sev-2/raiden"
+)
+
+// TypedQuery is query of model T, column and filter value
+// is checked at compile time by column descriptor of the model
+type TypedQuery[T any] struct {
+	query *Query
+}
+
+// For create query of model T, for example :
+//
+//	db.For[models.Users](ctx).Where(models.UsersCols.Email.Eq(email)).All()
+func For[T any](ctx raiden.Context) *TypedQuery[T] {
+	return &TypedQuery[T]{query: NewQuery(ctx).From(new(T))}
+}
+
+// Query return underlying query, used for feature that not available in typed query
+func (q *TypedQuery[T]) Query() *Query {
+	return q.query
+}
+
+func (q *TypedQuery[T]) AsSystem() *TypedQuery[T] {
+	q.query.AsSystem()
+	return q
+}
+
+func (q *TypedQuery[T]) SetCredential(credential Credential) *TypedQuery[T] {
+	q.query.SetCredential(credential)
+	return q
+}
+
+func (q *TypedQuery[T]) Select(columns ...ModelColumn[T]) *TypedQuery[T] {
+	for _, c := range columns {
+		q.query.Columns = append(q.query.Columns, c.Name())
+	}
+	return q
+}
+
+// Where add filter that all of them must be matched
+func (q *TypedQuery[T]) Where(filters ...Filter[T]) *TypedQuery[T] {
+	for _, f := range filters {
+		q.query.whereAnd(f.condition)
+	}
+	return q
+}
+
+// OrWhere add filter that one of them must be matched
+func (q *TypedQuery[T]) OrWhere(filters ...Filter[T]) *TypedQuery[T] {
+	for _, f := range filters {
+		q.query.whereOr(f.condition)
+	}
+	return q
+}
+
+func (q *TypedQuery[T]) OrderAsc(column ModelColumn[T]) *TypedQuery[T] {
+	q.query.OrderAsc(column.Name())
+	return q
+}
+
+func (q *TypedQuery[T]) OrderDesc(column ModelColumn[T]) *TypedQuery[T] {
+	q.query.OrderDesc(column.Name())
+	return q
+}
+
+func (q *TypedQuery[T]) Limit(value int) *TypedQuery[T] {
+	q.query.Limit(value)
+	return q
+}
+
+func (q *TypedQuery[T]) Offset(value int) *TypedQuery[T] {
+	q.query.Offset(value)
+	return q
+}
+
+func (q *TypedQuery[T]) All() ([]T, error) {
+	var rows []T
+	if err := q.query.Get(&rows); err != nil {
+		return nil, err
+	}
+	return rows, nil
+}
+
+func (q *TypedQuery[T]) Single() (T, error) {
+	var row T
+	err := q.query.Single(&row)
+	return row, err
+}
+
+// Insert create rows and return created rows
+func (q *TypedQuery[T]) Insert(data ...T) ([]T, error) {
+	var rows []T
+	if err := q.query.Insert(data, &rows); err != nil {
+		return nil, err
+	}
+	return rows, nil
+}
+
+// Update change rows that match the filter and return updated rows,
+// when columns is set only the given columns is updated
+func (q *TypedQuery[T]) Update(data T, columns ...ModelColumn[T]) ([]T, error) {
+	payload, err := buildTypedPayload(data, columns)
+	if err != nil {
+		return nil, err
+	}
+
+	var rows []T
+	if err := q.query.Update(json.RawMessage(payload), &rows); err != nil {
+		return nil, err
+	}
+	return rows, nil
+}
+
+func (q *TypedQuery[T]) Delete() error {
+	return q.query.Delete()
+}
+
+// buildTypedPayload encode data as json object, when columns is set only the given
+// columns is encoded including zero value that is omitted by json tag
+func buildTypedPayload[T any](data T, columns []ModelColumn[T]) ([]byte, error) {
+	if len(columns) == 0 {
+		return json.Marshal(data)
+	}
+
+	rv := reflect.ValueOf(data)
+	if rv.Kind() == reflect.Ptr {
+		rv = rv.Elem()
+	}
+
+	mapField := make(map[string]reflect.Value)
+	for i := 0; i < rv.NumField(); i++ {
+		tag := rv.Type().Field(i).Tag.Get("column")
+		if tag == "" {
+			continue
+		}
+
+		if ct := raiden.UnmarshalColumnTag(tag); ct.Name != "" {
+			mapField[ct.Name] = rv.Field(i)
+		}
+	}
+
+	payload := make(map[string]any)
+	for _, c := range columns {
+		field, exist := mapField[c.Name()]
+		if !exist {
+			return nil, fmt.Errorf("invalid column: \"%s\" is not available on \"%s\" table", c.Name(), GetTable(data))
+		}
+		payload[c.Name()] = field.Interface()
+	}
+	return json.Marshal(payload)
+}
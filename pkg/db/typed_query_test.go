@@ -0,0 +1,41 @@
+package db
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTypedQuery(t *testing.T) {
+	q := For[ArticleMockModel](&mockRaidenContext).
+		Select(articleMockCols.Id, articleMockCols.Title).
+		Where(articleMockCols.IsFeatured.Eq(true), articleMockCols.Id.Gt(10)).
+		OrWhere(articleMockCols.Title.Eq("hello"), articleMockCols.Body.IsNull()).
+		OrderDesc(articleMockCols.CreatedAt).
+		Limit(10).
+		Offset(20)
+
+	assert.Equal(t, "/rest/v1/articles?select=id,title&is_featured=eq.true&id=gt.10&or=(title.eq.hello,body.is.null)&order=created_at.desc&limit=10&offset=20", q.Query().GetUrl())
+}
+
+func TestTypedQuery_AsSystem(t *testing.T) {
+	q := For[ArticleMockModel](nil).AsSystem()
+	assert.True(t, q.Query().ByPass)
+	assert.Equal(t, "articles?select=*", q.Query().GetQueryURI())
+}
+
+func TestBuildTypedPayload(t *testing.T) {
+	data := ArticleMockModel{Id: 1, Title: "hello", IsFeatured: false}
+
+	payload, err := buildTypedPayload(data, nil)
+	assert.NoError(t, err)
+	assert.NotContains(t, string(payload), "is_featured")
+
+	payload, err = buildTypedPayload(data, []ModelColumn[ArticleMockModel]{articleMockCols.Title, articleMockCols.IsFeatured})
+	assert.NoError(t, err)
+	assert.JSONEq(t, `{"title":"hello","is_featured":false}`, string(payload))
+
+	invalidColumn := NewColumn[ArticleMockModel, string]("unknown")
+	_, err = buildTypedPayload(data, []ModelColumn[ArticleMockModel]{invalidColumn})
+	assert.EqualError(t, err, `invalid column: "unknown" is not available on "articles" table`)
+}
@@ -14,6 +14,10 @@ func (q *Query) Update(p interface{}, model interface{}) error {
 		return err
 	}
 
+	if usePostgresDriver() {
+		return q.postgresUpdate(jsonData, model)
+	}
+
 	url := q.GetUrl()
 
 	var cols []string
@@ -24,12 +28,16 @@ func (q *Query) Update(p interface{}, model interface{}) error {
 		t = t.Elem()
 	}
 
-	for i := 0; i < t.NumField(); i++ {
-		field := t.Field(i)
-		cols = append(cols, field.Name)
+	if t.Kind() == reflect.Struct {
+		for i := 0; i < t.NumField(); i++ {
+			field := t.Field(i)
+			cols = append(cols, field.Name)
+		}
 	}
 
-	url = url + "&" + strings.Join(cols, ",")
+	if len(cols) > 0 {
+		url = url + "&" + strings.Join(cols, ",")
+	}
 
 	headers := make(map[string]string)
 	headers["Content-Type"] = "application/json"
@@ -21,6 +21,10 @@ func (q *Query) Upsert(payload []interface{}, opt UpsertOptions) error {
 		return err
 	}
 
+	if usePostgresDriver() {
+		return q.postgresInsert(jsonData, opt.OnConflict, nil)
+	}
+
 	url := q.GetUrl()
 
 	headers := make(map[string]string)
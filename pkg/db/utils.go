@@ -9,7 +9,19 @@ import (
 	"github.com/sev-2/raiden"
 )
 
+var queryConfig *raiden.Config
+
+// SetConfig set configuration used by query instead of loading app.yaml
+// from current directory, used when query run outside raiden app
+func SetConfig(config *raiden.Config) {
+	queryConfig = config
+}
+
 func getConfig() *raiden.Config {
+	if queryConfig != nil {
+		return queryConfig
+	}
+
 	currentDir, err := os.Getwd()
 	if err != nil {
 		log.Println(err)
@@ -3,6 +3,8 @@ package db
 import (
 	"strings"
 	"testing"
+
+	"github.com/sev-2/raiden"
 )
 
 func TestKeyExist(t *testing.T) {
@@ -46,3 +48,13 @@ func TestReverseSortString(t *testing.T) {
 		})
 	}
 }
+
+func TestSetConfig(t *testing.T) {
+	config := &raiden.Config{Mode: raiden.SvcMode, PostgRestUrl: "http://localhost:3000"}
+	SetConfig(config)
+	defer SetConfig(nil)
+
+	if getConfig() != config {
+		t.Errorf("Expected query use configuration from SetConfig")
+	}
+}
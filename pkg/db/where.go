@@ -18,6 +18,8 @@ func (q *Query) Eq(column string, value any) *Query {
 		fmt.Sprintf("%s=eq.%s", column, getStringValue(value)),
 	)
 
+	q.andConditions = append(q.andConditions, newCondition(column, "eq", getStringValue(value), formatColumnValue(value)))
+
 	return q
 }
 
@@ -32,6 +34,8 @@ func (q *Query) NotEq(column string, value any) *Query {
 		fmt.Sprintf("%s=not.eq.%s", column, getStringValue(value)),
 	)
 
+	q.andConditions = append(q.andConditions, newCondition(column, "not.eq", getStringValue(value), formatColumnValue(value)))
+
 	return q
 }
 
@@ -46,6 +50,8 @@ func (q *Query) OrEq(column string, value any) *Query {
 		fmt.Sprintf("%s.eq.%s", column, getStringValue(value)),
 	)
 
+	q.orConditions = append(q.orConditions, newCondition(column, "eq", getStringValue(value), formatColumnValue(value)))
+
 	return q
 }
 
@@ -60,6 +66,8 @@ func (q *Query) Neq(column string, value any) *Query {
 		fmt.Sprintf("%s=neq.%s", column, getStringValue(value)),
 	)
 
+	q.andConditions = append(q.andConditions, newCondition(column, "neq", getStringValue(value), formatColumnValue(value)))
+
 	return q
 }
 
@@ -74,6 +82,8 @@ func (q *Query) NotNeq(column string, value any) *Query {
 		fmt.Sprintf("%s=not.neq.%s", column, getStringValue(value)),
 	)
 
+	q.andConditions = append(q.andConditions, newCondition(column, "not.neq", getStringValue(value), formatColumnValue(value)))
+
 	return q
 }
 
@@ -88,6 +98,8 @@ func (q *Query) OrNeq(column string, value any) *Query {
 		fmt.Sprintf("%s.neq.%s", column, getStringValue(value)),
 	)
 
+	q.orConditions = append(q.orConditions, newCondition(column, "neq", getStringValue(value), formatColumnValue(value)))
+
 	return q
 }
 
@@ -102,6 +114,8 @@ func (q *Query) Lt(column string, value any) *Query {
 		fmt.Sprintf("%s=lt.%s", column, getStringValue(value)),
 	)
 
+	q.andConditions = append(q.andConditions, newCondition(column, "lt", getStringValue(value), formatColumnValue(value)))
+
 	return q
 }
 
@@ -116,6 +130,8 @@ func (q *Query) NotLt(column string, value any) *Query {
 		fmt.Sprintf("%s=not.lt.%s", column, getStringValue(value)),
 	)
 
+	q.andConditions = append(q.andConditions, newCondition(column, "not.lt", getStringValue(value), formatColumnValue(value)))
+
 	return q
 }
 
@@ -130,6 +146,8 @@ func (q *Query) OrLt(column string, value any) *Query {
 		fmt.Sprintf("%s.lt.%s", column, getStringValue(value)),
 	)
 
+	q.orConditions = append(q.orConditions, newCondition(column, "lt", getStringValue(value), formatColumnValue(value)))
+
 	return q
 }
 
@@ -144,6 +162,8 @@ func (q *Query) Lte(column string, value any) *Query {
 		fmt.Sprintf("%s=lte.%s", column, getStringValue(value)),
 	)
 
+	q.andConditions = append(q.andConditions, newCondition(column, "lte", getStringValue(value), formatColumnValue(value)))
+
 	return q
 }
 
@@ -158,6 +178,8 @@ func (q *Query) NotLte(column string, value any) *Query {
 		fmt.Sprintf("%s=not.lte.%s", column, getStringValue(value)),
 	)
 
+	q.andConditions = append(q.andConditions, newCondition(column, "not.lte", getStringValue(value), formatColumnValue(value)))
+
 	return q
 }
 
@@ -172,6 +194,8 @@ func (q *Query) OrLte(column string, value any) *Query {
 		fmt.Sprintf("%s.lte.%s", column, getStringValue(value)),
 	)
 
+	q.orConditions = append(q.orConditions, newCondition(column, "lte", getStringValue(value), formatColumnValue(value)))
+
 	return q
 }
 
@@ -186,6 +210,8 @@ func (q *Query) Gt(column string, value int) *Query {
 		fmt.Sprintf("%s=gt.%s", column, getStringValue(value)),
 	)
 
+	q.andConditions = append(q.andConditions, newCondition(column, "gt", getStringValue(value), formatColumnValue(value)))
+
 	return q
 }
 
@@ -200,6 +226,8 @@ func (q *Query) NotGt(column string, value int) *Query {
 		fmt.Sprintf("%s=not.gt.%s", column, getStringValue(value)),
 	)
 
+	q.andConditions = append(q.andConditions, newCondition(column, "not.gt", getStringValue(value), formatColumnValue(value)))
+
 	return q
 }
 
@@ -214,6 +242,8 @@ func (q *Query) OrGt(column string, value int) *Query {
 		fmt.Sprintf("%s.gt.%s", column, getStringValue(value)),
 	)
 
+	q.orConditions = append(q.orConditions, newCondition(column, "gt", getStringValue(value), formatColumnValue(value)))
+
 	return q
 }
 
@@ -228,6 +258,8 @@ func (q *Query) Gte(column string, value any) *Query {
 		fmt.Sprintf("%s=gte.%s", column, getStringValue(value)),
 	)
 
+	q.andConditions = append(q.andConditions, newCondition(column, "gte", getStringValue(value), formatColumnValue(value)))
+
 	return q
 }
 
@@ -242,6 +274,8 @@ func (q *Query) NotGte(column string, value any) *Query {
 		fmt.Sprintf("%s=not.gte.%s", column, getStringValue(value)),
 	)
 
+	q.andConditions = append(q.andConditions, newCondition(column, "not.gte", getStringValue(value), formatColumnValue(value)))
+
 	return q
 }
 
@@ -256,6 +290,8 @@ func (q *Query) OrGte(column string, value any) *Query {
 		fmt.Sprintf("%s.gte.%s", column, getStringValue(value)),
 	)
 
+	q.orConditions = append(q.orConditions, newCondition(column, "gte", getStringValue(value), formatColumnValue(value)))
+
 	return q
 }
 
@@ -272,6 +308,8 @@ func (q *Query) In(column string, value any) *Query {
 		fmt.Sprintf("%s=in.(%s)", column, strValues),
 	)
 
+	q.andConditions = append(q.andConditions, newCondition(column, "in", fmt.Sprintf("(%s)", strValues), SliceToStringSlice(value)))
+
 	return q
 }
 
@@ -288,6 +326,8 @@ func (q *Query) NotIn(column string, value any) *Query {
 		fmt.Sprintf("%s=not.in.(%s)", column, strValues),
 	)
 
+	q.andConditions = append(q.andConditions, newCondition(column, "not.in", fmt.Sprintf("(%s)", strValues), SliceToStringSlice(value)))
+
 	return q
 }
 
@@ -304,6 +344,8 @@ func (q *Query) OrIn(column string, value any) *Query {
 		fmt.Sprintf("%s.in.(%s)", column, strValues),
 	)
 
+	q.orConditions = append(q.orConditions, newCondition(column, "in", fmt.Sprintf("(%s)", strValues), SliceToStringSlice(value)))
+
 	return q
 }
 
@@ -313,13 +355,15 @@ func (q *Query) Like(column string, value string) *Query {
 		q.WhereAndList = &[]string{}
 	}
 
-	value = strings.ReplaceAll(value, "%", "*")
+	pattern := strings.ReplaceAll(value, "%", "*")
 
 	*q.WhereAndList = append(
 		*q.WhereAndList,
-		fmt.Sprintf("%s=like.%s", column, getStringWithSpace(value)),
+		fmt.Sprintf("%s=like.%s", column, getStringWithSpace(pattern)),
 	)
 
+	q.andConditions = append(q.andConditions, newCondition(column, "like", getStringWithSpace(pattern), value))
+
 	return q
 }
 
@@ -329,13 +373,15 @@ func (q *Query) NotLike(column string, value string) *Query {
 		q.WhereAndList = &[]string{}
 	}
 
-	value = strings.ReplaceAll(value, "%", "*")
+	pattern := strings.ReplaceAll(value, "%", "*")
 
 	*q.WhereAndList = append(
 		*q.WhereAndList,
-		fmt.Sprintf("%s=not.like.%s", column, getStringWithSpace(value)),
+		fmt.Sprintf("%s=not.like.%s", column, getStringWithSpace(pattern)),
 	)
 
+	q.andConditions = append(q.andConditions, newCondition(column, "not.like", getStringWithSpace(pattern), value))
+
 	return q
 }
 
@@ -345,13 +391,15 @@ func (q *Query) OrLike(column string, value string) *Query {
 		q.WhereOrList = &[]string{}
 	}
 
-	value = strings.ReplaceAll(value, "%", "*")
+	pattern := strings.ReplaceAll(value, "%", "*")
 
 	*q.WhereOrList = append(
 		*q.WhereOrList,
-		fmt.Sprintf("%s.like.%s", column, getStringWithSpace(value)),
+		fmt.Sprintf("%s.like.%s", column, getStringWithSpace(pattern)),
 	)
 
+	q.orConditions = append(q.orConditions, newCondition(column, "like", getStringWithSpace(pattern), value))
+
 	return q
 }
 
@@ -361,13 +409,15 @@ func (q *Query) Ilike(column string, value string) *Query {
 		q.WhereAndList = &[]string{}
 	}
 
-	value = strings.ReplaceAll(value, "%", "*")
+	pattern := strings.ReplaceAll(value, "%", "*")
 
 	*q.WhereAndList = append(
 		*q.WhereAndList,
-		fmt.Sprintf("%s=ilike.%s", column, getStringWithSpace(value)),
+		fmt.Sprintf("%s=ilike.%s", column, getStringWithSpace(pattern)),
 	)
 
+	q.andConditions = append(q.andConditions, newCondition(column, "ilike", getStringWithSpace(pattern), value))
+
 	return q
 }
 
@@ -377,13 +427,15 @@ func (q *Query) NotIlike(column string, value string) *Query {
 		q.WhereAndList = &[]string{}
 	}
 
-	value = strings.ReplaceAll(value, "%", "*")
+	pattern := strings.ReplaceAll(value, "%", "*")
 
 	*q.WhereAndList = append(
 		*q.WhereAndList,
-		fmt.Sprintf("%s=not.ilike.%s", column, getStringWithSpace(value)),
+		fmt.Sprintf("%s=not.ilike.%s", column, getStringWithSpace(pattern)),
 	)
 
+	q.andConditions = append(q.andConditions, newCondition(column, "not.ilike", getStringWithSpace(pattern), value))
+
 	return q
 }
 
@@ -393,13 +445,15 @@ func (q *Query) OrIlike(column string, value string) *Query {
 		q.WhereOrList = &[]string{}
 	}
 
-	value = strings.ReplaceAll(value, "%", "*")
+	pattern := strings.ReplaceAll(value, "%", "*")
 
 	*q.WhereOrList = append(
 		*q.WhereOrList,
-		fmt.Sprintf("%s.ilike.%s", column, getStringWithSpace(value)),
+		fmt.Sprintf("%s.ilike.%s", column, getStringWithSpace(pattern)),
 	)
 
+	q.orConditions = append(q.orConditions, newCondition(column, "ilike", getStringWithSpace(pattern), value))
+
 	return q
 }
 
@@ -418,6 +472,8 @@ func (q *Query) Is(column string, value any) *Query {
 		fmt.Sprintf("%s=is.%s", column, getWhitelistIsValue(value)),
 	)
 
+	q.andConditions = append(q.andConditions, newCondition(column, "is", getWhitelistIsValue(value), nil))
+
 	return q
 }
 
@@ -436,6 +492,235 @@ func (q *Query) NotIs(column string, value any) *Query {
 		fmt.Sprintf("%s=not.is.%s", column, getWhitelistIsValue(value)),
 	)
 
+	q.andConditions = append(q.andConditions, newCondition(column, "not.is", getWhitelistIsValue(value), nil))
+
+	return q
+}
+
+// ----- full text search -----
+
+// Fts filter tsvector column with to_tsquery, config is text search config
+// for example english and empty config use database default
+func (q *Query) Fts(column string, value string, config string) *Query {
+	return q.whereAnd(newFtsCondition(column, "fts", value, config))
+}
+
+func (q *Query) NotFts(column string, value string, config string) *Query {
+	return q.whereAnd(Not(newFtsCondition(column, "fts", value, config)))
+}
+
+func (q *Query) OrFts(column string, value string, config string) *Query {
+	return q.whereOr(newFtsCondition(column, "fts", value, config))
+}
+
+// Plfts filter tsvector column with plainto_tsquery
+func (q *Query) Plfts(column string, value string, config string) *Query {
+	return q.whereAnd(newFtsCondition(column, "plfts", value, config))
+}
+
+func (q *Query) NotPlfts(column string, value string, config string) *Query {
+	return q.whereAnd(Not(newFtsCondition(column, "plfts", value, config)))
+}
+
+func (q *Query) OrPlfts(column string, value string, config string) *Query {
+	return q.whereOr(newFtsCondition(column, "plfts", value, config))
+}
+
+// Phfts filter tsvector column with phraseto_tsquery
+func (q *Query) Phfts(column string, value string, config string) *Query {
+	return q.whereAnd(newFtsCondition(column, "phfts", value, config))
+}
+
+func (q *Query) NotPhfts(column string, value string, config string) *Query {
+	return q.whereAnd(Not(newFtsCondition(column, "phfts", value, config)))
+}
+
+func (q *Query) OrPhfts(column string, value string, config string) *Query {
+	return q.whereOr(newFtsCondition(column, "phfts", value, config))
+}
+
+// Wfts filter tsvector column with websearch_to_tsquery
+func (q *Query) Wfts(column string, value string, config string) *Query {
+	return q.whereAnd(newFtsCondition(column, "wfts", value, config))
+}
+
+func (q *Query) NotWfts(column string, value string, config string) *Query {
+	return q.whereAnd(Not(newFtsCondition(column, "wfts", value, config)))
+}
+
+func (q *Query) OrWfts(column string, value string, config string) *Query {
+	return q.whereOr(newFtsCondition(column, "wfts", value, config))
+}
+
+// ----- array and range -----
+
+// Cs filter array, range or json column that contains value,
+// value can be slice, Range or raw postgres literal
+func (q *Query) Cs(column string, value any) *Query {
+	return q.whereAnd(newArrayOrRangeCondition(column, "cs", value))
+}
+
+func (q *Query) NotCs(column string, value any) *Query {
+	return q.whereAnd(Not(newArrayOrRangeCondition(column, "cs", value)))
+}
+
+func (q *Query) OrCs(column string, value any) *Query {
+	return q.whereOr(newArrayOrRangeCondition(column, "cs", value))
+}
+
+// Cd filter array, range or json column that contained in value
+func (q *Query) Cd(column string, value any) *Query {
+	return q.whereAnd(newArrayOrRangeCondition(column, "cd", value))
+}
+
+func (q *Query) NotCd(column string, value any) *Query {
+	return q.whereAnd(Not(newArrayOrRangeCondition(column, "cd", value)))
+}
+
+func (q *Query) OrCd(column string, value any) *Query {
+	return q.whereOr(newArrayOrRangeCondition(column, "cd", value))
+}
+
+// Ov filter array or range column that overlap with value
+func (q *Query) Ov(column string, value any) *Query {
+	return q.whereAnd(newArrayOrRangeCondition(column, "ov", value))
+}
+
+func (q *Query) NotOv(column string, value any) *Query {
+	return q.whereAnd(Not(newArrayOrRangeCondition(column, "ov", value)))
+}
+
+func (q *Query) OrOv(column string, value any) *Query {
+	return q.whereOr(newArrayOrRangeCondition(column, "ov", value))
+}
+
+// Sl filter range column that strictly left of value
+func (q *Query) Sl(column string, value any) *Query {
+	return q.whereAnd(newArrayOrRangeCondition(column, "sl", value))
+}
+
+func (q *Query) NotSl(column string, value any) *Query {
+	return q.whereAnd(Not(newArrayOrRangeCondition(column, "sl", value)))
+}
+
+func (q *Query) OrSl(column string, value any) *Query {
+	return q.whereOr(newArrayOrRangeCondition(column, "sl", value))
+}
+
+// Sr filter range column that strictly right of value
+func (q *Query) Sr(column string, value any) *Query {
+	return q.whereAnd(newArrayOrRangeCondition(column, "sr", value))
+}
+
+func (q *Query) NotSr(column string, value any) *Query {
+	return q.whereAnd(Not(newArrayOrRangeCondition(column, "sr", value)))
+}
+
+func (q *Query) OrSr(column string, value any) *Query {
+	return q.whereOr(newArrayOrRangeCondition(column, "sr", value))
+}
+
+// Nxl filter range column that does not extend to the left of value
+func (q *Query) Nxl(column string, value any) *Query {
+	return q.whereAnd(newArrayOrRangeCondition(column, "nxl", value))
+}
+
+func (q *Query) NotNxl(column string, value any) *Query {
+	return q.whereAnd(Not(newArrayOrRangeCondition(column, "nxl", value)))
+}
+
+func (q *Query) OrNxl(column string, value any) *Query {
+	return q.whereOr(newArrayOrRangeCondition(column, "nxl", value))
+}
+
+// Nxr filter range column that does not extend to the right of value
+func (q *Query) Nxr(column string, value any) *Query {
+	return q.whereAnd(newArrayOrRangeCondition(column, "nxr", value))
+}
+
+func (q *Query) NotNxr(column string, value any) *Query {
+	return q.whereAnd(Not(newArrayOrRangeCondition(column, "nxr", value)))
+}
+
+func (q *Query) OrNxr(column string, value any) *Query {
+	return q.whereOr(newArrayOrRangeCondition(column, "nxr", value))
+}
+
+// Adj filter range column that adjacent to value
+func (q *Query) Adj(column string, value any) *Query {
+	return q.whereAnd(newArrayOrRangeCondition(column, "adj", value))
+}
+
+func (q *Query) NotAdj(column string, value any) *Query {
+	return q.whereAnd(Not(newArrayOrRangeCondition(column, "adj", value)))
+}
+
+func (q *Query) OrAdj(column string, value any) *Query {
+	return q.whereOr(newArrayOrRangeCondition(column, "adj", value))
+}
+
+// ----- pattern and distinct -----
+
+// Match filter column with case sensitive posix regular expression
+func (q *Query) Match(column string, pattern string) *Query {
+	return q.whereAnd(Match(column, pattern))
+}
+
+func (q *Query) NotMatch(column string, pattern string) *Query {
+	return q.whereAnd(Not(Match(column, pattern)))
+}
+
+func (q *Query) OrMatch(column string, pattern string) *Query {
+	return q.whereOr(Match(column, pattern))
+}
+
+// Imatch filter column with case insensitive posix regular expression
+func (q *Query) Imatch(column string, pattern string) *Query {
+	return q.whereAnd(Imatch(column, pattern))
+}
+
+func (q *Query) NotImatch(column string, pattern string) *Query {
+	return q.whereAnd(Not(Imatch(column, pattern)))
+}
+
+func (q *Query) OrImatch(column string, pattern string) *Query {
+	return q.whereOr(Imatch(column, pattern))
+}
+
+// IsDistinct filter column that is distinct from value, null is treated as comparable value
+func (q *Query) IsDistinct(column string, value any) *Query {
+	return q.whereAnd(newDistinctCondition(column, value))
+}
+
+func (q *Query) NotIsDistinct(column string, value any) *Query {
+	return q.whereAnd(Not(newDistinctCondition(column, value)))
+}
+
+func (q *Query) OrIsDistinct(column string, value any) *Query {
+	return q.whereOr(newDistinctCondition(column, value))
+}
+
+// whereAnd add condition as filter param, condition is kept
+// so postgres driver compile it to sql without parsing the param
+func (q *Query) whereAnd(c Condition) *Query {
+	if q.WhereAndList == nil {
+		q.WhereAndList = &[]string{}
+	}
+
+	*q.WhereAndList = append(*q.WhereAndList, c.param())
+
+	q.andConditions = append(q.andConditions, c)
+	return q
+}
+
+func (q *Query) whereOr(c Condition) *Query {
+	if q.WhereOrList == nil {
+		q.WhereOrList = &[]string{}
+	}
+
+	*q.WhereOrList = append(*q.WhereOrList, c.item())
+
+	q.orConditions = append(q.orConditions, c)
 	return q
 }
 
@@ -492,3 +777,97 @@ func getWhitelistIsValue(value any) string {
 
 	return ""
 }
+
+func getFtsOperator(operator string, config string) string {
+	if config == "" {
+		return operator
+	}
+	return fmt.Sprintf("%s(%s)", operator, config)
+}
+
+// escapeFilterValue encode character that has meaning in url query,
+// so search text like "fat & rat" is sent as single value
+func escapeFilterValue(value string) string {
+	return filterValueReplacer.Replace(value)
+}
+
+var filterValueReplacer = strings.NewReplacer(
+	"%", "%25",
+	" ", "%20",
+	"&", "%26",
+	"#", "%23",
+	"+", "%2B",
+)
+
+func getDistinctValue(value any) string {
+	if value == nil {
+		return "null"
+	}
+	return escapeFilterValue(formatColumnValue(value))
+}
+
+// getArrayOrRangeValue convert value to encoded postgres literal for filter param
+func getArrayOrRangeValue(value any) string {
+	return escapeFilterValue(getArrayOrRangeLiteral(value))
+}
+
+// getArrayOrRangeLiteral convert value to postgres literal, slice is converted
+// to array literal for example {a,b} and Range to range literal for example [1,10)
+func getArrayOrRangeLiteral(value any) string {
+	switch v := value.(type) {
+	case Range:
+		return v.String()
+	case *Range:
+		return v.String()
+	case string:
+		return v
+	}
+
+	rv := reflect.ValueOf(value)
+	if rv.Kind() != reflect.Slice && rv.Kind() != reflect.Array {
+		return formatColumnValue(value)
+	}
+
+	items := make([]string, 0, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		items = append(items, quoteLiteralValue(formatColumnValue(rv.Index(i).Interface()), ",{}\\\" "))
+	}
+	return fmt.Sprintf("{%s}", strings.Join(items, ","))
+}
+
+// quoteLiteralValue quote value of array or range literal that contain reserved character
+func quoteLiteralValue(value string, reserved string) string {
+	if value == "" || strings.ContainsAny(value, reserved) {
+		value = strings.ReplaceAll(strings.ReplaceAll(value, `\`, `\\`), `"`, `\"`)
+		return fmt.Sprintf(`"%s"`, value)
+	}
+	return value
+}
+
+// Range is range value for range operator, nil bound is unbounded, for example
+// Range{Lower: 1, Upper: 10, LowerInclusive: true} is converted to [1,10)
+type Range struct {
+	Lower          any
+	Upper          any
+	LowerInclusive bool
+	UpperInclusive bool
+}
+
+func (r Range) String() string {
+	lowerBracket, upperBracket := "(", ")"
+	if r.LowerInclusive {
+		lowerBracket = "["
+	}
+	if r.UpperInclusive {
+		upperBracket = "]"
+	}
+
+	return fmt.Sprintf("%s%s,%s%s", lowerBracket, getRangeBound(r.Lower), getRangeBound(r.Upper), upperBracket)
+}
+
+func getRangeBound(value any) string {
+	if value == nil {
+		return ""
+	}
+	return quoteLiteralValue(formatColumnValue(value), ",()[]\\\" ")
+}
@@ -2,6 +2,7 @@ package db
 
 import (
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 )
@@ -471,3 +472,102 @@ func TestNotIs(t *testing.T) {
 		assert.Equalf(t, "/rest/v1/articles?select=*&is_featured=not.is.true&rating=not.is.null", q.GetUrl(), "the url should match")
 	})
 }
+
+func TestFullTextSearch(t *testing.T) {
+	t.Run("fts with config", func(t *testing.T) {
+		q := NewQuery(&mockRaidenContext).Model(articleMockModel).Fts("body", "fat & rat", "english")
+		assert.Equalf(t, "articles?select=*&body=fts(english).fat%20%26%20rat", buildQueryURI(*q), "the url should match")
+	})
+
+	t.Run("fts without config", func(t *testing.T) {
+		q := NewQuery(&mockRaidenContext).Model(articleMockModel).NotFts("body", "cat", "")
+		assert.Equalf(t, "articles?select=*&body=not.fts.cat", buildQueryURI(*q), "the url should match")
+	})
+
+	t.Run("plfts, phfts and wfts", func(t *testing.T) {
+		q := NewQuery(&mockRaidenContext).
+			Model(articleMockModel).
+			Plfts("body", "fat rat", "").
+			NotPhfts("body", "the cat", "english").
+			Wfts("title", `"fat rat" -cat`, "english")
+
+		assert.Equalf(t, "articles?select=*&body=plfts.fat%20rat&body=not.phfts(english).the%20cat&title=wfts(english).\"fat%20rat\"%20-cat", buildQueryURI(*q), "the url should match")
+	})
+
+	t.Run("or fts", func(t *testing.T) {
+		q := NewQuery(&mockRaidenContext).Model(articleMockModel).OrFts("body", "cat", "english").OrWfts("title", "cat", "")
+		assert.Equalf(t, "articles?select=*&or=(body.fts(english).cat,title.wfts.cat)", buildQueryURI(*q), "the url should match")
+	})
+}
+
+func TestArrayOperator(t *testing.T) {
+	t.Run("contains slice", func(t *testing.T) {
+		q := NewQuery(&mockRaidenContext).Model(articleMockModel).Cs("tags", []string{"go", "supabase"})
+		assert.Equalf(t, "articles?select=*&tags=cs.{go,supabase}", buildQueryURI(*q), "the url should match")
+	})
+
+	t.Run("contained with quoted element", func(t *testing.T) {
+		q := NewQuery(&mockRaidenContext).Model(articleMockModel).Cd("tags", []string{"a,b", "c d", ""})
+		assert.Equalf(t, "articles?select=*&tags=cd.{\"a,b\",\"c%20d\",\"\"}", buildQueryURI(*q), "the url should match")
+	})
+
+	t.Run("overlap number and raw literal", func(t *testing.T) {
+		q := NewQuery(&mockRaidenContext).Model(articleMockModel).Ov("rating", []int{1, 2}).NotOv("tags", "{go}")
+		assert.Equalf(t, "articles?select=*&rating=ov.{1,2}&tags=not.ov.{go}", buildQueryURI(*q), "the url should match")
+	})
+
+	t.Run("or array", func(t *testing.T) {
+		q := NewQuery(&mockRaidenContext).Model(articleMockModel).OrCs("tags", []string{"go"}).OrCd("tags", []string{"a", "b"})
+		assert.Equalf(t, `articles?select=*&or=(tags.cs."{go}",tags.cd."{a,b}")`, buildQueryURI(*q), "the url should match")
+	})
+}
+
+func TestRangeOperator(t *testing.T) {
+	t.Run("range literal", func(t *testing.T) {
+		assert.Equal(t, "[1,10)", Range{Lower: 1, Upper: 10, LowerInclusive: true}.String())
+		assert.Equal(t, "(,5]", Range{Upper: 5, UpperInclusive: true}.String())
+		assert.Equal(t, "[2024-01-01T00:00:00Z,)", Range{Lower: time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC), LowerInclusive: true}.String())
+		assert.Equal(t, "[\"a b\",z)", Range{Lower: "a b", Upper: "z", LowerInclusive: true}.String())
+	})
+
+	t.Run("range operator", func(t *testing.T) {
+		r := Range{Lower: 1, Upper: 10, LowerInclusive: true}
+		q := NewQuery(&mockRaidenContext).
+			Model(articleMockModel).
+			Sl("during", r).
+			Sr("during", &r).
+			Nxl("during", r).
+			NotNxr("during", r).
+			Adj("during", "[10,20)")
+
+		assert.Equalf(t, "articles?select=*&during=sl.[1,10)&during=sr.[1,10)&during=nxl.[1,10)&during=not.nxr.[1,10)&during=adj.[10,20)", buildQueryURI(*q), "the url should match")
+	})
+
+	t.Run("or range", func(t *testing.T) {
+		r := Range{Lower: 1, Upper: 10}
+		q := NewQuery(&mockRaidenContext).Model(articleMockModel).OrSl("during", r).OrAdj("during", r).OrOv("during", r)
+		assert.Equalf(t, `articles?select=*&or=(during.sl."(1,10)",during.adj."(1,10)",during.ov."(1,10)")`, buildQueryURI(*q), "the url should match")
+	})
+}
+
+func TestMatchAndDistinct(t *testing.T) {
+	t.Run("match", func(t *testing.T) {
+		q := NewQuery(&mockRaidenContext).Model(articleMockModel).Match("name", "^supa").NotImatch("title", "base$")
+		assert.Equalf(t, "articles?select=*&name=match.^supa&title=not.imatch.base$", buildQueryURI(*q), "the url should match")
+	})
+
+	t.Run("match encode reserved character", func(t *testing.T) {
+		q := NewQuery(&mockRaidenContext).Model(articleMockModel).Imatch("name", "a+ b#")
+		assert.Equalf(t, "articles?select=*&name=imatch.a%2B%20b%23", buildQueryURI(*q), "the url should match")
+	})
+
+	t.Run("is distinct", func(t *testing.T) {
+		q := NewQuery(&mockRaidenContext).Model(articleMockModel).IsDistinct("rating", nil).NotIsDistinct("rating", 5)
+		assert.Equalf(t, "articles?select=*&rating=isdistinct.null&rating=not.isdistinct.5", buildQueryURI(*q), "the url should match")
+	})
+
+	t.Run("or match and distinct", func(t *testing.T) {
+		q := NewQuery(&mockRaidenContext).Model(articleMockModel).OrMatch("name", "^a").OrImatch("name", "^b").OrIsDistinct("rating", 1)
+		assert.Equalf(t, "articles?select=*&or=(name.match.^a,name.imatch.^b,rating.isdistinct.1)", buildQueryURI(*q), "the url should match")
+	})
+}
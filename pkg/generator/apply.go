@@ -60,8 +60,11 @@ func main() {
 			}
 
 			// register app resource
+			bootstrap.RegisterExtensions()
 			bootstrap.RegisterModels()
 			bootstrap.RegisterTypes()
+			bootstrap.RegisterTriggers()
+			bootstrap.RegisterViews()
 			bootstrap.RegisterRpc()
 			{{if eq .Mode "bff"}}
 			bootstrap.RegisterRoles()
@@ -82,6 +85,10 @@ func main() {
 	cmd.Flags().BoolVarP(&f.StoragesOnly, "storages-only", "", false, "apply storages only")
 	cmd.Flags().StringVarP(&f.AllowedSchema, "schema", "s", "", "set allowed schema to apply, use coma separator for multiple schema")
 	cmd.Flags().BoolVar(&f.DryRun, "dry-run", false, "run apply in simulate mode without actual running apply change")
+	cmd.Flags().StringVar(&f.EmitMigrations, "emit-migrations", "", "write up and down sql migration file of apply change to directory")
+	cmd.Flags().BoolVar(&f.Transactional, "transactional", false, "run all apply change as single script in one transaction")
+	cmd.Flags().StringVarP(&f.Output, "output", "o", "text", "set apply report format, available format is text and json")
+	cmd.Flags().BoolVar(&f.AllowDestructive, "allow-destructive", false, "allow apply change that drop table, column or other resource that hold data")
 
 	f.Generate.Bind(cmd)
 
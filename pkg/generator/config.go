@@ -49,6 +49,18 @@ VERSION: 1.0.0
 
 ALLOWED_TABLES: '{{ .AllowedTables }}'
 SCHEDULE_STATUS: '{{ .ScheduleStatus }}'
+{{- if ne .StateBackend ""}}
+STATE_BACKEND: {{ .StateBackend }}
+{{- end }}
+{{- if ne .DatabaseUrl ""}}
+DATABASE_URL: {{ .DatabaseUrl }}
+{{- end }}
+{{- if eq .QueryDriver "postgres"}}
+QUERY_DRIVER: {{ .QueryDriver }}
+{{- end }}
+{{- if ne .JwtSecret ""}}
+JWT_SECRET: {{ .JwtSecret }}
+{{- end }}
 {{- if ne .GoogleProjectId ""}}
 GOOGLE_PROJECT_ID: {{ .GoogleProjectId }}
 {{- end }}
@@ -0,0 +1,129 @@
+package generator
+
+import (
+	"fmt"
+	"path/filepath"
+	"strings"
+
+	"github.com/hashicorp/go-hclog"
+	"github.com/sev-2/raiden"
+	"github.com/sev-2/raiden/pkg/logger"
+	"github.com/sev-2/raiden/pkg/supabase/objects"
+	"github.com/sev-2/raiden/pkg/utils"
+)
+
+var ExtensionLogger hclog.Logger = logger.HcLog().Named("generator.extension")
+
+// ----- Define type, variable and constant -----
+type GenerateExtensionData struct {
+	Imports    []string
+	Package    string
+	StructName string
+	Name       string
+	Schema     string
+	Version    string
+}
+
+const (
+	ExtensionDir      = "internal/extensions"
+	ExtensionTemplate = `package {{ .Package }}
+{{- if gt (len .Imports) 0 }}
+
+import (
+{{- range .Imports}}
+	{{.}}
+{{- end}}
+)
+{{- end }}
+
+type {{ .StructName }} struct {
+	raiden.ExtensionBase
+}
+
+func (e *{{ .StructName }}) Name() string {
+	return "{{ .Name }}"
+}
+{{- if ne .Schema "` + raiden.DefaultExtensionSchema + `" }}
+
+func (e *{{ .StructName }}) Schema() string {
+	return "{{ .Schema }}"
+}
+{{- end }}
+{{- if ne .Version "" }}
+
+func (e *{{ .StructName }}) Version() string {
+	return "{{ .Version }}"
+}
+{{- end }}
+`
+)
+
+func GenerateExtensions(basePath string, extensions []objects.Extension, generateFn GenerateFn) (err error) {
+	folderPath := filepath.Join(basePath, ExtensionDir)
+	ExtensionLogger.Trace("create extensions folder if not exist", folderPath)
+	if exist := utils.IsFolderExists(folderPath); !exist {
+		if err := utils.CreateFolder(folderPath); err != nil {
+			return err
+		}
+	}
+
+	for _, v := range extensions {
+		if err := GenerateExtension(folderPath, v, generateFn); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func GenerateExtension(folderPath string, e objects.Extension, generateFn GenerateFn) error {
+	// define file path
+	filePath := filepath.Join(folderPath, fmt.Sprintf("%s.%s", GetExtensionFileName(e), "go"))
+
+	// set imports path
+	var imports []string
+	raidenPath := fmt.Sprintf("%q", "github.com/sev-2/raiden")
+	imports = append(imports, raidenPath)
+
+	// execute the template and write to the file
+	data := GenerateExtensionData{
+		Package:    "extensions",
+		Imports:    imports,
+		StructName: GetExtensionStructName(e),
+		Name:       e.Name,
+		Schema:     e.Schema,
+	}
+
+	if data.Schema == "" {
+		data.Schema = raiden.DefaultExtensionSchema
+	}
+
+	// only pin version when installed version is not the default one
+	if e.InstalledVersion != "" && e.InstalledVersion != e.DefaultVersion {
+		data.Version = e.InstalledVersion
+	}
+
+	// set input
+	input := GenerateInput{
+		BindData:     data,
+		Template:     ExtensionTemplate,
+		TemplateName: "extensionTemplate",
+		OutputPath:   filePath,
+	}
+
+	// setup writer
+	writer := &FileWriter{FilePath: input.OutputPath}
+
+	ExtensionLogger.Debug("generate extension", "path", input.OutputPath)
+	return generateFn(input, writer)
+}
+
+// GetExtensionStructName return go struct name of extension,
+// some extension name contain dash (ex: uuid-ossp)
+func GetExtensionStructName(e objects.Extension) string {
+	return utils.SnakeCaseToPascalCase(GetExtensionFileName(e))
+}
+
+func GetExtensionFileName(e objects.Extension) string {
+	return utils.ToSnakeCase(strings.ReplaceAll(e.Name, "-", "_"))
+}
@@ -0,0 +1,134 @@
+package generator
+
+import (
+	"fmt"
+	"io/fs"
+	"path/filepath"
+	"strings"
+
+	"github.com/hashicorp/go-hclog"
+	"github.com/sev-2/raiden/pkg/logger"
+	"github.com/sev-2/raiden/pkg/utils"
+)
+
+var ExtensionRegisterLogger hclog.Logger = logger.HcLog().Named("generator.extension_register")
+
+// ----- Define type, variable and constant -----
+type (
+	GenerateRegisterExtensionData struct {
+		Imports    []string
+		Package    string
+		Extensions []string
+	}
+)
+
+const (
+	ExtensionRegisterFilename = "extensions.go"
+	ExtensionRegisterDir      = "internal/bootstrap"
+	ExtensionRegisterTemplate = `// Code generated by raiden-cli; DO NOT EDIT.
+package {{ .Package }}
+{{if gt (len .Imports) 0 }}
+import (
+{{- range .Imports}}
+	{{.}}
+{{- end}}
+)
+{{end }}
+func RegisterExtensions() {
+	resource.RegisterExtensions(
+		{{- range .Extensions}}
+		&extensions.{{.}}{},
+		{{- end}}
+	)
+}
+`
+)
+
+func GenerateExtensionRegister(basePath string, projectName string, generateFn GenerateFn) error {
+	extensionRegisterDir := filepath.Join(basePath, ExtensionRegisterDir)
+	ExtensionRegisterLogger.Trace("create bootstrap folder if not exist", extensionRegisterDir)
+	if exist := utils.IsFolderExists(extensionRegisterDir); !exist {
+		if err := utils.CreateFolder(extensionRegisterDir); err != nil {
+			return err
+		}
+	}
+
+	extensionDir := filepath.Join(basePath, ExtensionDir)
+	ExtensionRegisterLogger.Trace("create extensions folder if not exist", extensionDir)
+	if exist := utils.IsFolderExists(extensionDir); !exist {
+		if err := utils.CreateFolder(extensionDir); err != nil {
+			return err
+		}
+	}
+
+	// scan all extension
+	extensionList, err := WalkScanExtension(extensionDir)
+	if err != nil {
+		return err
+	}
+
+	input, err := createExtensionRegisterInput(projectName, extensionRegisterDir, extensionList)
+	if err != nil {
+		return err
+	}
+
+	// setup writer
+	writer := &FileWriter{FilePath: input.OutputPath}
+
+	ExtensionRegisterLogger.Debug("generate extension register", "path", input.OutputPath)
+	return generateFn(input, writer)
+}
+
+func createExtensionRegisterInput(projectName string, extensionRegisterDir string, extensionList []string) (input GenerateInput, err error) {
+	// set file path
+	filePath := filepath.Join(extensionRegisterDir, ExtensionRegisterFilename)
+
+	// set imports path
+	imports := []string{
+		fmt.Sprintf("%q", "github.com/sev-2/raiden/pkg/resource"),
+	}
+
+	if len(extensionList) > 0 {
+		extensionsImportPath := fmt.Sprintf("%s/internal/extensions", utils.ToGoModuleName(projectName))
+		imports = append(imports, fmt.Sprintf("%q", extensionsImportPath))
+	}
+
+	// set passed parameter
+	data := GenerateRegisterExtensionData{
+		Package:    "bootstrap",
+		Imports:    imports,
+		Extensions: extensionList,
+	}
+
+	input = GenerateInput{
+		BindData:     data,
+		Template:     ExtensionRegisterTemplate,
+		TemplateName: "extensionRegisterTemplate",
+		OutputPath:   filePath,
+	}
+
+	return
+}
+
+func WalkScanExtension(extensionDir string) ([]string, error) {
+	ExtensionRegisterLogger.Trace("scan registered all extensions", "path", extensionDir)
+
+	extensions := make([]string, 0)
+	err := filepath.Walk(extensionDir, func(path string, info fs.FileInfo, err error) error {
+		if strings.HasSuffix(path, ".go") {
+			ExtensionRegisterLogger.Trace("collect extensions", "file-path", path)
+			rs, e := getStructByBaseName(path, "ExtensionBase")
+			if e != nil {
+				return e
+			}
+
+			extensions = append(extensions, rs...)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return extensions, nil
+}
@@ -0,0 +1,47 @@
+package generator_test
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/sev-2/raiden/pkg/generator"
+	"github.com/sev-2/raiden/pkg/supabase/objects"
+	"github.com/sev-2/raiden/pkg/utils"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGenerateExtensions(t *testing.T) {
+	dir, err := os.MkdirTemp("", "extension")
+	assert.NoError(t, err)
+
+	internalPath := filepath.Join(dir, "internal")
+	err1 := utils.CreateFolder(internalPath)
+	assert.NoError(t, err1)
+
+	extensions := []objects.Extension{
+		{Name: "uuid-ossp", Schema: "extensions", DefaultVersion: "1.1", InstalledVersion: "1.1"},
+		{Name: "pg_trgm", Schema: "public", DefaultVersion: "1.6", InstalledVersion: "1.5"},
+	}
+
+	err2 := generator.GenerateExtensions(dir, extensions, generator.GenerateFn(generator.Generate))
+	assert.NoError(t, err2)
+	assert.FileExists(t, dir+"/internal/extensions/uuid_ossp.go")
+	assert.FileExists(t, dir+"/internal/extensions/pg_trgm.go")
+
+	content, err3 := os.ReadFile(dir + "/internal/extensions/uuid_ossp.go")
+	assert.NoError(t, err3)
+	assert.Contains(t, string(content), "type UuidOssp struct")
+	assert.Contains(t, string(content), `return "uuid-ossp"`)
+	assert.NotContains(t, string(content), "Schema()")
+	assert.NotContains(t, string(content), "Version()")
+
+	content, err4 := os.ReadFile(dir + "/internal/extensions/pg_trgm.go")
+	assert.NoError(t, err4)
+	assert.Contains(t, string(content), `return "public"`)
+	assert.Contains(t, string(content), `return "1.5"`)
+
+	structs, err5 := generator.WalkScanExtension(dir + "/internal/extensions")
+	assert.NoError(t, err5)
+	assert.ElementsMatch(t, []string{"UuidOssp", "PgTrgm"}, structs)
+}
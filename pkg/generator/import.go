@@ -55,8 +55,11 @@ func main() {
 			}
 
 			// register app resource
+			bootstrap.RegisterExtensions()
 			bootstrap.RegisterModels()
 			bootstrap.RegisterTypes()
+			bootstrap.RegisterTriggers()
+			bootstrap.RegisterViews()
 			{{if eq .Mode "bff"}}
 			bootstrap.RegisterRpc()
 			bootstrap.RegisterRoles()
@@ -69,9 +72,12 @@ func main() {
 
 			if err := resource.Import(&f, config); err != nil {
 				imports.ImportLogger.Error(err.Error())
+				if f.Check {
+					os.Exit(1)
+				}
 			}
 
-			if !f.DryRun {
+			if !f.DryRun && !f.Check {
 				imports.ImportLogger.Info("regenerate bootstrap file")
 				if err = generate.Run(&f.Generate, config, f.ProjectPath, false); err != nil {
 					imports.ImportLogger.Error(err.Error())
@@ -90,6 +96,8 @@ func main() {
 	cmd.Flags().BoolVarP(&f.StoragesOnly, "storages-only", "", false, "import storages only")
 	cmd.Flags().StringVarP(&f.AllowedSchema, "schema", "s", "", "set allowed schema to import, use coma separator for multiple schema")
 	cmd.Flags().BoolVar(&f.DryRun, "dry-run", false, "run import in simulate mode without actual import resource as code")
+	cmd.Flags().StringVarP(&f.Output, "output", "o", "text", "set import report format, available format is text and json")
+	cmd.Flags().BoolVar(&f.Check, "check", false, "check drift between database and local resource without import, exit with non zero code when drift is found")
 
 	f.Generate.Bind(cmd)
 
@@ -123,6 +131,7 @@ func GenerateImportMainFunction(basePath string, config *raiden.Config, generate
 
 	// setup import path
 	importPaths := []string{
+		fmt.Sprintf("%q", "os"),
 		fmt.Sprintf("%q", "github.com/sev-2/raiden"),
 		fmt.Sprintf("%q", "github.com/sev-2/raiden/pkg/cli/generate"),
 		fmt.Sprintf("%q", "github.com/sev-2/raiden/pkg/cli/imports"),
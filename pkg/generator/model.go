@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"path/filepath"
 	"sort"
+	"strconv"
 	"strings"
 	"text/template"
 	"unicode"
@@ -30,16 +31,20 @@ type (
 	}
 
 	GenerateModelData struct {
-		Columns    []GenerateModelColumn
-		Imports    []string
-		Package    string
-		Relations  []state.Relation
-		RlsTag     string
-		RlsEnable  bool
-		RlsForced  bool
-		StructName string
-		Schema     string
-		TableName  string
+		Columns      []GenerateModelColumn
+		Imports      []string
+		Indexes      []string
+		Publications []string
+		Realtime     bool
+		Comment      string
+		Package      string
+		Relations    []state.Relation
+		RlsTag       string
+		RlsEnable    bool
+		RlsForced    bool
+		StructName   string
+		Schema       string
+		TableName    string
 	}
 
 	GenerateModelInput struct {
@@ -70,7 +75,7 @@ type {{ .StructName }} struct {
 {{- end }}
 
 	// Table information
-	Metadata string ` + "`json:\"-\" schema:\"{{ .Schema}}\" tableName:\"{{ .TableName }}\" rlsEnable:\"{{ .RlsEnable }}\" rlsForced:\"{{ .RlsForced }}\"`" + `
+	Metadata string ` + "`json:\"-\" schema:\"{{ .Schema}}\" tableName:\"{{ .TableName }}\" rlsEnable:\"{{ .RlsEnable }}\" rlsForced:\"{{ .RlsForced }}\"{{ if .Realtime }} realtime:\"true\"{{ end }}{{ if .Comment }} comment:{{ .Comment }}{{ end }}`" + `
 
 	// Access control
 	Acl string ` + "`json:\"-\" {{ .RlsTag }}`" + `
@@ -83,6 +88,39 @@ type {{ .StructName }} struct {
 	{{ .Table | ToGoIdentifier }} {{ .Type }} ` + "`{{ .Tag }}`" + `
 {{- end }}
 }
+{{- if gt (len .Columns) 0 }}
+
+// {{ .StructName }}Cols is column descriptor of {{ .StructName }} for type safe query
+var {{ .StructName }}Cols = struct {
+{{- range .Columns }}
+	{{ .Name | ToGoIdentifier }} db.{{ .Type | ToColumnKind }}[{{ $.StructName }}, {{ .Type }}]
+{{- end }}
+}{
+{{- range .Columns }}
+	{{ .Name | ToGoIdentifier }}: db.New{{ .Type | ToColumnKind }}[{{ $.StructName }}, {{ .Type }}]("{{ .Name }}"),
+{{- end }}
+}
+{{- end }}
+{{- if gt (len .Indexes) 0 }}
+
+func (m *{{ .StructName }}) Indexes() []raiden.Index {
+	return []raiden.Index{
+{{- range .Indexes }}
+		{{ . }},
+{{- end }}
+	}
+}
+{{- end }}
+{{- if gt (len .Publications) 0 }}
+
+func (m *{{ .StructName }}) Publications() []raiden.Publication {
+	return []raiden.Publication{
+{{- range .Publications }}
+		{{ . }},
+{{- end }}
+	}
+}
+{{- end }}
 `
 )
 
@@ -110,6 +148,7 @@ func GenerateModel(folderPath string, projectName string, input *GenerateModelIn
 	funcMaps := []template.FuncMap{
 		{"ToGoIdentifier": utils.SnakeCaseToPascalCase},
 		{"ToSnakeCase": utils.ToSnakeCase},
+		{"ToColumnKind": toColumnKind},
 	}
 
 	// map column data
@@ -118,6 +157,23 @@ func GenerateModel(folderPath string, projectName string, input *GenerateModelIn
 	raidenPkgDbPath := "github.com/sev-2/raiden/pkg/db"
 	importsPath = append(importsPath, raidenPkgDbPath)
 
+	// build index declaration, simple single column index is declared in column tag
+	indexes := BuildModelIndexes(input.Table)
+
+	// build publication declaration, default publication without column list
+	// and row filter is declared with realtime tag in metadata
+	realtime, publications := BuildModelPublications(input.Table)
+	if len(indexes) > 0 || len(publications) > 0 {
+		importsPath = append(importsPath, "github.com/sev-2/raiden")
+		sort.Strings(importsPath)
+	}
+
+	// table comment is written as quoted tag value
+	var comment string
+	if c, isString := input.Table.Comment.(string); isString && c != "" {
+		comment = quoteTagValue(c)
+	}
+
 	// define file path
 	filePath := filepath.Join(folderPath, fmt.Sprintf("%s.%s", input.Table.Name, "go"))
 
@@ -126,16 +182,20 @@ func GenerateModel(folderPath string, projectName string, input *GenerateModelIn
 
 	// set data
 	data := GenerateModelData{
-		Package:    "models",
-		Imports:    importsPath,
-		StructName: utils.SnakeCaseToPascalCase(input.Table.Name),
-		Columns:    columns,
-		Schema:     input.Table.Schema,
-		TableName:  input.Table.Name,
-		RlsTag:     rlsTag,
-		RlsEnable:  input.Table.RLSEnabled,
-		RlsForced:  input.Table.RLSForced,
-		Relations:  relations,
+		Package:      "models",
+		Imports:      importsPath,
+		Indexes:      indexes,
+		Publications: publications,
+		Realtime:     realtime,
+		Comment:      comment,
+		StructName:   utils.SnakeCaseToPascalCase(input.Table.Name),
+		Columns:      columns,
+		Schema:       input.Table.Schema,
+		TableName:    input.Table.Name,
+		RlsTag:       rlsTag,
+		RlsEnable:    input.Table.RLSEnabled,
+		RlsForced:    input.Table.RLSForced,
+		Relations:    relations,
 	}
 
 	// setup generate input param
@@ -154,6 +214,15 @@ func GenerateModel(folderPath string, projectName string, input *GenerateModelIn
 	return generateFn(generateInput, &writer)
 }
 
+// toColumnKind return column descriptor type, text column use StringColumn that
+// have pattern filter and nullable column keep pointer value type so nil is filtered with is null
+func toColumnKind(goType string) string {
+	if strings.TrimPrefix(goType, "*") == "string" {
+		return "StringColumn"
+	}
+	return "Column"
+}
+
 // map table to column, map pg type to go type and get dependency import path
 func MapTableAttributes(projectName string, table objects.Table, mapDataType map[string]objects.Type, validationTags state.ModelValidationTag) (columns []GenerateModelColumn, importsPath []string) {
 	importsMap := make(map[string]any)
@@ -162,6 +231,13 @@ func MapTableAttributes(projectName string, table objects.Table, mapDataType map
 		mapPrimaryKey[k.Name] = true
 	}
 
+	mapColumnIndex := map[string]bool{}
+	for _, idx := range table.Indexes {
+		if isColumnTagIndex(table.Name, idx) {
+			mapColumnIndex[idx.Columns[0]] = true
+		}
+	}
+
 	for _, c := range table.Columns {
 		var userDataType *objects.Type
 
@@ -175,7 +251,7 @@ func MapTableAttributes(projectName string, table objects.Table, mapDataType map
 
 		column := GenerateModelColumn{
 			Name: c.Name,
-			Tag:  buildColumnTag(c, mapPrimaryKey, userDataType, validationTags),
+			Tag:  buildColumnTag(c, mapPrimaryKey, mapColumnIndex, userDataType, validationTags),
 		}
 
 		if userDataType != nil {
@@ -234,7 +310,7 @@ func MapTableAttributes(projectName string, table objects.Table, mapDataType map
 	return
 }
 
-func buildColumnTag(c objects.Column, mapPk map[string]bool, userDefinedType *objects.Type, validationTags state.ModelValidationTag) string {
+func buildColumnTag(c objects.Column, mapPk map[string]bool, mapIndex map[string]bool, userDefinedType *objects.Type, validationTags state.ModelValidationTag) string {
 	var tags []string
 
 	// append json tag
@@ -270,6 +346,9 @@ func buildColumnTag(c objects.Column, mapPk map[string]bool, userDefinedType *ob
 	if c.IdentityGeneration != nil {
 		if identityStr, isString := c.IdentityGeneration.(string); isString && len(identityStr) > 0 {
 			columnTags = append(columnTags, "autoIncrement")
+			if identityStr == "ALWAYS" {
+				columnTags = append(columnTags, "identity:always")
+			}
 		}
 	}
 
@@ -279,22 +358,144 @@ func buildColumnTag(c objects.Column, mapPk map[string]bool, userDefinedType *ob
 		columnTags = append(columnTags, "nullable:false")
 	}
 
-	if c.DefaultValue != "" {
+	// default value of generated column is the generation expression
+	if c.IsGenerated {
+		if expression, isString := c.DefaultValue.(string); isString && expression != "" {
+			columnTags = append(columnTags, "generated:"+raiden.EscapeColumnTagValue(expression))
+		}
+	} else if c.DefaultValue != "" {
 		defaultStr, isString := c.DefaultValue.(string)
 		if isString {
-			columnTags = append(columnTags, "default:"+utils.CleanDoubleColonPattern(defaultStr))
+			columnTags = append(columnTags, "default:"+raiden.EscapeColumnTagValue(utils.CleanDoubleColonPattern(defaultStr)))
 		}
 	}
 
+	if check, isString := c.Check.(string); isString && check != "" {
+		columnTags = append(columnTags, "check:"+raiden.EscapeColumnTagValue(check))
+	}
+
+	if comment, isString := c.Comment.(string); isString && comment != "" {
+		columnTags = append(columnTags, "comment:"+raiden.EscapeColumnTagValue(comment))
+	}
+
 	if c.IsUnique {
 		columnTags = append(columnTags, "unique")
 	}
 
-	tags = append(tags, fmt.Sprintf("column:%q", strings.Join(columnTags, ";")))
+	if mapIndex[c.Name] {
+		columnTags = append(columnTags, "index")
+	}
+
+	tags = append(tags, "column:"+quoteTagValue(strings.Join(columnTags, ";")))
 
 	return strings.Join(tags, " ")
 }
 
+// quoteTagValue quote struct tag value, backtick is escaped
+// because tag is written in raw string literal
+func quoteTagValue(value string) string {
+	return strings.ReplaceAll(strconv.Quote(value), "`", `\x60`)
+}
+
+// isColumnTagIndex check if index can be declared with index key in column tag,
+// only plain btree index on single column with default name is allowed
+func isColumnTagIndex(tableName string, idx objects.Index) bool {
+	if len(idx.Columns) != 1 || idx.IsUnique || idx.Predicate != "" {
+		return false
+	}
+
+	if idx.Method != "" && idx.Method != string(raiden.IndexMethodBtree) {
+		return false
+	}
+
+	return idx.Name == state.GetIndexName(tableName, idx.Columns)
+}
+
+// BuildModelIndexes build raiden.Index literal for every table index
+// that cannot be declared in column tag
+func BuildModelIndexes(table objects.Table) (indexes []string) {
+	for _, idx := range table.Indexes {
+		if isColumnTagIndex(table.Name, idx) {
+			continue
+		}
+
+		columns := make([]string, 0, len(idx.Columns))
+		for _, c := range idx.Columns {
+			columns = append(columns, fmt.Sprintf("%q", c))
+		}
+
+		fields := []string{
+			fmt.Sprintf("Name: %q", idx.Name),
+			fmt.Sprintf("Columns: []string{%s}", strings.Join(columns, ", ")),
+		}
+
+		if idx.IsUnique {
+			fields = append(fields, "Unique: true")
+		}
+
+		if method := buildIndexMethod(idx.Method); method != "" {
+			fields = append(fields, "Method: "+method)
+		}
+
+		if idx.Predicate != "" {
+			fields = append(fields, fmt.Sprintf("Where: %q", idx.Predicate))
+		}
+
+		indexes = append(indexes, fmt.Sprintf("{%s}", strings.Join(fields, ", ")))
+	}
+	return
+}
+
+func BuildModelPublications(table objects.Table) (realtime bool, publications []string) {
+	for _, p := range table.Publications {
+		if p.Name == raiden.DefaultPublication && len(p.Columns) == 0 && p.RowFilter == "" {
+			realtime = true
+			continue
+		}
+
+		fields := []string{}
+		if p.Name == raiden.DefaultPublication {
+			fields = append(fields, "Name: raiden.DefaultPublication")
+		} else {
+			fields = append(fields, fmt.Sprintf("Name: %q", p.Name))
+		}
+
+		if len(p.Columns) > 0 {
+			columns := make([]string, 0, len(p.Columns))
+			for _, c := range p.Columns {
+				columns = append(columns, fmt.Sprintf("%q", c))
+			}
+			fields = append(fields, fmt.Sprintf("Columns: []string{%s}", strings.Join(columns, ", ")))
+		}
+
+		if p.RowFilter != "" {
+			fields = append(fields, fmt.Sprintf("Where: %q", p.RowFilter))
+		}
+
+		publications = append(publications, fmt.Sprintf("{%s}", strings.Join(fields, ", ")))
+	}
+	return
+}
+
+func buildIndexMethod(method string) string {
+	switch raiden.IndexMethod(method) {
+	case "", raiden.IndexMethodBtree:
+		return ""
+	case raiden.IndexMethodHash:
+		return "raiden.IndexMethodHash"
+	case raiden.IndexMethodGin:
+		return "raiden.IndexMethodGin"
+	case raiden.IndexMethodGist:
+		return "raiden.IndexMethodGist"
+	case raiden.IndexMethodSpGist:
+		return "raiden.IndexMethodSpGist"
+	case raiden.IndexMethodBrin:
+		return "raiden.IndexMethodBrin"
+	default:
+		return fmt.Sprintf("raiden.IndexMethod(%q)", method)
+	}
+}
+
 func containsRelation(relations []state.Relation, r state.Relation) bool {
 	for _, rel := range relations {
 		if rel.Tag == r.Tag {
@@ -4,8 +4,10 @@ import (
 	"encoding/json"
 	"os"
 	"path/filepath"
+	"reflect"
 	"testing"
 
+	"github.com/sev-2/raiden"
 	"github.com/sev-2/raiden/pkg/generator"
 	"github.com/sev-2/raiden/pkg/postgres"
 	"github.com/sev-2/raiden/pkg/state"
@@ -102,6 +104,195 @@ func TestGenerateModels_WithCustomType(t *testing.T) {
 	assert.FileExists(t, dir+"/internal/models/test_table.go")
 }
 
+func TestGenerateModels_WithIndex(t *testing.T) {
+	dir, err := os.MkdirTemp("", "model")
+	assert.NoError(t, err)
+
+	modelPath := filepath.Join(dir, "internal")
+	err1 := utils.CreateFolder(modelPath)
+	assert.NoError(t, err1)
+
+	tables := []*generator.GenerateModelInput{
+		{
+			Table: objects.Table{
+				Name:   "test_table",
+				Schema: "public",
+				PrimaryKeys: []objects.PrimaryKey{
+					{Name: "id"},
+				},
+				Columns: []objects.Column{
+					{Name: "id", DataType: "integer", IsNullable: false},
+					{Name: "name", DataType: "text", IsNullable: true},
+					{Name: "tags", DataType: "jsonb", IsNullable: true},
+				},
+				Indexes: []objects.Index{
+					{Name: "idx_test_table_name", Columns: []string{"name"}, Method: "btree"},
+					{Name: "idx_test_table_tags", Columns: []string{"tags"}, Method: "gin"},
+					{Name: "uq_test_table_active_name", Columns: []string{"lower(name)"}, Method: "btree", IsUnique: true, Predicate: "name IS NOT NULL"},
+				},
+			},
+			Policies: objects.Policies{},
+		},
+	}
+
+	err2 := generator.GenerateModels(dir, "test-project", tables, nil, generator.GenerateFn(generator.Generate))
+	assert.NoError(t, err2)
+	assert.FileExists(t, dir+"/internal/models/test_table.go")
+
+	content, err3 := os.ReadFile(dir + "/internal/models/test_table.go")
+	assert.NoError(t, err3)
+	assert.Contains(t, string(content), `"github.com/sev-2/raiden"`)
+	assert.Contains(t, string(content), `column:"name:name;type:text;nullable;index"`)
+	assert.Contains(t, string(content), "func (m *TestTable) Indexes() []raiden.Index {")
+	assert.Contains(t, string(content), `{Name: "idx_test_table_tags", Columns: []string{"tags"}, Method: raiden.IndexMethodGin},`)
+	assert.Contains(t, string(content), `{Name: "uq_test_table_active_name", Columns: []string{"lower(name)"}, Unique: true, Where: "name IS NOT NULL"},`)
+}
+
+func TestGenerateModels_WithPublication(t *testing.T) {
+	dir, err := os.MkdirTemp("", "model")
+	assert.NoError(t, err)
+
+	modelPath := filepath.Join(dir, "internal")
+	err1 := utils.CreateFolder(modelPath)
+	assert.NoError(t, err1)
+
+	tables := []*generator.GenerateModelInput{
+		{
+			Table: objects.Table{
+				Name:   "test_table",
+				Schema: "public",
+				Columns: []objects.Column{
+					{Name: "id", DataType: "integer", IsNullable: false},
+					{Name: "body", DataType: "text", IsNullable: true},
+				},
+				Publications: []objects.TablePublication{
+					{Name: "supabase_realtime"},
+					{Name: "audit", Columns: []string{"id", "body"}, RowFilter: "(body IS NOT NULL)"},
+				},
+			},
+			Policies: objects.Policies{},
+		},
+	}
+
+	err2 := generator.GenerateModels(dir, "test-project", tables, nil, generator.GenerateFn(generator.Generate))
+	assert.NoError(t, err2)
+
+	content, err3 := os.ReadFile(dir + "/internal/models/test_table.go")
+	assert.NoError(t, err3)
+	assert.Contains(t, string(content), `rlsForced:"false" realtime:"true"`)
+	assert.Contains(t, string(content), "func (m *TestTable) Publications() []raiden.Publication {")
+	assert.Contains(t, string(content), `{Name: "audit", Columns: []string{"id", "body"}, Where: "(body IS NOT NULL)"},`)
+}
+
+func TestGenerateModels_WithCheckCommentAndGenerated(t *testing.T) {
+	dir, err := os.MkdirTemp("", "model")
+	assert.NoError(t, err)
+
+	modelPath := filepath.Join(dir, "internal")
+	err1 := utils.CreateFolder(modelPath)
+	assert.NoError(t, err1)
+
+	tables := []*generator.GenerateModelInput{
+		{
+			Table: objects.Table{
+				Name:    "products",
+				Schema:  "public",
+				Comment: "list of product",
+				Columns: []objects.Column{
+					{Name: "id", DataType: "bigint", IsNullable: false, IsIdentity: true, IdentityGeneration: "ALWAYS"},
+					{Name: "price", DataType: "numeric", IsNullable: false, Check: "(price > (0)::numeric)", Comment: "price in rupiah"},
+					{Name: "total", DataType: "numeric", IsNullable: true, IsGenerated: true, DefaultValue: "(price * (2)::numeric)"},
+				},
+			},
+			Policies: objects.Policies{},
+		},
+	}
+
+	err2 := generator.GenerateModels(dir, "test-project", tables, nil, generator.GenerateFn(generator.Generate))
+	assert.NoError(t, err2)
+
+	content, err3 := os.ReadFile(dir + "/internal/models/products.go")
+	assert.NoError(t, err3)
+	assert.Contains(t, string(content), `column:"name:id;type:bigint;autoIncrement;identity:always;nullable:false"`)
+	assert.Contains(t, string(content), `column:"name:price;type:numeric;nullable:false;check:(price > (0)::numeric);comment:price in rupiah"`)
+	assert.Contains(t, string(content), `column:"name:total;type:numeric;nullable;generated:(price * (2)::numeric)"`)
+	assert.Contains(t, string(content), `rlsForced:"false" comment:"list of product"`)
+}
+
+func TestGenerateModels_WithEscapedColumnTag(t *testing.T) {
+	dir, err := os.MkdirTemp("", "model")
+	assert.NoError(t, err)
+
+	modelPath := filepath.Join(dir, "internal")
+	err1 := utils.CreateFolder(modelPath)
+	assert.NoError(t, err1)
+
+	tables := []*generator.GenerateModelInput{
+		{
+			Table: objects.Table{
+				Name:    "orders",
+				Schema:  "public",
+				Comment: "order `list`",
+				Columns: []objects.Column{
+					{Name: "id", DataType: "bigint", IsNullable: false},
+					{Name: "amount", DataType: "integer", IsNullable: false, Check: "a > 0; b > 0", Comment: `amount in "cents"; can't be negative`},
+				},
+			},
+			Policies: objects.Policies{},
+		},
+	}
+
+	err2 := generator.GenerateModels(dir, "test-project", tables, nil, generator.GenerateFn(generator.Generate))
+	assert.NoError(t, err2)
+
+	content, err3 := os.ReadFile(dir + "/internal/models/orders.go")
+	assert.NoError(t, err3)
+	assert.Contains(t, string(content), `column:"name:amount;type:integer;nullable:false;check:a > 0\\; b > 0;comment:amount in \"cents\"\\; can't be negative"`)
+	assert.Contains(t, string(content), `comment:"order \x60list\x60"`)
+
+	// generated tag is parsed back to the original value
+	tag := reflect.StructTag(`column:"name:amount;type:integer;nullable:false;check:a > 0\\; b > 0;comment:amount in \"cents\"\\; can't be negative"`)
+	column := raiden.UnmarshalColumnTag(tag.Get("column"))
+	assert.Equal(t, "a > 0; b > 0", column.Check)
+	assert.Equal(t, `amount in "cents"; can't be negative`, column.Comment)
+	assert.False(t, column.Nullable)
+}
+
+func TestGenerateModels_WithColumnDescriptor(t *testing.T) {
+	dir, err := os.MkdirTemp("", "model")
+	assert.NoError(t, err)
+
+	modelPath := filepath.Join(dir, "internal")
+	err1 := utils.CreateFolder(modelPath)
+	assert.NoError(t, err1)
+
+	tables := []*generator.GenerateModelInput{
+		{
+			Table: objects.Table{
+				Name:   "users",
+				Schema: "public",
+				Columns: []objects.Column{
+					{Name: "id", DataType: "bigint", IsNullable: false},
+					{Name: "email", DataType: "text", IsNullable: true},
+					{Name: "age", DataType: "integer", IsNullable: true},
+				},
+			},
+			Policies: objects.Policies{},
+		},
+	}
+
+	err2 := generator.GenerateModels(dir, "test-project", tables, nil, generator.GenerateFn(generator.Generate))
+	assert.NoError(t, err2)
+
+	content, err3 := os.ReadFile(dir + "/internal/models/users.go")
+	assert.NoError(t, err3)
+	assert.Contains(t, string(content), "var UsersCols = struct {")
+	assert.Contains(t, string(content), "Id    db.Column[Users, int64]")
+	assert.Contains(t, string(content), "Email db.StringColumn[Users, *string]")
+	assert.Contains(t, string(content), "Age   db.Column[Users, *int32]")
+	assert.Contains(t, string(content), `Email: db.NewStringColumn[Users, *string]("email"),`)
+}
+
 func TestBuildRelationFields(t *testing.T) {
 	table := objects.Table{
 		Name: "profiles",
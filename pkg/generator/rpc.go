@@ -2,6 +2,8 @@ package generator
 
 import (
 	"fmt"
+	"go/token"
+	"os"
 	"path/filepath"
 	"regexp"
 	"sort"
@@ -51,11 +53,13 @@ type (
 		ReturnDecl   string
 		IsReturnArr  bool
 
-		Name     string
-		Schema   string
-		Security string
-		Behavior string
-		Language string
+		Name                  string
+		FunctionName          string
+		IdentityArgumentTypes string
+		Schema                string
+		Security              string
+		Behavior              string
+		Language              string
 
 		Models     string
 		Definition string
@@ -99,7 +103,7 @@ type {{ .Name }} struct {
 }
 
 func (r *{{ .Name }}) GetName() string {
-	return "{{ .Name | ToSnakeCase }}"
+	return "{{ .FunctionName }}"
 }
 
 func (r *{{ .Name }}) GetLanguage() string {
@@ -148,26 +152,137 @@ func (r *{{ .Name }}) GetRawDefinition() string {
 }`
 )
 
+var rpcPackageCleanRegex = regexp.MustCompile(`[^a-z0-9_]+`)
+
+// rpcReservedPackage is package name that is imported by generated rpc register
+var rpcReservedPackage = map[string]bool{"rpc": true, "resource": true}
+
 func GenerateRpc(basePath string, projectName string, functions []objects.Function, tables []objects.Table, generateFn GenerateFn) (err error) {
-	folderPath := filepath.Join(basePath, RpcDir)
-	RpcLogger.Trace("create rpc folder if not exist", "path", folderPath)
-	if exist := utils.IsFolderExists(folderPath); !exist {
-		if err := utils.CreateFolder(folderPath); err != nil {
+	rpcNames := GetRpcNames(functions)
+	for i := range functions {
+		f := functions[i]
+
+		// rpc is generated in package of its schema,
+		// so rpc with the same name in different schema is not collide
+		folderPath := filepath.Join(basePath, GetRpcDir(f.Schema))
+		RpcLogger.Trace("create rpc folder if not exist", "path", folderPath)
+		if exist := utils.IsFolderExists(folderPath); !exist {
+			if err := os.MkdirAll(folderPath, os.ModePerm); err != nil {
+				return err
+			}
+		}
+
+		if err := generateRpcItem(folderPath, projectName, &f, rpcNames[i], tables, generateFn); err != nil {
 			return err
 		}
 	}
 
+	return nil
+}
+
+// GetRpcNames return file and struct name in snake case for every function,
+// overloaded function is suffixed by argument name so every overload has its own struct
+// for example get_user(in_id integer) is named get_user_by_id
+func GetRpcNames(functions []objects.Function) []string {
+	names := make([]string, len(functions))
+	mapOverload := make(map[string][]int)
 	for i := range functions {
 		f := functions[i]
-		if err := generateRpcItem(folderPath, projectName, &f, tables, generateFn); err != nil {
-			return err
+		names[i] = f.Name
+
+		key := fmt.Sprintf("%s.%s", GetRpcPackage(f.Schema), f.Name)
+		mapOverload[key] = append(mapOverload[key], i)
+	}
+
+	for _, indexes := range mapOverload {
+		if len(indexes) < 2 {
+			continue
+		}
+
+		// use argument type when argument name is not enough for differentiate overload
+		for _, withType := range []bool{false, true} {
+			isUnique, mapName := true, make(map[string]bool)
+			for _, i := range indexes {
+				f := functions[i]
+				names[i] = f.Name + getRpcOverloadSuffix(f.IdentityArgumentTypes, withType)
+				if mapName[names[i]] {
+					isUnique = false
+				}
+				mapName[names[i]] = true
+			}
+
+			if isUnique {
+				break
+			}
 		}
 	}
 
-	return nil
+	return names
+}
+
+// getRpcOverloadSuffix convert identity argument types to name suffix,
+// for example "in_id integer, in_name text" is converted to _by_id_and_name
+func getRpcOverloadSuffix(identityArgumentTypes string, withType bool) string {
+	var args []string
+	for _, arg := range strings.Split(identityArgumentTypes, ",") {
+		fields := strings.Fields(strings.ToLower(arg))
+		if len(fields) > 0 {
+			switch fields[0] {
+			case "in", "out", "inout", "variadic":
+				fields = fields[1:]
+			}
+		}
+
+		if len(fields) == 0 {
+			continue
+		}
+
+		name, argType := "", strings.Join(fields, "_")
+		if len(fields) > 1 {
+			name = strings.TrimPrefix(fields[0], raiden.DefaultRpcParamPrefix)
+			argType = strings.Join(fields[1:], "_")
+		}
+
+		if name == "" || withType {
+			name = strings.Trim(name+"_"+argType, "_")
+		}
+		args = append(args, strings.Trim(rpcPackageCleanRegex.ReplaceAllString(name, "_"), "_"))
+	}
+
+	if len(args) == 0 {
+		return ""
+	}
+	return "_by_" + strings.Join(args, "_and_")
+}
+
+// GetRpcDir return folder of rpc, rpc in public schema is placed in rpc folder
+// and rpc in other schema is placed in sub folder named by the schema
+func GetRpcDir(schema string) string {
+	if schema == "" || schema == raiden.DefaultRpcSchema {
+		return RpcDir
+	}
+	return filepath.Join(RpcDir, GetRpcPackage(schema))
+}
+
+// GetRpcPackage return go package name of rpc in schema, schema that is go keyword
+// or package used by rpc register is suffixed, for example type_rpc
+func GetRpcPackage(schema string) string {
+	if schema == "" || schema == raiden.DefaultRpcSchema {
+		return "rpc"
+	}
+
+	name := strings.Trim(rpcPackageCleanRegex.ReplaceAllString(strings.ToLower(schema), "_"), "_")
+	if name == "" || (name[0] >= '0' && name[0] <= '9') {
+		name = strings.TrimSuffix("schema_"+name, "_")
+	}
+
+	if token.IsKeyword(name) || rpcReservedPackage[name] {
+		name += "_rpc"
+	}
+	return name
 }
 
-func generateRpcItem(folderPath string, projectName string, function *objects.Function, tables []objects.Table, generateFn GenerateFn) error {
+func generateRpcItem(folderPath string, projectName string, function *objects.Function, rpcName string, tables []objects.Table, generateFn GenerateFn) error {
 	// define binding func
 	funcMaps := []template.FuncMap{
 		{"ToSnakeCase": utils.ToSnakeCase},
@@ -181,7 +296,7 @@ func generateRpcItem(folderPath string, projectName string, function *objects.Fu
 	}
 
 	// define file path
-	filePath := filepath.Join(folderPath, fmt.Sprintf("%s.%s", utils.ToSnakeCase(function.Name), "go"))
+	filePath := filepath.Join(folderPath, fmt.Sprintf("%s.%s", utils.ToSnakeCase(rpcName), "go"))
 
 	// // extract rpc function
 	result, err := ExtractRpcFunction(function, tables)
@@ -218,21 +333,23 @@ func generateRpcItem(folderPath string, projectName string, function *objects.Fu
 
 	// set data
 	data := GenerateRpcData{
-		Package:        "rpc",
-		Imports:        importsPath,
-		Name:           utils.SnakeCaseToPascalCase(function.Name),
-		Language:       strings.ToLower(result.Rpc.Language),
-		Params:         rpcParams,
-		UseParamPrefix: result.UseParamPrefix,
-		ReturnType:     returnTypeDecl,
-		ReturnDecl:     returnDecl,
-		ReturnColumn:   returnColumns,
-		IsReturnArr:    IsReturnArr,
-		Schema:         result.Rpc.Schema,
-		Security:       result.GetSecurity(),
-		Behavior:       result.GetBehavior(),
-		Models:         result.GetModelDecl(),
-		Definition:     result.Rpc.Definition,
+		Package:               GetRpcPackage(function.Schema),
+		Imports:               importsPath,
+		Name:                  utils.SnakeCaseToPascalCase(rpcName),
+		FunctionName:          function.Name,
+		IdentityArgumentTypes: function.IdentityArgumentTypes,
+		Language:              strings.ToLower(result.Rpc.Language),
+		Params:                rpcParams,
+		UseParamPrefix:        result.UseParamPrefix,
+		ReturnType:            returnTypeDecl,
+		ReturnDecl:            returnDecl,
+		ReturnColumn:          returnColumns,
+		IsReturnArr:           IsReturnArr,
+		Schema:                result.Rpc.Schema,
+		Security:              result.GetSecurity(),
+		Behavior:              result.GetBehavior(),
+		Models:                result.GetModelDecl(),
+		Definition:            result.Rpc.Definition,
 	}
 
 	// setup generate input param
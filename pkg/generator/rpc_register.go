@@ -6,7 +6,9 @@ import (
 	"go/parser"
 	"go/token"
 	"io/fs"
+	"os"
 	"path/filepath"
+	"sort"
 	"strings"
 
 	"github.com/hashicorp/go-hclog"
@@ -40,7 +42,7 @@ import (
 func RegisterRpc() {
 	resource.RegisterRpc(
 		{{- range .Rpc}}
-		&rpc.{{.}}{},
+		&{{.}}{},
 		{{- end}}
 	)
 }
@@ -64,13 +66,13 @@ func GenerateRpcRegister(basePath string, projectName string, generateFn Generat
 		}
 	}
 
-	// scan all controller
-	rpcList, err := WalkScanRpc(rpcDir)
+	// scan all rpc
+	rpcList, packages, err := ScanRpcPackages(rpcDir)
 	if err != nil {
 		return err
 	}
 
-	input, err := createRegisterRpcInput(projectName, rpcRegisterDir, rpcList)
+	input, err := createRegisterRpcInput(projectName, rpcRegisterDir, rpcList, packages)
 	if err != nil {
 		return err
 	}
@@ -82,7 +84,7 @@ func GenerateRpcRegister(basePath string, projectName string, generateFn Generat
 	return generateFn(input, writer)
 }
 
-func createRegisterRpcInput(projectName string, rpcRegisterDir string, rpcList []string) (input GenerateInput, err error) {
+func createRegisterRpcInput(projectName string, rpcRegisterDir string, rpcList []string, packages []string) (input GenerateInput, err error) {
 	// set file path
 	filePath := filepath.Join(rpcRegisterDir, RpcRegisterFilename)
 
@@ -91,8 +93,11 @@ func createRegisterRpcInput(projectName string, rpcRegisterDir string, rpcList [
 		fmt.Sprintf("%q", "github.com/sev-2/raiden/pkg/resource"),
 	}
 
-	if len(rpcList) > 0 {
-		rpcImportPath := fmt.Sprintf("%s/internal/rpc", utils.ToGoModuleName(projectName))
+	for _, p := range packages {
+		rpcImportPath := fmt.Sprintf("%s/%s", utils.ToGoModuleName(projectName), RpcDir)
+		if p != "rpc" {
+			rpcImportPath = fmt.Sprintf("%s/%s", rpcImportPath, p)
+		}
 		imports = append(imports, fmt.Sprintf("%q", rpcImportPath))
 	}
 
@@ -137,6 +142,57 @@ func WalkScanRpc(rpcDir string) ([]string, error) {
 	return rpc, nil
 }
 
+// ScanRpcPackages scan rpc in rpc folder and schema sub folder, rpc is returned
+// with package name, for example rpc.GetUser or api.GetUser
+func ScanRpcPackages(rpcDir string) (rpc []string, packages []string, err error) {
+	entries, err := os.ReadDir(rpcDir)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	collect := func(pkg string, rs []string) {
+		if len(rs) == 0 {
+			return
+		}
+
+		sort.Strings(rs)
+		for _, r := range rs {
+			rpc = append(rpc, fmt.Sprintf("%s.%s", pkg, r))
+		}
+		packages = append(packages, pkg)
+	}
+
+	var rootRpc []string
+	for _, e := range entries {
+		if e.IsDir() || !strings.HasSuffix(e.Name(), ".go") {
+			continue
+		}
+
+		path := filepath.Join(rpcDir, e.Name())
+		RpcRegisterLogger.Trace("collect rpc", "path", path)
+		rs, e := getStructByBaseAndExcludeReturnType(path, "RpcBase", map[string]bool{})
+		if e != nil {
+			return nil, nil, e
+		}
+		rootRpc = append(rootRpc, rs...)
+	}
+	collect("rpc", rootRpc)
+
+	for _, e := range entries {
+		if !e.IsDir() {
+			continue
+		}
+
+		rs, err := WalkScanRpc(filepath.Join(rpcDir, e.Name()))
+		if err != nil {
+			return nil, nil, err
+		}
+		collect(e.Name(), rs)
+	}
+
+	return rpc, packages, nil
+}
+
 func getStructByBaseAndExcludeReturnType(filePath string, baseStructName string, returnTypes map[string]bool) (r []string, err error) {
 	fset := token.NewFileSet()
 	file, err := parser.ParseFile(fset, filePath, nil, parser.ParseComments)
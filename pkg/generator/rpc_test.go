@@ -665,3 +665,157 @@ func TestRpcWithTrigger(t *testing.T) {
 	// assert security type
 	assert.Equal(t, "RpcSecurityTypeDefiner", result.GetSecurity())
 }
+
+func TestGenerateRpc_MultiSchema(t *testing.T) {
+	fns := []objects.Function{
+		{
+			Schema:            "public",
+			Name:              "get_version",
+			Language:          "sql",
+			Definition:        "select 'public'",
+			CompleteStatement: "CREATE OR REPLACE FUNCTION public.get_version()\n RETURNS text\n LANGUAGE sql\nAS $function$select 'public'$function$\n",
+			ReturnType:        "text",
+			Behavior:          string(raiden.RpcBehaviorVolatile),
+		},
+		{
+			Schema:            "api",
+			Name:              "get_version",
+			Language:          "sql",
+			Definition:        "select 'api'",
+			CompleteStatement: "CREATE OR REPLACE FUNCTION api.get_version()\n RETURNS text\n LANGUAGE sql\nAS $function$select 'api'$function$\n",
+			ReturnType:        "text",
+			Behavior:          string(raiden.RpcBehaviorVolatile),
+		},
+	}
+
+	dir, err := os.MkdirTemp("", "rpc_multi_schema")
+	assert.NoError(t, err)
+	defer os.RemoveAll(dir)
+	assert.NoError(t, utils.CreateFolder(filepath.Join(dir, "internal")))
+
+	err = generator.GenerateRpc(dir, "test", fns, []objects.Table{}, generator.GenerateFn(generator.Generate))
+	assert.NoError(t, err)
+	assert.FileExists(t, dir+"/internal/rpc/get_version.go")
+	assert.FileExists(t, dir+"/internal/rpc/api/get_version.go")
+
+	content, err := os.ReadFile(dir + "/internal/rpc/api/get_version.go")
+	assert.NoError(t, err)
+	assert.Contains(t, string(content), "package api")
+
+	err = generator.GenerateRpcRegister(dir, "test", generator.GenerateFn(generator.Generate))
+	assert.NoError(t, err)
+
+	register, err := os.ReadFile(dir + "/internal/bootstrap/rpc.go")
+	assert.NoError(t, err)
+	assert.Contains(t, string(register), `"test/internal/rpc"`)
+	assert.Contains(t, string(register), `"test/internal/rpc/api"`)
+	assert.Contains(t, string(register), "&rpc.GetVersion{}")
+	assert.Contains(t, string(register), "&api.GetVersion{}")
+}
+
+func TestGetRpcDir(t *testing.T) {
+	assert.Equal(t, "internal/rpc", generator.GetRpcDir(""))
+	assert.Equal(t, "internal/rpc", generator.GetRpcDir("public"))
+	assert.Equal(t, "internal/rpc/api", generator.GetRpcDir("api"))
+	assert.Equal(t, "rpc", generator.GetRpcPackage("public"))
+	assert.Equal(t, "my_schema", generator.GetRpcPackage("My-Schema"))
+	assert.Equal(t, "type_rpc", generator.GetRpcPackage("type"))
+	assert.Equal(t, "func_rpc", generator.GetRpcPackage("func"))
+	assert.Equal(t, "default_rpc", generator.GetRpcPackage("default"))
+	assert.Equal(t, "go_rpc", generator.GetRpcPackage("go"))
+	assert.Equal(t, "rpc_rpc", generator.GetRpcPackage("rpc"))
+	assert.Equal(t, "schema_2024", generator.GetRpcPackage("2024"))
+	assert.Equal(t, "internal/rpc/type_rpc", generator.GetRpcDir("type"))
+}
+
+func TestGenerateRpc_KeywordSchema(t *testing.T) {
+	fns := []objects.Function{
+		{
+			Schema:            "type",
+			Name:              "get_version",
+			Language:          "sql",
+			Definition:        "select 'type'",
+			CompleteStatement: "CREATE OR REPLACE FUNCTION \"type\".get_version()\n RETURNS text\n LANGUAGE sql\nAS $function$select 'type'$function$\n",
+			ReturnType:        "text",
+			Behavior:          string(raiden.RpcBehaviorVolatile),
+		},
+	}
+
+	dir, err := os.MkdirTemp("", "rpc_keyword_schema")
+	assert.NoError(t, err)
+	defer os.RemoveAll(dir)
+	assert.NoError(t, utils.CreateFolder(filepath.Join(dir, "internal")))
+
+	err = generator.GenerateRpc(dir, "test", fns, []objects.Table{}, generator.GenerateFn(generator.Generate))
+	assert.NoError(t, err)
+
+	content, err := os.ReadFile(dir + "/internal/rpc/type_rpc/get_version.go")
+	assert.NoError(t, err)
+	assert.Contains(t, string(content), "package type_rpc")
+	assert.Contains(t, string(content), `return "type"`)
+
+	err = generator.GenerateRpcRegister(dir, "test", generator.GenerateFn(generator.Generate))
+	assert.NoError(t, err)
+
+	register, err := os.ReadFile(dir + "/internal/bootstrap/rpc.go")
+	assert.NoError(t, err)
+	assert.Contains(t, string(register), `"test/internal/rpc/type_rpc"`)
+	assert.Contains(t, string(register), "&type_rpc.GetVersion{}")
+}
+
+func TestGenerateRpc_Overload(t *testing.T) {
+	fns := []objects.Function{
+		{
+			Schema:                "public",
+			Name:                  "get_user_name",
+			Language:              "sql",
+			Definition:            "select 'user'",
+			CompleteStatement:     "CREATE OR REPLACE FUNCTION public.get_user_name(in_id integer)\n RETURNS text\n LANGUAGE sql\nAS $function$select 'user'$function$\n",
+			Args:                  []objects.FunctionArg{{Mode: "in", Name: "in_id", TypeId: 23}},
+			ArgumentTypes:         "in_id integer",
+			IdentityArgumentTypes: "in_id integer",
+			ReturnType:            "text",
+			Behavior:              string(raiden.RpcBehaviorVolatile),
+		},
+		{
+			Schema:                "public",
+			Name:                  "get_user_name",
+			Language:              "sql",
+			Definition:            "select 'user'",
+			CompleteStatement:     "CREATE OR REPLACE FUNCTION public.get_user_name(in_email text)\n RETURNS text\n LANGUAGE sql\nAS $function$select 'user'$function$\n",
+			Args:                  []objects.FunctionArg{{Mode: "in", Name: "in_email", TypeId: 25}},
+			ArgumentTypes:         "in_email text",
+			IdentityArgumentTypes: "in_email text",
+			ReturnType:            "text",
+			Behavior:              string(raiden.RpcBehaviorVolatile),
+		},
+	}
+
+	dir, err := os.MkdirTemp("", "rpc_overload")
+	assert.NoError(t, err)
+	defer os.RemoveAll(dir)
+	assert.NoError(t, utils.CreateFolder(filepath.Join(dir, "internal")))
+
+	err = generator.GenerateRpc(dir, "test", fns, []objects.Table{}, generator.GenerateFn(generator.Generate))
+	assert.NoError(t, err)
+	assert.FileExists(t, dir+"/internal/rpc/get_user_name_by_id.go")
+	assert.FileExists(t, dir+"/internal/rpc/get_user_name_by_email.go")
+
+	content, err := os.ReadFile(dir + "/internal/rpc/get_user_name_by_id.go")
+	assert.NoError(t, err)
+	assert.Contains(t, string(content), "type GetUserNameById struct")
+	assert.Contains(t, string(content), `return "get_user_name"`)
+}
+
+func TestGetRpcNames(t *testing.T) {
+	fns := []objects.Function{
+		{Schema: "public", Name: "get_user"},
+		{Schema: "public", Name: "find_user", IdentityArgumentTypes: "in_id integer"},
+		{Schema: "public", Name: "find_user", IdentityArgumentTypes: "in_id uuid"},
+		{Schema: "public", Name: "find_user", IdentityArgumentTypes: ""},
+		{Schema: "api", Name: "get_user", IdentityArgumentTypes: "VARIADIC in_ids integer[]"},
+	}
+
+	names := generator.GetRpcNames(fns)
+	assert.Equal(t, []string{"get_user", "find_user_by_id_integer", "find_user_by_id_uuid", "find_user", "get_user"}, names)
+}
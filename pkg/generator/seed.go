@@ -0,0 +1,125 @@
+package generator
+
+import (
+	"fmt"
+	"path/filepath"
+
+	"github.com/hashicorp/go-hclog"
+	"github.com/sev-2/raiden"
+	"github.com/sev-2/raiden/pkg/logger"
+	"github.com/sev-2/raiden/pkg/utils"
+)
+
+var SeedLogger hclog.Logger = logger.HcLog().Named("generator.seed")
+
+// ----- Define type, variable and constant -----
+type GenerateSeedMainFunctionData struct {
+	Package string
+	Imports []string
+}
+
+const (
+	SeedMainFunctionDirTemplate = "/cmd/seed"
+	SeedMainFunctionTemplate    = `package {{ .Package }}
+{{- if gt (len .Imports) 0 }}
+
+import (
+{{- range .Imports}}
+	{{.}}
+{{- end}}
+)
+{{- end }}
+
+
+func main() {
+	f := resource.Flags{}
+
+	cmd := &cobra.Command{
+		Run: func(cmd *cobra.Command, args []string) {
+			f.CheckAndActivateDebug(cmd)
+			// load configuration
+			if f.ProjectPath == "" {
+				curDir, err := utils.GetCurrentDirectory()
+				if err != nil {
+					resource.SeedLogger.Error(err.Error())
+					return
+				}
+				f.ProjectPath = curDir
+			}
+
+			config, err := raiden.LoadConfig(nil)
+			if err != nil {
+				resource.SeedLogger.Error(err.Error())
+				return
+			}
+
+			// register model and seed
+			bootstrap.RegisterModels()
+			bootstrap.RegisterSeeds()
+
+			if err = resource.Seed(&f, config); err != nil {
+				resource.SeedLogger.Error(err.Error())
+				os.Exit(1)
+			}
+		},
+	}
+
+	f.BindLog(cmd)
+	cmd.Flags().StringVarP(&f.ProjectPath, "project-path", "p", "", "set project path")
+	cmd.Flags().BoolVar(&f.DryRun, "dry-run", false, "show seed report without upsert seed data")
+	cmd.Flags().StringVarP(&f.Output, "output", "o", "text", "set seed report format, available format is text and json")
+
+	cmd.Execute()
+}
+`
+)
+
+// ----- Generate main function -----
+
+func GenerateSeedMainFunction(basePath string, config *raiden.Config, generateFn GenerateFn) error {
+	// make sure all folder exist
+	cmdFolderPath := filepath.Join(basePath, "cmd")
+	SeedLogger.Trace("create cmd folder if not exist", "path", cmdFolderPath)
+	if exist := utils.IsFolderExists(cmdFolderPath); !exist {
+		if err := utils.CreateFolder(cmdFolderPath); err != nil {
+			return err
+		}
+	}
+
+	seedMainFunctionPath := filepath.Join(basePath, SeedMainFunctionDirTemplate)
+	SeedLogger.Trace("create main folder if not exist", "path", seedMainFunctionPath)
+	if exist := utils.IsFolderExists(seedMainFunctionPath); !exist {
+		if err := utils.CreateFolder(seedMainFunctionPath); err != nil {
+			return err
+		}
+	}
+
+	// set file path
+	filePath := filepath.Join(seedMainFunctionPath, "main.go")
+
+	// setup import path
+	importPaths := []string{
+		fmt.Sprintf("%q", "os"),
+		fmt.Sprintf("%q", "github.com/sev-2/raiden"),
+		fmt.Sprintf("%q", "github.com/sev-2/raiden/pkg/resource"),
+		fmt.Sprintf("%q", "github.com/sev-2/raiden/pkg/utils"),
+		fmt.Sprintf("%q", "github.com/spf13/cobra"),
+	}
+	bootstrapImportPath := fmt.Sprintf("\"%s/internal/bootstrap\"", utils.ToGoModuleName(config.ProjectName))
+	importPaths = append(importPaths, bootstrapImportPath)
+	data := GenerateSeedMainFunctionData{
+		Package: "main",
+		Imports: importPaths,
+	}
+
+	// setup generate input param
+	input := GenerateInput{
+		BindData:     data,
+		Template:     SeedMainFunctionTemplate,
+		TemplateName: "seedMainFunctionTemplate",
+		OutputPath:   filePath,
+	}
+
+	SeedLogger.Debug("generate seed main function", "path", input.OutputPath)
+	return generateFn(input, nil)
+}
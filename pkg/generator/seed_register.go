@@ -0,0 +1,136 @@
+package generator
+
+import (
+	"fmt"
+	"io/fs"
+	"path/filepath"
+	"strings"
+
+	"github.com/hashicorp/go-hclog"
+	"github.com/sev-2/raiden/pkg/logger"
+	"github.com/sev-2/raiden/pkg/utils"
+)
+
+var SeedRegisterLogger hclog.Logger = logger.HcLog().Named("generator.seed_register")
+
+// ----- Define type, variable and constant -----
+type (
+	GenerateRegisterSeedData struct {
+		Imports []string
+		Package string
+		Seeds   []string
+	}
+)
+
+const (
+	SeedDir              = "internal/seeds"
+	SeedRegisterFilename = "seeds.go"
+	SeedRegisterDir      = "internal/bootstrap"
+	SeedRegisterTemplate = `// Code generated by raiden-cli; DO NOT EDIT.
+package {{ .Package }}
+
+{{if gt (len .Imports) 0 }}
+import (
+{{- range .Imports}}
+	{{.}}
+{{- end}}
+)
+{{end}}
+func RegisterSeeds() {
+	resource.RegisterSeeds(
+		{{- range .Seeds}}
+		&seeds.{{.}}{},
+		{{- end}}
+	)
+}
+`
+)
+
+func GenerateSeedRegister(basePath string, projectName string, generateFn GenerateFn) error {
+	seedRegisterDir := filepath.Join(basePath, SeedRegisterDir)
+	SeedRegisterLogger.Trace("create bootstrap folder if not exist", "path", seedRegisterDir)
+	if exist := utils.IsFolderExists(seedRegisterDir); !exist {
+		if err := utils.CreateFolder(seedRegisterDir); err != nil {
+			return err
+		}
+	}
+
+	seedDir := filepath.Join(basePath, SeedDir)
+	SeedRegisterLogger.Trace("create seeds folder if not exist", "path", seedDir)
+	if exist := utils.IsFolderExists(seedDir); !exist {
+		if err := utils.CreateFolder(seedDir); err != nil {
+			return err
+		}
+	}
+
+	// scan all seed
+	seedList, err := WalkScanSeed(seedDir)
+	if err != nil {
+		return err
+	}
+
+	input, err := createSeedRegisterInput(projectName, seedRegisterDir, seedList)
+	if err != nil {
+		return err
+	}
+
+	// setup writer
+	writer := &FileWriter{FilePath: input.OutputPath}
+
+	SeedRegisterLogger.Debug("generate seed register", "path", input.OutputPath)
+	return generateFn(input, writer)
+}
+
+func createSeedRegisterInput(projectName string, seedRegisterDir string, seedList []string) (input GenerateInput, err error) {
+	// set file path
+	filePath := filepath.Join(seedRegisterDir, SeedRegisterFilename)
+
+	// set imports path
+	imports := []string{
+		fmt.Sprintf("%q", "github.com/sev-2/raiden/pkg/resource"),
+	}
+
+	if len(seedList) > 0 {
+		seedImportPath := fmt.Sprintf("%s/internal/seeds", utils.ToGoModuleName(projectName))
+		imports = append(imports, fmt.Sprintf("%q", seedImportPath))
+	}
+
+	// set passed parameter
+	data := GenerateRegisterSeedData{
+		Package: "bootstrap",
+		Imports: imports,
+		Seeds:   seedList,
+	}
+
+	input = GenerateInput{
+		BindData:     data,
+		Template:     SeedRegisterTemplate,
+		TemplateName: "seedRegisterTemplate",
+		OutputPath:   filePath,
+	}
+
+	return
+}
+
+func WalkScanSeed(seedDir string) ([]string, error) {
+	SeedRegisterLogger.Trace("scan all seeds", "path", seedDir)
+
+	seeds := make([]string, 0)
+	err := filepath.Walk(seedDir, func(path string, info fs.FileInfo, err error) error {
+		if strings.HasSuffix(path, ".go") {
+			SeedRegisterLogger.Trace("collect seeds", "path", path)
+			rs, e := getStructByBaseName(path, "SeedBase")
+			if e != nil {
+				return e
+			}
+
+			seeds = append(seeds, rs...)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return seeds, nil
+}
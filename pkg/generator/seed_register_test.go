@@ -0,0 +1,45 @@
+package generator_test
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/sev-2/raiden/pkg/generator"
+	"github.com/sev-2/raiden/pkg/utils"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGenerateSeedRegister(t *testing.T) {
+	dir, err := os.MkdirTemp("", "seed_register")
+	assert.NoError(t, err)
+
+	seedPath := filepath.Join(dir, "internal")
+	err1 := utils.CreateFolder(seedPath)
+	assert.NoError(t, err1)
+
+	err2 := generator.GenerateSeedRegister(dir, "test", generator.GenerateFn(generator.Generate))
+	assert.NoError(t, err2)
+	assert.Equal(t, true, utils.IsFolderExists(dir+"/internal/bootstrap"))
+	assert.FileExists(t, dir+"/internal/bootstrap/seeds.go")
+}
+
+func TestWalkScanSeed(t *testing.T) {
+	dir, err := os.MkdirTemp("", "seed_scan")
+	assert.NoError(t, err)
+
+	content := `package seeds
+
+import "github.com/sev-2/raiden"
+
+type CountrySeed struct {
+	raiden.SeedBase
+}
+`
+	err = os.WriteFile(filepath.Join(dir, "country.go"), []byte(content), 0644)
+	assert.NoError(t, err)
+
+	seeds, err := generator.WalkScanSeed(dir)
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"CountrySeed"}, seeds)
+}
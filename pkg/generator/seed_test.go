@@ -0,0 +1,20 @@
+package generator_test
+
+import (
+	"os"
+	"testing"
+
+	"github.com/sev-2/raiden/pkg/generator"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGenerateSeedMainFunction(t *testing.T) {
+	dir, err := os.MkdirTemp("", "seed")
+	assert.NoError(t, err)
+
+	conf := loadConfig()
+
+	err1 := generator.GenerateSeedMainFunction(dir, conf, generator.GenerateFn(generator.Generate))
+	assert.NoError(t, err1)
+	assert.FileExists(t, dir+"/cmd/seed/main.go")
+}
@@ -0,0 +1,244 @@
+package generator
+
+import (
+	"fmt"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"text/template"
+
+	"github.com/hashicorp/go-hclog"
+	"github.com/sev-2/raiden/pkg/logger"
+	"github.com/sev-2/raiden/pkg/supabase/objects"
+	"github.com/sev-2/raiden/pkg/utils"
+)
+
+var TriggerLogger hclog.Logger = logger.HcLog().Named("generator.trigger")
+
+// ----- Define type, variable and constant -----
+type GenerateTriggerData struct {
+	Imports        []string
+	Package        string
+	StructName     string
+	Name           string
+	Schema         string
+	Table          string
+	Timing         string
+	Events         string
+	Orientation    string
+	Condition      string
+	FunctionName   string
+	FunctionSchema string
+	FunctionArgs   string
+	EnabledMode    string
+}
+
+const (
+	TriggerDir      = "internal/triggers"
+	TriggerTemplate = `package {{ .Package }}
+{{- if gt (len .Imports) 0 }}
+
+import (
+{{- range .Imports}}
+	{{.}}
+{{- end}}
+)
+{{- end }}
+
+type {{ .StructName }} struct {
+	raiden.TriggerBase
+}
+
+func (t *{{ .StructName }}) Name() string {
+	return "{{ .Name }}"
+}
+{{- if ne .Schema "public" }}
+
+func (t *{{ .StructName }}) Schema() string {
+	return "{{ .Schema }}"
+}
+{{- end }}
+
+func (t *{{ .StructName }}) Table() string {
+	return "{{ .Table }}"
+}
+
+func (t *{{ .StructName }}) Timing() raiden.TriggerTiming {
+	return {{ .Timing }}
+}
+
+func (t *{{ .StructName }}) Events() []raiden.TriggerEvent {
+	return {{ .Events }}
+}
+{{- if ne .Orientation "raiden.TriggerOrientationRow" }}
+
+func (t *{{ .StructName }}) Orientation() raiden.TriggerOrientation {
+	return {{ .Orientation }}
+}
+{{- end }}
+{{- if ne .Condition "" }}
+
+func (t *{{ .StructName }}) Condition() string {
+	return {{ .Condition }}
+}
+{{- end }}
+
+func (t *{{ .StructName }}) FunctionName() string {
+	return "{{ .FunctionName }}"
+}
+{{- if ne .FunctionSchema "public" }}
+
+func (t *{{ .StructName }}) FunctionSchema() string {
+	return "{{ .FunctionSchema }}"
+}
+{{- end }}
+{{- if ne .FunctionArgs "" }}
+
+func (t *{{ .StructName }}) FunctionArgs() []string {
+	return {{ .FunctionArgs }}
+}
+{{- end }}
+{{- if ne .EnabledMode "raiden.TriggerEnabledModeOrigin" }}
+
+func (t *{{ .StructName }}) EnabledMode() raiden.TriggerEnabledMode {
+	return {{ .EnabledMode }}
+}
+{{- end }}
+`
+)
+
+var (
+	mapTriggerTiming = map[string]string{
+		"BEFORE":     "raiden.TriggerTimingBefore",
+		"AFTER":      "raiden.TriggerTimingAfter",
+		"INSTEAD OF": "raiden.TriggerTimingInsteadOf",
+	}
+
+	mapTriggerEvent = map[string]string{
+		"INSERT":   "raiden.TriggerEventInsert",
+		"UPDATE":   "raiden.TriggerEventUpdate",
+		"DELETE":   "raiden.TriggerEventDelete",
+		"TRUNCATE": "raiden.TriggerEventTruncate",
+	}
+
+	mapTriggerOrientation = map[string]string{
+		"ROW":       "raiden.TriggerOrientationRow",
+		"STATEMENT": "raiden.TriggerOrientationStatement",
+	}
+
+	mapTriggerEnabledMode = map[string]string{
+		"ORIGIN":   "raiden.TriggerEnabledModeOrigin",
+		"REPLICA":  "raiden.TriggerEnabledModeReplica",
+		"ALWAYS":   "raiden.TriggerEnabledModeAlways",
+		"DISABLED": "raiden.TriggerEnabledModeDisabled",
+	}
+)
+
+// GetTriggerStructName return go struct name of trigger,
+// trigger name is only unique per table so table name is used as prefix
+func GetTriggerStructName(t objects.Trigger) string {
+	return utils.SnakeCaseToPascalCase(GetTriggerFileName(t))
+}
+
+func GetTriggerFileName(t objects.Trigger) string {
+	return utils.ToSnakeCase(fmt.Sprintf("%s_%s", t.Table, t.Name))
+}
+
+func GenerateTriggers(basePath string, triggers []objects.Trigger, generateFn GenerateFn) (err error) {
+	folderPath := filepath.Join(basePath, TriggerDir)
+	TriggerLogger.Trace("create triggers folder if not exist", folderPath)
+	if exist := utils.IsFolderExists(folderPath); !exist {
+		if err := utils.CreateFolder(folderPath); err != nil {
+			return err
+		}
+	}
+
+	for _, v := range triggers {
+		if err := GenerateTrigger(folderPath, v, generateFn); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func GenerateTrigger(folderPath string, t objects.Trigger, generateFn GenerateFn) error {
+	// define file path
+	filePath := filepath.Join(folderPath, fmt.Sprintf("%s.%s", GetTriggerFileName(t), "go"))
+
+	// set imports path
+	var imports []string
+	raidenPath := fmt.Sprintf("%q", "github.com/sev-2/raiden")
+	imports = append(imports, raidenPath)
+
+	// execute the template and write to the file
+	data := GenerateTriggerData{
+		Package:        "triggers",
+		Imports:        imports,
+		StructName:     GetTriggerStructName(t),
+		Name:           t.Name,
+		Schema:         t.Schema,
+		Table:          t.Table,
+		Timing:         getTriggerConstant(mapTriggerTiming, t.Activation),
+		Orientation:    getTriggerConstant(mapTriggerOrientation, t.Orientation),
+		FunctionName:   t.FunctionName,
+		FunctionSchema: t.FunctionSchema,
+		EnabledMode:    getTriggerConstant(mapTriggerEnabledMode, t.EnabledMode),
+	}
+
+	if data.Schema == "" {
+		data.Schema = "public"
+	}
+
+	if data.FunctionSchema == "" {
+		data.FunctionSchema = "public"
+	}
+
+	if data.Timing == "" {
+		data.Timing = mapTriggerTiming["AFTER"]
+	}
+
+	if data.Orientation == "" {
+		data.Orientation = mapTriggerOrientation["ROW"]
+	}
+
+	if data.EnabledMode == "" {
+		data.EnabledMode = mapTriggerEnabledMode["ORIGIN"]
+	}
+
+	events := []string{}
+	for _, e := range t.Events {
+		events = append(events, getTriggerConstant(mapTriggerEvent, e))
+	}
+	data.Events = fmt.Sprintf("[]raiden.TriggerEvent{%s}", strings.Join(events, ", "))
+
+	if t.Condition != nil && *t.Condition != "" {
+		data.Condition = fmt.Sprintf("%q", *t.Condition)
+	}
+
+	if len(t.FunctionArgs) > 0 {
+		data.FunctionArgs = GenerateArrayDeclaration(reflect.ValueOf(t.FunctionArgs), false)
+	}
+
+	// set input
+	input := GenerateInput{
+		BindData:     data,
+		Template:     TriggerTemplate,
+		TemplateName: "triggerTemplate",
+		OutputPath:   filePath,
+		FuncMap:      []template.FuncMap{},
+	}
+
+	// setup writer
+	writer := &FileWriter{FilePath: input.OutputPath}
+
+	TriggerLogger.Debug("generate trigger", "path", input.OutputPath)
+	return generateFn(input, writer)
+}
+
+func getTriggerConstant(mapConstant map[string]string, value string) string {
+	if c, exist := mapConstant[strings.ToUpper(value)]; exist {
+		return c
+	}
+	return ""
+}
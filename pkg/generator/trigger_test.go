@@ -0,0 +1,52 @@
+package generator_test
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/sev-2/raiden/pkg/generator"
+	"github.com/sev-2/raiden/pkg/supabase/objects"
+	"github.com/sev-2/raiden/pkg/utils"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGenerateTriggers(t *testing.T) {
+	dir, err := os.MkdirTemp("", "trigger")
+	assert.NoError(t, err)
+
+	internalPath := filepath.Join(dir, "internal")
+	err1 := utils.CreateFolder(internalPath)
+	assert.NoError(t, err1)
+
+	condition := "(old.* IS DISTINCT FROM new.*)"
+	triggers := []objects.Trigger{
+		{
+			Name:           "set_updated_at",
+			Schema:         "public",
+			Table:          "users",
+			Activation:     "BEFORE",
+			Events:         []string{"INSERT", "UPDATE"},
+			Orientation:    "ROW",
+			Condition:      &condition,
+			FunctionName:   "handle_updated_at",
+			FunctionSchema: "public",
+			FunctionArgs:   []string{"updated_at"},
+			EnabledMode:    "ORIGIN",
+		},
+	}
+
+	err2 := generator.GenerateTriggers(dir, triggers, generator.GenerateFn(generator.Generate))
+	assert.NoError(t, err2)
+	assert.FileExists(t, dir+"/internal/triggers/users_set_updated_at.go")
+
+	content, err3 := os.ReadFile(dir + "/internal/triggers/users_set_updated_at.go")
+	assert.NoError(t, err3)
+	assert.Contains(t, string(content), "type UsersSetUpdatedAt struct")
+	assert.Contains(t, string(content), "raiden.TriggerTimingBefore")
+	assert.Contains(t, string(content), "[]raiden.TriggerEvent{raiden.TriggerEventInsert, raiden.TriggerEventUpdate}")
+
+	structs, err4 := generator.WalkScanTrigger(dir + "/internal/triggers")
+	assert.NoError(t, err4)
+	assert.Equal(t, []string{"UsersSetUpdatedAt"}, structs)
+}
@@ -0,0 +1,144 @@
+package generator
+
+import (
+	"fmt"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"text/template"
+
+	"github.com/hashicorp/go-hclog"
+	"github.com/sev-2/raiden/pkg/logger"
+	"github.com/sev-2/raiden/pkg/supabase/objects"
+	"github.com/sev-2/raiden/pkg/utils"
+)
+
+var ViewLogger hclog.Logger = logger.HcLog().Named("generator.view")
+
+// ----- Define type, variable and constant -----
+type GenerateViewData struct {
+	Columns      []GenerateModelColumn
+	Imports      []string
+	Package      string
+	StructName   string
+	Schema       string
+	Name         string
+	Definition   string
+	Materialized bool
+}
+
+const (
+	ViewDir      = "internal/views"
+	ViewTemplate = `package {{ .Package }}
+{{- if gt (len .Imports) 0 }}
+
+import (
+{{- range .Imports}}
+	"{{.}}"
+{{- end}}
+)
+{{- end }}
+
+type {{ .StructName }} struct {
+	raiden.ViewBase
+
+{{- range .Columns }}
+	{{ .Name | ToGoIdentifier }} {{ .Type }} ` + "`{{ .Tag }}`" + `
+{{- end }}
+
+	// View information
+	Metadata string ` + "`json:\"-\" schema:\"{{ .Schema }}\" tableName:\"{{ .Name }}\"`" + `
+}
+
+func (v *{{ .StructName }}) Definition() string {
+	return {{ .Definition }}
+}
+{{- if .Materialized }}
+
+func (v *{{ .StructName }}) Materialized() bool {
+	return true
+}
+{{- end }}
+`
+)
+
+func GenerateViews(basePath string, projectName string, views []objects.View, mapDataType map[string]objects.Type, generateFn GenerateFn) (err error) {
+	folderPath := filepath.Join(basePath, ViewDir)
+	ViewLogger.Trace("create views folder if not exist", folderPath)
+	if exist := utils.IsFolderExists(folderPath); !exist {
+		if err := utils.CreateFolder(folderPath); err != nil {
+			return err
+		}
+	}
+
+	for _, v := range views {
+		if err := GenerateView(folderPath, projectName, v, mapDataType, generateFn); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func GenerateView(folderPath string, projectName string, v objects.View, mapDataType map[string]objects.Type, generateFn GenerateFn) error {
+	// define binding func
+	funcMaps := []template.FuncMap{
+		{"ToGoIdentifier": utils.SnakeCaseToPascalCase},
+	}
+
+	// define file path
+	filePath := filepath.Join(folderPath, fmt.Sprintf("%s.%s", utils.ToSnakeCase(v.Name), "go"))
+
+	// map column data, view column is mapped the same way as table column
+	table := objects.Table{Name: v.Name, Schema: v.Schema, Columns: v.Columns}
+	columns, imports := MapTableAttributes(projectName, table, mapDataType, nil)
+	imports = append(imports, "github.com/sev-2/raiden")
+	sort.Strings(imports)
+
+	schema := v.Schema
+	if schema == "" {
+		schema = "public"
+	}
+
+	// execute the template and write to the file
+	data := GenerateViewData{
+		Package:      "views",
+		Imports:      imports,
+		Columns:      columns,
+		StructName:   GetViewStructName(v),
+		Schema:       schema,
+		Name:         v.Name,
+		Definition:   buildViewDefinitionLiteral(v.Definition),
+		Materialized: v.IsMaterialized,
+	}
+
+	// set input
+	input := GenerateInput{
+		BindData:     data,
+		Template:     ViewTemplate,
+		TemplateName: "viewTemplate",
+		OutputPath:   filePath,
+		FuncMap:      funcMaps,
+	}
+
+	// setup writer
+	writer := &FileWriter{FilePath: input.OutputPath}
+
+	ViewLogger.Debug("generate view", "path", input.OutputPath)
+	return generateFn(input, writer)
+}
+
+func GetViewStructName(v objects.View) string {
+	return utils.SnakeCaseToPascalCase(v.Name)
+}
+
+// buildViewDefinitionLiteral return definition as go raw string,
+// fallback to quoted string when definition contain backtick
+func buildViewDefinitionLiteral(definition string) string {
+	definition = strings.TrimRight(strings.TrimSpace(definition), ";")
+	if strings.Contains(definition, "`") {
+		return strconv.Quote(definition)
+	}
+	return "`" + definition + "`"
+}
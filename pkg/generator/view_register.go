@@ -0,0 +1,134 @@
+package generator
+
+import (
+	"fmt"
+	"io/fs"
+	"path/filepath"
+	"strings"
+
+	"github.com/hashicorp/go-hclog"
+	"github.com/sev-2/raiden/pkg/logger"
+	"github.com/sev-2/raiden/pkg/utils"
+)
+
+var ViewRegisterLogger hclog.Logger = logger.HcLog().Named("generator.view_register")
+
+// ----- Define type, variable and constant -----
+type (
+	GenerateRegisterViewData struct {
+		Imports []string
+		Package string
+		Views   []string
+	}
+)
+
+const (
+	ViewRegisterFilename = "views.go"
+	ViewRegisterDir      = "internal/bootstrap"
+	ViewRegisterTemplate = `// Code generated by raiden-cli; DO NOT EDIT.
+package {{ .Package }}
+{{if gt (len .Imports) 0 }}
+import (
+{{- range .Imports}}
+	{{.}}
+{{- end}}
+)
+{{end }}
+func RegisterViews() {
+	resource.RegisterViews(
+		{{- range .Views}}
+		&views.{{.}}{},
+		{{- end}}
+	)
+}
+`
+)
+
+func GenerateViewRegister(basePath string, projectName string, generateFn GenerateFn) error {
+	viewRegisterDir := filepath.Join(basePath, ViewRegisterDir)
+	ViewRegisterLogger.Trace("create bootstrap folder if not exist", viewRegisterDir)
+	if exist := utils.IsFolderExists(viewRegisterDir); !exist {
+		if err := utils.CreateFolder(viewRegisterDir); err != nil {
+			return err
+		}
+	}
+
+	viewDir := filepath.Join(basePath, ViewDir)
+	ViewRegisterLogger.Trace("create views folder if not exist", viewDir)
+	if exist := utils.IsFolderExists(viewDir); !exist {
+		if err := utils.CreateFolder(viewDir); err != nil {
+			return err
+		}
+	}
+
+	// scan all view
+	viewList, err := WalkScanView(viewDir)
+	if err != nil {
+		return err
+	}
+
+	input, err := createViewRegisterInput(projectName, viewRegisterDir, viewList)
+	if err != nil {
+		return err
+	}
+
+	// setup writer
+	writer := &FileWriter{FilePath: input.OutputPath}
+
+	ViewRegisterLogger.Debug("generate view register", "path", input.OutputPath)
+	return generateFn(input, writer)
+}
+
+func createViewRegisterInput(projectName string, viewRegisterDir string, viewList []string) (input GenerateInput, err error) {
+	// set file path
+	filePath := filepath.Join(viewRegisterDir, ViewRegisterFilename)
+
+	// set imports path
+	imports := []string{
+		fmt.Sprintf("%q", "github.com/sev-2/raiden/pkg/resource"),
+	}
+
+	if len(viewList) > 0 {
+		viewsImportPath := fmt.Sprintf("%s/internal/views", utils.ToGoModuleName(projectName))
+		imports = append(imports, fmt.Sprintf("%q", viewsImportPath))
+	}
+
+	// set passed parameter
+	data := GenerateRegisterViewData{
+		Package: "bootstrap",
+		Imports: imports,
+		Views:   viewList,
+	}
+
+	input = GenerateInput{
+		BindData:     data,
+		Template:     ViewRegisterTemplate,
+		TemplateName: "viewRegisterTemplate",
+		OutputPath:   filePath,
+	}
+
+	return
+}
+
+func WalkScanView(viewDir string) ([]string, error) {
+	ViewRegisterLogger.Trace("scan registered all views", "path", viewDir)
+
+	views := make([]string, 0)
+	err := filepath.Walk(viewDir, func(path string, info fs.FileInfo, err error) error {
+		if strings.HasSuffix(path, ".go") {
+			ViewRegisterLogger.Trace("collect views", "file-path", path)
+			rs, e := getStructByBaseName(path, "ViewBase")
+			if e != nil {
+				return e
+			}
+
+			views = append(views, rs...)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return views, nil
+}
@@ -0,0 +1,61 @@
+package generator_test
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/sev-2/raiden/pkg/generator"
+	"github.com/sev-2/raiden/pkg/supabase/objects"
+	"github.com/sev-2/raiden/pkg/utils"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGenerateViews(t *testing.T) {
+	dir, err := os.MkdirTemp("", "view")
+	assert.NoError(t, err)
+
+	internalPath := filepath.Join(dir, "internal")
+	err1 := utils.CreateFolder(internalPath)
+	assert.NoError(t, err1)
+
+	views := []objects.View{
+		{
+			Name:       "active_users",
+			Schema:     "public",
+			Definition: " SELECT users.id,\n    users.name\n   FROM users\n  WHERE users.is_active;",
+			Columns: []objects.Column{
+				{Name: "id", DataType: "bigint", IsNullable: true},
+				{Name: "name", DataType: "text", IsNullable: true},
+			},
+		},
+		{
+			Name:           "user_summary",
+			Schema:         "private",
+			Definition:     " SELECT count(*) AS total\n   FROM users;",
+			IsMaterialized: true,
+		},
+	}
+
+	err2 := generator.GenerateViews(dir, "test", views, nil, generator.GenerateFn(generator.Generate))
+	assert.NoError(t, err2)
+	assert.FileExists(t, dir+"/internal/views/active_users.go")
+	assert.FileExists(t, dir+"/internal/views/user_summary.go")
+
+	content, err3 := os.ReadFile(dir + "/internal/views/active_users.go")
+	assert.NoError(t, err3)
+	assert.Contains(t, string(content), "type ActiveUsers struct")
+	assert.Contains(t, string(content), "raiden.ViewBase")
+	assert.Contains(t, string(content), `tableName:"active_users"`)
+	assert.Contains(t, string(content), "WHERE users.is_active`")
+	assert.NotContains(t, string(content), "Materialized()")
+
+	content, err4 := os.ReadFile(dir + "/internal/views/user_summary.go")
+	assert.NoError(t, err4)
+	assert.Contains(t, string(content), `schema:"private"`)
+	assert.Contains(t, string(content), "Materialized() bool")
+
+	structs, err5 := generator.WalkScanView(dir + "/internal/views")
+	assert.NoError(t, err5)
+	assert.ElementsMatch(t, []string{"ActiveUsers", "UserSummary"}, structs)
+}
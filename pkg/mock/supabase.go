@@ -197,6 +197,24 @@ func (m *MockSupabase) MockGetTypesWithExpectedResponse(httpCode int, types []ob
 	return registerMock(m.Cfg, actionType, method, url, httpCode, types)
 }
 
+func (m *MockSupabase) MockGetMigrationsWithExpectedResponse(httpCode int, migrations []objects.Migration) error {
+	actionType, method, url := getMethodAndUrl(m.Cfg, "getMigrations")
+
+	return registerMock(m.Cfg, actionType, method, url, httpCode, migrations)
+}
+
+func (m *MockSupabase) MockRunMigrationScriptWithExpectedResponse(httpCode int, data interface{}) error {
+	actionType, method, url := getMethodAndUrl(m.Cfg, "common")
+
+	return registerMock(m.Cfg, actionType, method, url, httpCode, data)
+}
+
+func (m *MockSupabase) MockGetStateWithExpectedResponse(httpCode int, remoteState objects.RemoteState) error {
+	actionType, method, url := getMethodAndUrl(m.Cfg, "common")
+
+	return registerMock(m.Cfg, actionType, method, url, httpCode, []objects.RemoteState{remoteState})
+}
+
 func (m *MockSupabase) MockGetTypeByNameWithExpectedResponse(httpCode int, dataType objects.Type) error {
 	actionType, method, url := getMethodAndUrl(m.Cfg, "common")
 
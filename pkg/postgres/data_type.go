@@ -283,3 +283,78 @@ func GetPgDataTypeName(pgType DataType, returnAlias bool) DataType {
 
 	return TextType
 }
+
+// safeCastMap is list of target type that can hold all value of source type,
+// casting to type outside this list can fail or change the stored value
+var safeCastMap = map[DataType][]DataType{
+	SmallIntType:        {IntType, BigIntType, NumericType, RealType, DoublePrecisionType, TextType, VarcharType},
+	IntType:             {BigIntType, NumericType, DoublePrecisionType, TextType, VarcharType},
+	BigIntType:          {NumericType, TextType, VarcharType},
+	NumericType:         {TextType, VarcharType},
+	RealType:            {DoublePrecisionType, TextType, VarcharType},
+	DoublePrecisionType: {TextType, VarcharType},
+	VarcharType:         {TextType},
+	CharType:            {VarcharType, TextType, BpcharType},
+	BpcharType:          {VarcharType, TextType, CharType},
+	TimestampType:       {TimestampTzType, TextType, VarcharType},
+	TimestampTzType:     {TextType, VarcharType},
+	DateType:            {TimestampType, TimestampTzType, TextType, VarcharType},
+	TimeType:            {TimeTzType, IntervalType, TextType, VarcharType},
+	TimeTzType:          {TextType, VarcharType},
+	IntervalType:        {TextType, VarcharType},
+	BooleanType:         {TextType, VarcharType},
+	UuidType:            {TextType, VarcharType},
+	JsonType:            {JsonbType, TextType},
+	JsonbType:           {JsonType, TextType},
+}
+
+// IsSafeCast check if column with source type can be altered to target type
+// without losing data, unknown and user defined type is never safe
+func IsSafeCast(source, target DataType) bool {
+	source, target = NormalizeDataType(source), NormalizeDataType(target)
+	if source == target {
+		return source != UserDefined
+	}
+
+	for _, t := range safeCastMap[source] {
+		if t == target {
+			return true
+		}
+	}
+	return false
+}
+
+// NormalizeDataType return canonical name of data type that is
+// returned by postgres, for example int4 is returned as integer
+func NormalizeDataType(dataType DataType) DataType {
+	switch d := DataType(strings.ToLower(strings.TrimSpace(string(dataType)))); d {
+	case "int", "int4":
+		return IntType
+	case "int2":
+		return SmallIntType
+	case "int8":
+		return BigIntType
+	case "float4":
+		return RealType
+	case DecimalType:
+		return NumericType
+	case "bool":
+		return BooleanType
+	case DoublePrecisionTypeAlias:
+		return DoublePrecisionType
+	case VarcharTypeAlias:
+		return VarcharType
+	case TimestampTypeAlias:
+		return TimestampType
+	case TimestampTzTypeAlias, "timestamptz":
+		return TimestampTzType
+	case TimeTypeAlias:
+		return TimeType
+	case TimeTzTypeAlias, "timetz":
+		return TimeTzType
+	case "user-defined":
+		return UserDefined
+	default:
+		return d
+	}
+}
@@ -192,3 +192,24 @@ func TestKnownRoles(t *testing.T) {
 		})
 	}
 }
+
+func TestIsSafeCast(t *testing.T) {
+	assert.True(t, postgres.IsSafeCast(postgres.IntType, postgres.BigIntType))
+	assert.True(t, postgres.IsSafeCast(postgres.VarcharTypeAlias, postgres.TextType))
+	assert.True(t, postgres.IsSafeCast(postgres.TimestampTypeAlias, postgres.TimestampTzType))
+	assert.True(t, postgres.IsSafeCast(postgres.TextType, postgres.TextType))
+	assert.False(t, postgres.IsSafeCast(postgres.BigIntType, postgres.IntType))
+	assert.False(t, postgres.IsSafeCast(postgres.TextType, postgres.IntType))
+	assert.False(t, postgres.IsSafeCast(postgres.TimestampTzType, postgres.DateType))
+	assert.False(t, postgres.IsSafeCast(postgres.UserDefined, postgres.UserDefined))
+}
+
+func TestNormalizeDataType(t *testing.T) {
+	assert.Equal(t, postgres.IntType, postgres.NormalizeDataType("INT4"))
+	assert.Equal(t, postgres.BigIntType, postgres.NormalizeDataType("int8"))
+	assert.Equal(t, postgres.BooleanType, postgres.NormalizeDataType("bool"))
+	assert.Equal(t, postgres.NumericType, postgres.NormalizeDataType(postgres.DecimalType))
+	assert.Equal(t, postgres.VarcharType, postgres.NormalizeDataType(postgres.VarcharTypeAlias))
+	assert.Equal(t, postgres.TimestampTzType, postgres.NormalizeDataType("timestamptz"))
+	assert.Equal(t, postgres.TextType, postgres.NormalizeDataType(postgres.TextType))
+}
@@ -3,21 +3,25 @@ package resource
 import (
 	"errors"
 	"fmt"
+	"os"
+	"path/filepath"
 	"strings"
-	"sync"
 	"time"
 
 	"github.com/hashicorp/go-hclog"
 	"github.com/sev-2/raiden"
 	"github.com/sev-2/raiden/pkg/generator"
 	"github.com/sev-2/raiden/pkg/logger"
+	"github.com/sev-2/raiden/pkg/resource/extensions"
 	"github.com/sev-2/raiden/pkg/resource/migrator"
 	"github.com/sev-2/raiden/pkg/resource/policies"
 	"github.com/sev-2/raiden/pkg/resource/roles"
 	"github.com/sev-2/raiden/pkg/resource/rpc"
 	"github.com/sev-2/raiden/pkg/resource/storages"
 	"github.com/sev-2/raiden/pkg/resource/tables"
+	"github.com/sev-2/raiden/pkg/resource/triggers"
 	"github.com/sev-2/raiden/pkg/resource/types"
+	"github.com/sev-2/raiden/pkg/resource/views"
 	"github.com/sev-2/raiden/pkg/state"
 	"github.com/sev-2/raiden/pkg/supabase"
 	"github.com/sev-2/raiden/pkg/supabase/objects"
@@ -27,12 +31,37 @@ import (
 var ApplyLogger hclog.Logger = logger.HcLog().Named("apply")
 
 type MigrateData struct {
-	Tables   []tables.MigrateItem
-	Roles    []roles.MigrateItem
-	Rpc      []rpc.MigrateItem
-	Policies []policies.MigrateItem
-	Storages []storages.MigrateItem
-	Types    []types.MigrateItem
+	Tables     []tables.MigrateItem
+	Roles      []roles.MigrateItem
+	Rpc        []rpc.MigrateItem
+	Policies   []policies.MigrateItem
+	Storages   []storages.MigrateItem
+	Types      []types.MigrateItem
+	Triggers   []triggers.MigrateItem
+	Views      []views.MigrateItem
+	Extensions []extensions.MigrateItem
+}
+
+// appResource is local resource that will be compared with supabase resource
+type appResource struct {
+	Tables     state.ExtractTableResult
+	Roles      state.ExtractRoleResult
+	Rpc        state.ExtractRpcResult
+	Storages   state.ExtractStorageResult
+	Types      state.ExtractTypeResult
+	Triggers   state.ExtractTriggerResult
+	Views      state.ExtractViewResult
+	Extensions state.ExtractExtensionResult
+	Policies   state.ExtractedPolicies
+}
+
+func loadAppResource(flags *Flags, latestState *state.State) (app appResource, err error) {
+	app.Tables, app.Roles, app.Rpc, app.Storages, app.Types, app.Triggers, app.Views, app.Extensions, err = extractAppResource(flags, latestState)
+	if err != nil {
+		return
+	}
+	app.Policies = mergeAllPolicy(app.Tables, app.Storages)
+	return
 }
 
 // Migrate resource :
@@ -57,6 +86,10 @@ type MigrateData struct {
 //	[x] update table column set set data type
 //	[x] update table column set unique column
 //	[x] update table column set nullable
+//	[x] create table with index declared in column tag or Indexes method
+//	[x] update table index - create, update (drop and create) and delete
+//	[x] create table with publication declared in realtime tag or Publications method
+//	[x] update table publication - add, update column list and row filter and drop
 //
 // [ ] migrate role
 //
@@ -88,11 +121,34 @@ type MigrateData struct {
 //	[x] delete storage
 //	[x] add storage acl
 //	[x] update storage acl
+//
+// [x] migrate trigger
+//
+//	[x] create trigger
+//	[x] update trigger (drop and create)
+//	[x] delete trigger
+//
+// [x] migrate view
+//
+//	[x] create view and materialized view (ordered by dependency)
+//	[x] update view (create or replace)
+//	[x] update materialized view (drop and create)
+//	[x] delete view
+//
+// [x] migrate extension
+//
+//	[x] enable extension (before table and type)
+//	[x] update extension schema and version
+//	[x] disable extension
 func Apply(flags *Flags, config *raiden.Config) error {
 	// declare default variable
 	var migrateData MigrateData
 	var localState state.LocalState
 
+	if err := ValidateOutput(flags.Output); err != nil {
+		return err
+	}
+
 	if flags.DryRun {
 		ApplyLogger.Info("running apply in dry run mode")
 	}
@@ -104,6 +160,13 @@ func Apply(flags *Flags, config *raiden.Config) error {
 		return err
 	}
 
+	// apply from other machine wait until state is released
+	releaseState, err := useStateBackend(config, !flags.DryRun, ApplyLogger)
+	if err != nil {
+		return err
+	}
+	defer releaseState()
+
 	// load app resource
 	ApplyLogger.Info("load resource from local state")
 	latestLocalState, err := state.Load()
@@ -118,16 +181,15 @@ func Apply(flags *Flags, config *raiden.Config) error {
 	}
 
 	ApplyLogger.Info("extract table, role, and rpc from local state")
-	appTables, appRoles, appRpcFunctions, appStorage, appTypes, err := extractAppResource(flags, latestLocalState)
+	app, err := loadAppResource(flags, latestLocalState)
 	if err != nil {
 		return err
 	}
-	appPolicies := mergeAllPolicy(appTables, appStorage)
 
 	// validate table relation
 	var validateTable []objects.Table
-	validateTable = append(validateTable, appTables.New.ToFlatTable()...)
-	validateTable = append(validateTable, appTables.Existing.ToFlatTable()...)
+	validateTable = append(validateTable, app.Tables.New.ToFlatTable()...)
+	validateTable = append(validateTable, app.Tables.Existing.ToFlatTable()...)
 	ApplyLogger.Info("validate local table relation")
 	if err := validateTableRelations(validateTable...); err != nil {
 		return err
@@ -135,7 +197,7 @@ func Apply(flags *Flags, config *raiden.Config) error {
 
 	// validate role in policies is exist
 	ApplyLogger.Info("validate local role")
-	if err := validateRoleIsExist(appPolicies, appRoles, mapNativeRole); err != nil {
+	if err := validateRoleIsExist(app.Policies, app.Roles, mapNativeRole); err != nil {
 		return err
 	}
 
@@ -146,27 +208,72 @@ func Apply(flags *Flags, config *raiden.Config) error {
 		return err
 	}
 
-	// filter table for with allowed schema
-	ApplyLogger.Debug("start filter table and function by allowed schema", "allowed-schema", flags.AllowedSchema)
-	ApplyLogger.Trace("filter table by schema")
-	resource.Tables = filterTableBySchema(resource.Tables, strings.Split(flags.AllowedSchema, ",")...)
-	if config.Mode == raiden.BffMode {
-		if config.AllowedTables != "*" {
-			allowedTable := strings.Split(config.AllowedTables, ",")
-			resource.Tables = filterAllowedTables(resource.Tables, strings.Split(flags.AllowedSchema, ","), allowedTable...)
+	migrateData, err = buildMigrateData(flags, config, resource, mapNativeRole, &app)
+	if err != nil {
+		return err
+	}
+
+	ApplyLogger.Info("check destructive change")
+	if err := CheckDestructiveChange(flags, migrateData, getAllowDestructiveResource(&app)); err != nil {
+		return err
+	}
+
+	ApplyLogger.Info("finish build migrate data")
+	if flags.EmitMigrations != "" {
+		migrationDir := flags.EmitMigrations
+		if !filepath.IsAbs(migrationDir) {
+			migrationDir = filepath.Join(flags.ProjectPath, migrationDir)
+		}
+
+		if _, _, err := EmitMigrations(migrationDir, &migrateData, time.Now()); err != nil {
+			return err
 		}
 	}
-	ApplyLogger.Trace("filter function by schema")
-	resource.Functions = filterFunctionBySchema(resource.Functions, strings.Split(flags.AllowedSchema, ",")...)
-	ApplyLogger.Debug("finish filter table and function by allowed schema", "allowed-schema", flags.AllowedSchema)
 
-	ApplyLogger.Trace("remove native role for supabase list role")
-	resource.Roles = filterUserRole(resource.Roles, mapNativeRole)
+	if !flags.DryRun {
+		// state before apply is stored as snapshot for rollback
+		snapshot, err := state.MarshalSnapshot(latestLocalState)
+		if err != nil {
+			return err
+		}
+
+		startTime := time.Now()
+		migrateErr := runMigrate(flags, config, &localState, &migrateData)
+		recordMigration(config, objects.MigrationActionApply, nil, snapshot, &localState, &migrateData, startTime, migrateErr)
+		if len(migrateErr) > 0 {
+			return joinMigrateErrors(migrateErr)
+		}
+		ApplyLogger.Info("finish migrate resource")
+	}
+
+	if flags.Output == OutputJson {
+		return PrintPlan(os.Stdout, BuildApplyPlan(migrateData, flags.DryRun))
+	}
+	PrintApplyChangeReport(migrateData)
+	return nil
+}
+
+// runMigrate migrate resource concurrently per resource type or as single
+// transaction script when transactional flag is enabled
+func runMigrate(flags *Flags, config *raiden.Config, localState *state.LocalState, migrateData *MigrateData) []error {
+	if flags.Transactional {
+		if err := MigrateTransaction(flags, config, localState, migrateData); err != nil {
+			return []error{err}
+		}
+		return nil
+	}
+	return Migrate(config, localState, flags.ProjectPath, migrateData)
+}
+
+// buildMigrateData compare app resource with supabase resource
+// and return list of change that need to migrate
+func buildMigrateData(flags *Flags, config *raiden.Config, resource *Resource, mapNativeRole map[string]raiden.Role, app *appResource) (migrateData MigrateData, err error) {
+	filterMigrateResource(flags, config, resource, mapNativeRole)
 
 	ApplyLogger.Info("start build migrate data")
 	if flags.All() || flags.RolesOnly {
-		if data, err := roles.BuildMigrateData(appRoles, resource.Roles); err != nil {
-			return err
+		if data, err := roles.BuildMigrateData(app.Roles, resource.Roles); err != nil {
+			return migrateData, err
 		} else {
 			migrateData.Roles = data
 		}
@@ -180,67 +287,101 @@ func Apply(flags *Flags, config *raiden.Config) error {
 		}
 
 		resource.Tables = tables.AttachIndexAndAction(resource.Tables, resource.Indexes, resource.RelationActions)
-		if data, err := tables.BuildMigrateData(appTables, resource.Tables, allowedTable); err != nil {
-			return err
+		resource.Tables = tables.AttachPublication(resource.Tables, resource.Publications)
+		if data, err := tables.BuildMigrateData(app.Tables, resource.Tables, allowedTable); err != nil {
+			return migrateData, err
 		} else {
 			migrateData.Tables = data
 		}
 	}
 
 	if flags.All() || flags.RpcOnly {
-		if data, err := rpc.BuildMigrateData(appRpcFunctions, resource.Functions); err != nil {
-			return err
+		if data, err := rpc.BuildMigrateData(app.Rpc, resource.Functions); err != nil {
+			return migrateData, err
 		} else {
 			migrateData.Rpc = data
 		}
 	}
 
 	if flags.All() || flags.StoragesOnly {
-		if data, err := storages.BuildMigrateData(appStorage, resource.Storages); err != nil {
-			return err
+		if data, err := storages.BuildMigrateData(app.Storages, resource.Storages); err != nil {
+			return migrateData, err
 		} else {
 			migrateData.Storages = data
 		}
 	}
 
-	if len(appPolicies.New) > 0 || len(appPolicies.Existing) > 0 || len(appPolicies.Delete) > 0 {
+	if len(app.Policies.New) > 0 || len(app.Policies.Existing) > 0 || len(app.Policies.Delete) > 0 {
 		// bind app policies to resource
-		if data, err := policies.BuildMigrateData(appPolicies, resource.Policies); err != nil {
-			return err
+		if data, err := policies.BuildMigrateData(app.Policies, resource.Policies); err != nil {
+			return migrateData, err
 		} else {
 			migrateData.Policies = data
 		}
 	}
 
-	if len(appTypes.New) > 0 || len(appTypes.Existing) > 0 || len(appTypes.Delete) > 0 {
+	if len(app.Types.New) > 0 || len(app.Types.Existing) > 0 || len(app.Types.Delete) > 0 {
 		// bind app policies to resource
-		if data, err := types.BuildMigrateData(appTypes, resource.Types); err != nil {
-			return err
+		if data, err := types.BuildMigrateData(app.Types, resource.Types); err != nil {
+			return migrateData, err
 		} else {
 			migrateData.Types = data
 		}
 
 	}
 
-	ApplyLogger.Info("finish build migrate data")
-	if !flags.DryRun {
-		migrateErr := Migrate(config, &localState, flags.ProjectPath, &migrateData)
-		if len(migrateErr) > 0 {
-			var errMessages []string
-			for _, e := range migrateErr {
-				errMessages = append(errMessages, e.Error())
-			}
+	if len(app.Triggers.New) > 0 || len(app.Triggers.Existing) > 0 || len(app.Triggers.Delete) > 0 {
+		if data, err := triggers.BuildMigrateData(app.Triggers, resource.Triggers); err != nil {
+			return migrateData, err
+		} else {
+			migrateData.Triggers = data
+		}
+	}
 
-			return errors.New(strings.Join(errMessages, ","))
+	if len(app.Extensions.New) > 0 || len(app.Extensions.Existing) > 0 || len(app.Extensions.Delete) > 0 {
+		if data, err := extensions.BuildMigrateData(app.Extensions, resource.Extensions); err != nil {
+			return migrateData, err
+		} else {
+			migrateData.Extensions = data
 		}
-		ApplyLogger.Info("finish migrate resource")
 	}
-	PrintApplyChangeReport(migrateData)
-	return nil
+
+	if len(app.Views.New) > 0 || len(app.Views.Existing) > 0 || len(app.Views.Delete) > 0 {
+		if data, err := views.BuildMigrateData(app.Views, resource.Views); err != nil {
+			return migrateData, err
+		} else {
+			migrateData.Views = data
+		}
+	}
+
+	return migrateData, nil
+}
+
+// filterMigrateResource remove resource that is not allowed to migrate
+// based on allowed schema, allowed table and native role
+func filterMigrateResource(flags *Flags, config *raiden.Config, resource *Resource, mapNativeRole map[string]raiden.Role) {
+	// filter table for with allowed schema
+	ApplyLogger.Debug("start filter table and function by allowed schema", "allowed-schema", flags.AllowedSchema)
+	ApplyLogger.Trace("filter table by schema")
+	resource.Tables = filterTableBySchema(resource.Tables, strings.Split(flags.AllowedSchema, ",")...)
+	if config.Mode == raiden.BffMode {
+		if config.AllowedTables != "*" {
+			allowedTable := strings.Split(config.AllowedTables, ",")
+			resource.Tables = filterAllowedTables(resource.Tables, strings.Split(flags.AllowedSchema, ","), allowedTable...)
+		}
+	}
+	ApplyLogger.Trace("filter function by schema")
+	resource.Functions = filterFunctionBySchema(resource.Functions, strings.Split(flags.AllowedSchema, ",")...)
+	ApplyLogger.Trace("filter trigger by table")
+	resource.Triggers = filterTriggerByTables(resource.Triggers, resource.Tables, strings.Split(flags.AllowedSchema, ",")...)
+	ApplyLogger.Debug("finish filter table and function by allowed schema", "allowed-schema", flags.AllowedSchema)
+
+	ApplyLogger.Trace("remove native role for supabase list role")
+	resource.Roles = filterUserRole(resource.Roles, mapNativeRole)
 }
 
 func Migrate(config *raiden.Config, importState *state.LocalState, projectPath string, resource *MigrateData) (errors []error) {
-	wg, errChan, stateChan := sync.WaitGroup{}, make(chan []error), make(chan any)
+	stateChan := make(chan any)
 	doneListen := UpdateLocalStateFromApply(projectPath, importState, stateChan)
 
 	// role must be run first because will be use when create/update rls
@@ -253,6 +394,35 @@ func Migrate(config *raiden.Config, importState *state.LocalState, projectPath s
 		}
 	}
 
+	// extension must be enabled before table and type because
+	// column type or default value can depend on extension
+	if len(resource.Extensions) > 0 {
+		errors = extensions.Migrate(config, resource.Extensions, stateChan, extensions.ActionFunc)
+		if len(errors) > 0 {
+			close(stateChan)
+			return errors
+		}
+	}
+
+	// type must be exist before table because column can use the type,
+	// deleted type is migrated after table no longer use it
+	var upsertTypes, deleteTypes []types.MigrateItem
+	for i := range resource.Types {
+		if resource.Types[i].Type == migrator.MigrateTypeDelete {
+			deleteTypes = append(deleteTypes, resource.Types[i])
+			continue
+		}
+		upsertTypes = append(upsertTypes, resource.Types[i])
+	}
+
+	if len(upsertTypes) > 0 {
+		errors = types.Migrate(config, upsertTypes, stateChan, types.ActionFunc)
+		if len(errors) > 0 {
+			close(stateChan)
+			return errors
+		}
+	}
+
 	if len(resource.Tables) > 0 {
 		var updateTableRelation []tables.MigrateItem
 		for i := range resource.Tables {
@@ -300,75 +470,63 @@ func Migrate(config *raiden.Config, importState *state.LocalState, projectPath s
 	}
 
 	if len(resource.Rpc) > 0 {
-		wg.Add(1)
-		go func(w *sync.WaitGroup, eChan chan []error) {
-			defer wg.Done()
+		errors = rpc.Migrate(config, resource.Rpc, stateChan, rpc.ActionFunc)
+		if len(errors) > 0 {
+			close(stateChan)
+			return errors
+		}
+	}
 
-			errors := rpc.Migrate(config, resource.Rpc, stateChan, rpc.ActionFunc)
-			if len(errors) > 0 {
-				eChan <- errors
-				return
-			}
-		}(&wg, errChan)
+	// view must be run after table and rpc because
+	// view definition can use table and function
+	if len(resource.Views) > 0 {
+		errors = views.Migrate(config, resource.Views, stateChan, views.ActionFunc)
+		if len(errors) > 0 {
+			close(stateChan)
+			return errors
+		}
+	}
+
+	// trigger must be run after rpc because
+	// trigger function can be created in the same apply
+	if len(resource.Triggers) > 0 {
+		errors = triggers.Migrate(config, resource.Triggers, stateChan, triggers.ActionFunc)
+		if len(errors) > 0 {
+			close(stateChan)
+			return errors
+		}
 	}
 
 	if len(resource.Policies) > 0 {
-		wg.Add(1)
-		go func(w *sync.WaitGroup, eChan chan []error) {
-			defer w.Done()
-			errors := policies.Migrate(config, resource.Policies, stateChan, policies.ActionFunc)
-			if len(errors) > 0 {
-				eChan <- errors
-				return
-			}
-		}(&wg, errChan)
+		errors = policies.Migrate(config, resource.Policies, stateChan, policies.ActionFunc)
+		if len(errors) > 0 {
+			close(stateChan)
+			return errors
+		}
 	}
 
 	if len(resource.Storages) > 0 {
-		wg.Add(1)
-		go func(w *sync.WaitGroup, eChan chan []error) {
-			defer w.Done()
-
-			errors := storages.Migrate(config, resource.Storages, stateChan, storages.ActionFunc)
-			if len(errors) > 0 {
-				eChan <- errors
-				return
-			}
-		}(&wg, errChan)
+		errors = storages.Migrate(config, resource.Storages, stateChan, storages.ActionFunc)
+		if len(errors) > 0 {
+			close(stateChan)
+			return errors
+		}
 	}
 
-	if len(resource.Types) > 0 {
-		wg.Add(1)
-		go func(w *sync.WaitGroup, eChan chan []error) {
-			defer w.Done()
-
-			errors := types.Migrate(config, resource.Types, stateChan, types.ActionFunc)
-			if len(errors) > 0 {
-				eChan <- errors
-				return
-			}
-		}(&wg, errChan)
+	// type is deleted after table because column can still use the type
+	if len(deleteTypes) > 0 {
+		errors = types.Migrate(config, deleteTypes, stateChan, types.ActionFunc)
+		if len(errors) > 0 {
+			close(stateChan)
+			return errors
+		}
 	}
 
-	go func() {
-		wg.Wait()
-		close(stateChan)
-		close(errChan)
-	}()
-
-	for {
-		select {
-		case rsErr := <-errChan:
-			if len(rsErr) > 0 {
-				errors = append(errors, rsErr...)
-			}
-		case saveErr := <-doneListen:
-			if saveErr != nil {
-				errors = append(errors, saveErr)
-			}
-			return
-		}
+	close(stateChan)
+	if saveErr := <-doneListen; saveErr != nil {
+		errors = append(errors, saveErr)
 	}
+	return
 }
 
 func validateTableRelations(migratedTables ...objects.Table) error {
@@ -729,7 +887,7 @@ func UpdateLocalStateFromApply(projectPath string, localState *state.LocalState,
 						continue
 					}
 					rpcStruct := utils.SnakeCaseToPascalCase(m.NewData.Name)
-					rpcPath := fmt.Sprintf("%s/%s/%s.go", projectPath, generator.RpcDir, utils.ToSnakeCase(m.NewData.Name))
+					rpcPath := fmt.Sprintf("%s/%s/%s.go", projectPath, generator.GetRpcDir(m.NewData.Schema), utils.ToSnakeCase(m.NewData.Name))
 
 					r := state.RpcState{
 						Function:   m.NewData,
@@ -813,6 +971,111 @@ func UpdateLocalStateFromApply(projectPath string, localState *state.LocalState,
 					rState.LastUpdate = time.Now()
 					localState.UpdateType(fIndex, rState)
 				}
+			case *triggers.MigrateItem:
+				switch m.Type {
+				case migrator.MigrateTypeCreate:
+					if m.NewData.Name == "" {
+						continue
+					}
+
+					localState.AddTrigger(state.TriggerState{
+						Trigger:       m.NewData,
+						TriggerPath:   fmt.Sprintf("%s/%s/%s.go", projectPath, generator.TriggerDir, generator.GetTriggerFileName(m.NewData)),
+						TriggerStruct: generator.GetTriggerStructName(m.NewData),
+						LastUpdate:    time.Now(),
+					})
+				case migrator.MigrateTypeDelete:
+					if m.OldData.Name == "" {
+						continue
+					}
+					localState.DeleteTrigger(m.OldData.ID)
+				case migrator.MigrateTypeUpdate:
+					fIndex, tState, found := localState.FindTrigger(m.NewData.ID)
+					if !found {
+						// trigger exist in database but not in state
+						localState.AddTrigger(state.TriggerState{
+							Trigger:       m.NewData,
+							TriggerPath:   fmt.Sprintf("%s/%s/%s.go", projectPath, generator.TriggerDir, generator.GetTriggerFileName(m.NewData)),
+							TriggerStruct: generator.GetTriggerStructName(m.NewData),
+							LastUpdate:    time.Now(),
+						})
+						continue
+					}
+
+					tState.Trigger = m.NewData
+					tState.LastUpdate = time.Now()
+					localState.UpdateTrigger(fIndex, tState)
+				}
+			case *views.MigrateItem:
+				switch m.Type {
+				case migrator.MigrateTypeCreate:
+					if m.NewData.Name == "" {
+						continue
+					}
+
+					localState.AddView(state.ViewState{
+						View:       m.NewData,
+						ViewPath:   fmt.Sprintf("%s/%s/%s.go", projectPath, generator.ViewDir, utils.ToSnakeCase(m.NewData.Name)),
+						ViewStruct: generator.GetViewStructName(m.NewData),
+						LastUpdate: time.Now(),
+					})
+				case migrator.MigrateTypeDelete:
+					if m.OldData.Name == "" {
+						continue
+					}
+					localState.DeleteView(m.OldData.ID)
+				case migrator.MigrateTypeUpdate:
+					fIndex, vState, found := localState.FindView(m.NewData.ID)
+					if !found {
+						// view exist in database but not in state
+						localState.AddView(state.ViewState{
+							View:       m.NewData,
+							ViewPath:   fmt.Sprintf("%s/%s/%s.go", projectPath, generator.ViewDir, utils.ToSnakeCase(m.NewData.Name)),
+							ViewStruct: generator.GetViewStructName(m.NewData),
+							LastUpdate: time.Now(),
+						})
+						continue
+					}
+
+					vState.View = m.NewData
+					vState.LastUpdate = time.Now()
+					localState.UpdateView(fIndex, vState)
+				}
+			case *extensions.MigrateItem:
+				switch m.Type {
+				case migrator.MigrateTypeCreate:
+					if m.NewData.Name == "" {
+						continue
+					}
+
+					localState.AddExtension(state.ExtensionState{
+						Extension:       m.NewData,
+						ExtensionPath:   fmt.Sprintf("%s/%s/%s.go", projectPath, generator.ExtensionDir, generator.GetExtensionFileName(m.NewData)),
+						ExtensionStruct: generator.GetExtensionStructName(m.NewData),
+						LastUpdate:      time.Now(),
+					})
+				case migrator.MigrateTypeDelete:
+					if m.OldData.Name == "" {
+						continue
+					}
+					localState.DeleteExtension(m.OldData.Name)
+				case migrator.MigrateTypeUpdate:
+					fIndex, eState, found := localState.FindExtension(m.NewData.Name)
+					if !found {
+						// extension enabled in database but not in state
+						localState.AddExtension(state.ExtensionState{
+							Extension:       m.NewData,
+							ExtensionPath:   fmt.Sprintf("%s/%s/%s.go", projectPath, generator.ExtensionDir, generator.GetExtensionFileName(m.NewData)),
+							ExtensionStruct: generator.GetExtensionStructName(m.NewData),
+							LastUpdate:      time.Now(),
+						})
+						continue
+					}
+
+					eState.Extension = m.NewData
+					eState.LastUpdate = time.Now()
+					localState.UpdateExtension(fIndex, eState)
+				}
 
 			}
 		}
@@ -824,6 +1087,11 @@ func UpdateLocalStateFromApply(projectPath string, localState *state.LocalState,
 func PrintApplyChangeReport(migrateData MigrateData) {
 	diffMessage := []string{}
 
+	diffExtensions := extensions.GetDiffChangeMessage(migrateData.Extensions)
+	if len(diffExtensions) > 0 {
+		diffMessage = append(diffMessage, diffExtensions)
+	}
+
 	diffTable := tables.GetDiffChangeMessage(migrateData.Tables)
 	if len(diffTable) > 0 {
 		diffMessage = append(diffMessage, diffTable)
@@ -854,6 +1122,16 @@ func PrintApplyChangeReport(migrateData MigrateData) {
 		diffMessage = append(diffMessage, diffTypes)
 	}
 
+	diffTriggers := triggers.GetDiffChangeMessage(migrateData.Triggers)
+	if len(diffTriggers) > 0 {
+		diffMessage = append(diffMessage, diffTriggers)
+	}
+
+	diffViews := views.GetDiffChangeMessage(migrateData.Views)
+	if len(diffViews) > 0 {
+		diffMessage = append(diffMessage, diffViews)
+	}
+
 	if len(diffMessage) == 0 {
 		ApplyLogger.Info("your code is up to date, nothing to migrate :)")
 	} else {
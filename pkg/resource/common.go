@@ -2,6 +2,8 @@ package resource
 
 import (
 	"errors"
+	"fmt"
+	"strings"
 	"time"
 
 	"github.com/hashicorp/go-hclog"
@@ -16,17 +18,22 @@ import (
 
 // Flags is struct to binding options when import and apply is run binart
 type Flags struct {
-	ProjectPath     string
-	RpcOnly         bool
-	RolesOnly       bool
-	ModelsOnly      bool
-	StoragesOnly    bool
-	AllowedSchema   string
-	DebugMode       bool
-	TraceMode       bool
-	Generate        generate.Flags
-	UpdateStateOnly bool
-	DryRun          bool
+	ProjectPath      string
+	RpcOnly          bool
+	RolesOnly        bool
+	ModelsOnly       bool
+	StoragesOnly     bool
+	AllowedSchema    string
+	DebugMode        bool
+	TraceMode        bool
+	Generate         generate.Flags
+	UpdateStateOnly  bool
+	DryRun           bool
+	EmitMigrations   string
+	Transactional    bool
+	Output           string
+	AllowDestructive bool
+	Check            bool
 }
 
 // LoadAll is function to check is all resource need to import or apply
@@ -78,6 +85,27 @@ func RegisterTypes(list ...raiden.Type) {
 	registeredTypes = append(registeredTypes, list...)
 }
 
+// ----- Handle register triggers -----
+var registeredTriggers []raiden.Trigger
+
+func RegisterTriggers(list ...raiden.Trigger) {
+	registeredTriggers = append(registeredTriggers, list...)
+}
+
+// ----- Handle register extensions -----
+var registeredExtensions []raiden.Extension
+
+func RegisterExtensions(list ...raiden.Extension) {
+	registeredExtensions = append(registeredExtensions, list...)
+}
+
+// ----- Handle register views -----
+var registeredViews []raiden.View
+
+func RegisterViews(list ...raiden.View) {
+	registeredViews = append(registeredViews, list...)
+}
+
 // ----- Handle register models -----
 var RegisteredModels []any
 
@@ -92,6 +120,13 @@ func RegisterStorages(list ...raiden.Bucket) {
 	registeredStorages = append(registeredStorages, list...)
 }
 
+// ----- Handle register seeds -----
+var registeredSeeds []raiden.Seed
+
+func RegisterSeeds(list ...raiden.Seed) {
+	registeredSeeds = append(registeredSeeds, list...)
+}
+
 // ----- Filter function -----
 func filterTableBySchema(input []objects.Table, allowedSchema ...string) (output []objects.Table) {
 	filterSchema := []string{"public"}
@@ -159,6 +194,21 @@ func filterAllowedTables(input []objects.Table, allowedSchema []string, allowedT
 	return
 }
 
+// getAllowedSchema return schema from allowed schema flag,
+// public schema is used when flag is not set
+func getAllowedSchema(allowedSchema string) (schemas []string) {
+	for _, s := range strings.Split(allowedSchema, ",") {
+		if s = strings.TrimSpace(s); s != "" {
+			schemas = append(schemas, s)
+		}
+	}
+
+	if len(schemas) == 0 {
+		schemas = []string{"public"}
+	}
+	return
+}
+
 func filterFunctionBySchema(input []objects.Function, allowedSchema ...string) (output []objects.Function) {
 	filterSchema := []string{"public"}
 	if len(allowedSchema) > 0 && allowedSchema[0] != "" {
@@ -182,6 +232,36 @@ func filterFunctionBySchema(input []objects.Function, allowedSchema ...string) (
 	return
 }
 
+// filterTriggerByTables keep trigger in allowed schema, trigger in schema that has loaded
+// tables must be attached to one of the tables so it follow the allowed tables filter
+func filterTriggerByTables(input []objects.Trigger, tables []objects.Table, allowedSchema ...string) (output []objects.Trigger) {
+	mapSchema := make(map[string]bool)
+	for _, s := range getAllowedSchema(strings.Join(allowedSchema, ",")) {
+		mapSchema[s] = true
+	}
+
+	mapTable, mapTableSchema := make(map[string]bool), make(map[string]bool)
+	for i := range tables {
+		t := tables[i]
+		mapTable[fmt.Sprintf("%s.%s", t.Schema, t.Name)] = true
+		mapTableSchema[t.Schema] = true
+	}
+
+	for i := range input {
+		t := input[i]
+		if !mapSchema[t.Schema] {
+			continue
+		}
+
+		if mapTableSchema[t.Schema] && !mapTable[fmt.Sprintf("%s.%s", t.Schema, t.Table)] {
+			continue
+		}
+		output = append(output, t)
+	}
+
+	return
+}
+
 func filterUserRole(roles []objects.Role, mapNativeRole map[string]raiden.Role) (userRole []objects.Role) {
 	for i := range roles {
 		r := roles[i]
@@ -214,7 +294,8 @@ func filterIsNativeRole(mapNativeRole map[string]raiden.Role, supabaseRole []obj
 func extractAppResource(f *Flags, latestState *state.State) (
 	extractedTable state.ExtractTableResult, extractedRole state.ExtractRoleResult,
 	extractedRpc state.ExtractRpcResult, extractedStorage state.ExtractStorageResult,
-	extractedType state.ExtractTypeResult,
+	extractedType state.ExtractTypeResult, extractedTrigger state.ExtractTriggerResult,
+	extractedView state.ExtractViewResult, extractedExtension state.ExtractExtensionResult,
 	err error,
 ) {
 	if latestState == nil {
@@ -245,6 +326,27 @@ func extractAppResource(f *Flags, latestState *state.State) (
 			return
 		}
 		ImportLogger.Debug("Finish extract table")
+
+		ImportLogger.Debug("Start extract trigger")
+		extractedTrigger, err = state.ExtractTrigger(latestState.Triggers, registeredTriggers)
+		if err != nil {
+			return
+		}
+		ImportLogger.Debug("Finish extract trigger")
+
+		ImportLogger.Debug("Start extract view")
+		extractedView, err = state.ExtractView(latestState.Views, registeredViews)
+		if err != nil {
+			return
+		}
+		ImportLogger.Debug("Finish extract view")
+
+		ImportLogger.Debug("Start extract extension")
+		extractedExtension, err = state.ExtractExtension(latestState.Extensions, registeredExtensions)
+		if err != nil {
+			return
+		}
+		ImportLogger.Debug("Finish extract extension")
 	}
 
 	if f.All() || f.RolesOnly {
@@ -276,3 +378,30 @@ func extractAppResource(f *Flags, latestState *state.State) (
 
 	return
 }
+
+// useStateBackend set state backend from configuration and take state lock when lock is true,
+// returned function must be called to release the lock
+func useStateBackend(config *raiden.Config, lock bool, l hclog.Logger) (release func(), err error) {
+	backend, err := state.NewBackend(config)
+	if err != nil {
+		return nil, err
+	}
+	state.SetBackend(backend)
+
+	release = func() {}
+	if !lock {
+		return release, nil
+	}
+
+	l.Info("lock state", "backend", config.StateBackend)
+	if err := backend.Lock(); err != nil {
+		return nil, err
+	}
+
+	release = func() {
+		if err := backend.Unlock(); err != nil {
+			l.Error("failed unlock state", "message", err.Error())
+		}
+	}
+	return release, nil
+}
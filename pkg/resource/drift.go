@@ -0,0 +1,177 @@
+package resource
+
+import (
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/sev-2/raiden"
+	"github.com/sev-2/raiden/pkg/resource/migrator"
+	"github.com/sev-2/raiden/pkg/state"
+	"github.com/sev-2/raiden/pkg/supabase/objects"
+)
+
+// buildDriftPlan compare database resource with local state and app resource.
+// resource that only exist in database is reported as create, resource in local state
+// that is not found in database as delete and changed resource as update
+func buildDriftPlan(flags *Flags, config *raiden.Config, spResource *Resource, localState *state.State, app *appResource) (Plan, error) {
+	changes, err := buildComparePlanChanges(flags, spResource, app)
+	if err != nil {
+		return Plan{}, err
+	}
+
+	if localState == nil {
+		localState = &state.State{}
+	}
+
+	allowedSchema := strings.Split(flags.AllowedSchema, ",")
+	mapSchema := getAllowedSchemaMap(flags.AllowedSchema)
+	isBff := config.Mode == raiden.BffMode
+
+	if isBff && (flags.All() || flags.RolesOnly) {
+		var localRoles []objects.Role
+		for i := range localState.Roles {
+			if !localState.Roles[i].IsNative {
+				localRoles = append(localRoles, localState.Roles[i].Role)
+			}
+		}
+		changes = appendPresenceDrift(changes, "role", spResource.Roles, localRoles, func(r objects.Role) string {
+			return r.Name
+		})
+	}
+
+	if flags.All() || flags.ModelsOnly {
+		var localTables []objects.Table
+		for i := range localState.Tables {
+			localTables = append(localTables, localState.Tables[i].Table)
+		}
+		localTables = filterTableBySchema(localTables, allowedSchema...)
+		if isBff && config.AllowedTables != "*" && config.AllowedTables != "" {
+			localTables = filterAllowedTables(localTables, allowedSchema, strings.Split(config.AllowedTables, ",")...)
+		}
+		changes = appendPresenceDrift(changes, "table", spResource.Tables, localTables, func(t objects.Table) string {
+			return fmt.Sprintf("%s.%s", t.Schema, t.Name)
+		})
+
+		var localTypes []objects.Type
+		for i := range localState.Types {
+			localTypes = append(localTypes, localState.Types[i].Type)
+		}
+		isAllowedType := func(t objects.Type) bool { return mapSchema[t.Schema] }
+		changes = appendPresenceDrift(changes, "type", filterSlice(spResource.Types, isAllowedType), filterSlice(localTypes, isAllowedType), func(t objects.Type) string {
+			return fmt.Sprintf("%s.%s", t.Schema, t.Name)
+		})
+
+		var localTriggers []objects.Trigger
+		for i := range localState.Triggers {
+			localTriggers = append(localTriggers, localState.Triggers[i].Trigger)
+		}
+		localTriggers = filterTriggerByTables(localTriggers, localTables, allowedSchema...)
+		changes = appendPresenceDrift(changes, "trigger", spResource.Triggers, localTriggers, func(t objects.Trigger) string {
+			return fmt.Sprintf("%s.%s.%s", t.Schema, t.Table, t.Name)
+		})
+
+		var localViews []objects.View
+		for i := range localState.Views {
+			localViews = append(localViews, localState.Views[i].View)
+		}
+		isAllowedView := func(v objects.View) bool { return mapSchema[v.Schema] }
+		changes = appendPresenceDrift(changes, "view", filterSlice(spResource.Views, isAllowedView), filterSlice(localViews, isAllowedView), func(v objects.View) string {
+			return fmt.Sprintf("%s.%s", v.Schema, v.Name)
+		})
+
+		var localExtensions []objects.Extension
+		for i := range localState.Extensions {
+			localExtensions = append(localExtensions, localState.Extensions[i].Extension)
+		}
+		isAllowedExtension := func(e objects.Extension) bool { return mapSchema[e.Schema] }
+		changes = appendPresenceDrift(changes, "extension", filterSlice(spResource.Extensions, isAllowedExtension), filterSlice(localExtensions, isAllowedExtension), func(e objects.Extension) string {
+			return e.Name
+		})
+	}
+
+	if flags.All() || flags.RpcOnly {
+		var localFunctions []objects.Function
+		for i := range localState.Rpc {
+			localFunctions = append(localFunctions, localState.Rpc[i].Function)
+		}
+		localFunctions = filterFunctionBySchema(localFunctions, allowedSchema...)
+		changes = appendPresenceDrift(changes, "rpc", spResource.Functions, localFunctions, state.GetRpcKey)
+	}
+
+	if isBff && (flags.All() || flags.StoragesOnly) {
+		var localStorages []objects.Bucket
+		for i := range localState.Storage {
+			localStorages = append(localStorages, localState.Storage[i].Storage)
+		}
+		changes = appendPresenceDrift(changes, "storage", spResource.Storages, localStorages, func(b objects.Bucket) string {
+			return b.Name
+		})
+	}
+
+	return newPlan("drift", true, changes), nil
+}
+
+// checkDrift print drift plan and return error when database is changed outside raiden
+func checkDrift(flags *Flags, plan Plan) error {
+	if flags.Output == OutputJson {
+		if err := PrintPlan(os.Stdout, plan); err != nil {
+			return err
+		}
+	} else {
+		PrintDriftReport(plan)
+	}
+
+	if len(plan.Changes) > 0 {
+		return fmt.Errorf("drift detected, %d resource in database is different with local resource", len(plan.Changes))
+	}
+	return nil
+}
+
+func PrintDriftReport(plan Plan) {
+	if len(plan.Changes) == 0 {
+		ImportLogger.Info("no drift detected, database is in sync with local resource")
+		return
+	}
+
+	var changes []string
+	for _, c := range plan.Changes {
+		var fields []string
+		for _, d := range c.Diffs {
+			fields = append(fields, d.Field)
+		}
+
+		line := fmt.Sprintf("- %s %s %s", c.Action, c.Kind, c.Name)
+		if len(fields) > 0 {
+			line += fmt.Sprintf(" (%s)", strings.Join(fields, ", "))
+		}
+		changes = append(changes, line)
+	}
+	ImportLogger.Warn("drift report", "create", plan.Summary.Create, "update", plan.Summary.Update, "delete", plan.Summary.Delete, "list", strings.Join(changes, "\n"))
+}
+
+// appendPresenceDrift add create change for resource that only exist in database
+// and delete change for resource that only exist in local state
+func appendPresenceDrift[T any](changes []migrator.PlanChange, kind string, remote []T, local []T, keyFn func(T) string) []migrator.PlanChange {
+	mapRemote, mapLocal := make(map[string]bool), make(map[string]bool)
+	for i := range remote {
+		mapRemote[keyFn(remote[i])] = true
+	}
+
+	for i := range local {
+		key := keyFn(local[i])
+		mapLocal[key] = true
+		if !mapRemote[key] {
+			changes = append(changes, migrator.PlanChange{Kind: kind, Name: key, Action: migrator.MigrateTypeDelete, Risk: migrator.RiskLevelSafe})
+		}
+	}
+
+	for i := range remote {
+		key := keyFn(remote[i])
+		if !mapLocal[key] {
+			mapLocal[key] = true
+			changes = append(changes, migrator.PlanChange{Kind: kind, Name: key, Action: migrator.MigrateTypeCreate, Risk: migrator.RiskLevelSafe})
+		}
+	}
+	return changes
+}
@@ -0,0 +1,109 @@
+package resource_test
+
+import (
+	"testing"
+
+	"github.com/sev-2/raiden/pkg/mock"
+	"github.com/sev-2/raiden/pkg/resource"
+	"github.com/sev-2/raiden/pkg/state"
+	"github.com/sev-2/raiden/pkg/supabase/objects"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestImportCheck(t *testing.T) {
+	flags := &resource.Flags{
+		ProjectPath: t.TempDir(),
+		ModelsOnly:  true,
+		Check:       true,
+	}
+	config := loadConfig()
+
+	mock := &mock.MockSupabase{Cfg: config}
+	mock.Activate()
+	defer mock.Deactivate()
+
+	testState := state.State{
+		Tables: []state.TableState{
+			{Table: objects.Table{ID: 1, Name: "other_table", Schema: "public", RLSEnabled: false}},
+			{Table: objects.Table{ID: 5, Name: "removed_table", Schema: "public"}},
+		},
+	}
+	assert.NoError(t, state.Save(&testState))
+	defer func() {
+		assert.NoError(t, state.Save(&state.State{}))
+	}()
+
+	resource.RegisterModels(MockOtherTable{})
+
+	otherTable := objects.Table{
+		ID: 1, Name: "other_table", Schema: "public",
+		Columns: []objects.Column{{Name: "id", DataType: "bigint", IsIdentity: true}},
+	}
+
+	// other table is changed, removed table is dropped and dashboard table
+	// is created from supabase dashboard
+	changedTable := otherTable
+	changedTable.Columns = []objects.Column{{Name: "id", DataType: "integer", IsIdentity: true}}
+	err := mock.MockGetTablesWithExpectedResponse(200, []objects.Table{
+		changedTable,
+		{ID: 4, Name: "dashboard_table", Schema: "public"},
+	})
+	assert.NoError(t, err)
+
+	// other resource without matcher use latest response
+	err = mock.MockGetTypesWithExpectedResponse(200, []objects.Type{})
+	assert.NoError(t, err)
+
+	err = resource.Import(flags, config)
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "drift detected, 3 resource")
+
+	// nothing changed in database
+	mock.Deactivate()
+	mock.Activate()
+	err = mock.MockGetTablesWithExpectedResponse(200, []objects.Table{otherTable, {ID: 5, Name: "removed_table", Schema: "public"}})
+	assert.NoError(t, err)
+
+	err = mock.MockGetTypesWithExpectedResponse(200, []objects.Type{})
+	assert.NoError(t, err)
+
+	err = resource.Import(flags, config)
+	assert.NoError(t, err)
+}
+
+func TestImportCheck_TypeOutsideAllowedSchema(t *testing.T) {
+	flags := &resource.Flags{
+		ProjectPath:   t.TempDir(),
+		ModelsOnly:    true,
+		Check:         true,
+		AllowedSchema: "public",
+	}
+	config := loadConfig()
+
+	mock := &mock.MockSupabase{Cfg: config}
+	mock.Activate()
+	defer mock.Deactivate()
+
+	// type outside allowed schema is not managed by project, so it is
+	// never reported as drift from both database and local state
+	testState := state.State{
+		Types: []state.TypeState{
+			{Type: objects.Type{ID: 1, Name: "local_status", Schema: "private"}},
+		},
+	}
+	assert.NoError(t, state.Save(&testState))
+	defer func() {
+		assert.NoError(t, state.Save(&state.State{}))
+	}()
+
+	err := mock.MockGetTablesWithExpectedResponse(200, []objects.Table{})
+	assert.NoError(t, err)
+
+	err = mock.MockGetTypesWithExpectedResponse(200, []objects.Type{
+		{ID: 2, Name: "remote_status", Schema: "private"},
+	})
+	assert.NoError(t, err)
+
+	err = resource.Import(flags, config)
+	assert.NoError(t, err)
+}
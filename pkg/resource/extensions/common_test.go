@@ -0,0 +1,33 @@
+package extensions_test
+
+import (
+	"testing"
+
+	"github.com/sev-2/raiden/pkg/resource/extensions"
+	"github.com/sev-2/raiden/pkg/state"
+	"github.com/sev-2/raiden/pkg/supabase/objects"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGetNewCountData(t *testing.T) {
+	supabaseExtensions := []objects.Extension{
+		{Name: "pg_trgm"},
+		{Name: "vector"},
+		{Name: "postgis"},
+	}
+
+	extractResult := state.ExtractExtensionResult{
+		Delete: []objects.Extension{
+			{Name: "pg_trgm"},
+			{Name: "pgcrypto"},
+		},
+	}
+
+	count := extensions.GetNewCountData(supabaseExtensions, extractResult)
+	assert.Equal(t, 1, count)
+}
+
+func TestGetNewCountDataEmpty(t *testing.T) {
+	count := extensions.GetNewCountData([]objects.Extension{}, state.ExtractExtensionResult{})
+	assert.Equal(t, 0, count)
+}
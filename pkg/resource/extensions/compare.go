@@ -0,0 +1,66 @@
+package extensions
+
+import (
+	"github.com/sev-2/raiden/pkg/supabase/objects"
+)
+
+type CompareDiffResult struct {
+	Name           string
+	SourceResource objects.Extension
+	TargetResource objects.Extension
+	DiffItems      objects.UpdateExtensionParam
+	IsConflict     bool
+}
+
+func Compare(source []objects.Extension, target []objects.Extension) error {
+	diffResult, err := CompareList(source, target)
+	if err != nil {
+		return err
+	}
+	return PrintDiffResult(diffResult)
+}
+
+func CompareList(sourceExtension, targetExtension []objects.Extension) (diffResult []CompareDiffResult, err error) {
+	mapTargetExtensions := make(map[string]objects.Extension)
+	for i := range targetExtension {
+		r := targetExtension[i]
+		mapTargetExtensions[r.Name] = r
+	}
+
+	for i := range sourceExtension {
+		r := sourceExtension[i]
+
+		tr, isExist := mapTargetExtensions[r.Name]
+		if !isExist {
+			continue
+		}
+
+		diffResult = append(diffResult, CompareItem(r, tr))
+	}
+
+	return
+}
+
+func CompareItem(source, target objects.Extension) (diffResult CompareDiffResult) {
+	var updateItem objects.UpdateExtensionParam
+
+	// assign diff result object
+	diffResult.Name = source.Name
+	diffResult.SourceResource = source
+	diffResult.TargetResource = target
+
+	if source.Schema != "" && target.Schema != "" && source.Schema != target.Schema {
+		updateItem.ChangeItems = append(updateItem.ChangeItems, objects.UpdateExtensionSchema)
+	}
+
+	// empty version mean follow installed version
+	if source.InstalledVersion != "" && target.InstalledVersion != "" && source.InstalledVersion != target.InstalledVersion {
+		updateItem.ChangeItems = append(updateItem.ChangeItems, objects.UpdateExtensionVersion)
+	}
+
+	updateItem.OldData = target
+	diffResult.IsConflict = len(updateItem.ChangeItems) > 0
+	diffResult.DiffItems = updateItem
+
+	return
+}
@@ -0,0 +1,39 @@
+package extensions_test
+
+import (
+	"testing"
+
+	"github.com/sev-2/raiden/pkg/resource/extensions"
+	"github.com/sev-2/raiden/pkg/supabase/objects"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCompare(t *testing.T) {
+	source := []objects.Extension{
+		{Name: "vector", Schema: "extensions", InstalledVersion: "0.5.1"},
+	}
+
+	target := []objects.Extension{
+		{Name: "vector", Schema: "extensions", InstalledVersion: "0.7.0"},
+	}
+
+	err := extensions.Compare(source, target)
+	assert.Error(t, err)
+
+	err = extensions.Compare(source, source)
+	assert.NoError(t, err)
+}
+
+func TestCompareItem(t *testing.T) {
+	source := objects.Extension{Name: "pg_trgm", Schema: "public"}
+	target := objects.Extension{Name: "pg_trgm", Schema: "extensions", InstalledVersion: "1.6"}
+
+	diffResult := extensions.CompareItem(source, target)
+	assert.True(t, diffResult.IsConflict)
+	assert.Equal(t, []objects.UpdateExtensionType{objects.UpdateExtensionSchema}, diffResult.DiffItems.ChangeItems)
+	assert.Equal(t, target, diffResult.DiffItems.OldData)
+
+	source.Schema = "extensions"
+	diffResult = extensions.CompareItem(source, target)
+	assert.False(t, diffResult.IsConflict)
+}
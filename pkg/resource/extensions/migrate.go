@@ -0,0 +1,126 @@
+package extensions
+
+import (
+	"github.com/sev-2/raiden"
+	"github.com/sev-2/raiden/pkg/connector/pgmeta"
+	"github.com/sev-2/raiden/pkg/resource/migrator"
+	"github.com/sev-2/raiden/pkg/state"
+	"github.com/sev-2/raiden/pkg/supabase"
+	"github.com/sev-2/raiden/pkg/supabase/objects"
+)
+
+type MigrateItem = migrator.MigrateItem[objects.Extension, objects.UpdateExtensionParam]
+type MigrateActionFunc = migrator.MigrateActionFunc[objects.Extension, objects.UpdateExtensionParam]
+
+var ActionFunc = MigrateActionFunc{
+	CreateFunc: func(cfg *raiden.Config, param objects.Extension) (response objects.Extension, err error) {
+		if cfg.Mode == raiden.SvcMode {
+			return pgmeta.CreateExtension(cfg, param)
+		}
+		return supabase.CreateExtension(cfg, param)
+	},
+	UpdateFunc: func(cfg *raiden.Config, param objects.Extension, items objects.UpdateExtensionParam) (err error) {
+		// nothing to alter, extension only need to be recorded in state
+		if len(items.ChangeItems) == 0 {
+			return nil
+		}
+
+		if cfg.Mode == raiden.SvcMode {
+			return pgmeta.UpdateExtension(cfg, param, items)
+		}
+		return supabase.UpdateExtension(cfg, param, items)
+	},
+	DeleteFunc: func(cfg *raiden.Config, param objects.Extension) (err error) {
+		if cfg.Mode == raiden.SvcMode {
+			return pgmeta.DeleteExtension(cfg, param)
+		}
+		return supabase.DeleteExtension(cfg, param)
+	},
+}
+
+func BuildMigrateData(extractedLocalData state.ExtractExtensionResult, supabaseData []objects.Extension) (migrateData []MigrateItem, err error) {
+	Logger.Info("start build migrate extension data")
+	if rs, err := BuildMigrateItem(supabaseData, extractedLocalData.Existing); err != nil {
+		return migrateData, err
+	} else {
+		migrateData = append(migrateData, rs...)
+	}
+
+	// bind new extension to migrated data
+	Logger.Debug("filter new extension data")
+	mapSupabaseExtension := make(map[string]objects.Extension)
+	for i := range supabaseData {
+		se := supabaseData[i]
+		mapSupabaseExtension[se.Name] = se
+	}
+
+	if len(extractedLocalData.New) > 0 {
+		for i := range extractedLocalData.New {
+			e := extractedLocalData.New[i]
+			if se, exist := mapSupabaseExtension[e.Name]; exist {
+				// extension already enabled in database but not in state,
+				// alter it when needed and record it in state
+				diffResult := CompareItem(e, se)
+				migrateData = append(migrateData, MigrateItem{
+					Type:           migrator.MigrateTypeUpdate,
+					NewData:        e,
+					OldData:        se,
+					MigrationItems: diffResult.DiffItems,
+				})
+				continue
+			}
+
+			migrateData = append(migrateData, MigrateItem{
+				Type:    migrator.MigrateTypeCreate,
+				NewData: e,
+			})
+		}
+	}
+
+	Logger.Debug("filter delete extension data")
+	if len(extractedLocalData.Delete) > 0 {
+		for i := range extractedLocalData.Delete {
+			e := extractedLocalData.Delete[i]
+			if _, exist := mapSupabaseExtension[e.Name]; exist {
+				migrateData = append(migrateData, MigrateItem{
+					Type:    migrator.MigrateTypeDelete,
+					OldData: e,
+				})
+			}
+		}
+	}
+
+	Logger.Info("finish build migrate extension data")
+	return
+}
+
+func BuildMigrateItem(supabaseData []objects.Extension, localData []objects.Extension) (migratedData []MigrateItem, err error) {
+	Logger.Info("compare supabase and local resource for existing extension data")
+	result, e := CompareList(localData, supabaseData)
+	if e != nil {
+		err = e
+		return
+	}
+
+	for i := range result {
+		r := result[i]
+
+		migrateType := migrator.MigrateTypeIgnore
+		if r.IsConflict {
+			migrateType = migrator.MigrateTypeUpdate
+		}
+
+		migratedData = append(migratedData, MigrateItem{
+			Type:           migrateType,
+			NewData:        r.SourceResource,
+			OldData:        r.TargetResource,
+			MigrationItems: r.DiffItems,
+		})
+	}
+
+	return
+}
+
+func Migrate(config *raiden.Config, extensions []MigrateItem, stateChan chan any, actions MigrateActionFunc) []error {
+	return migrator.MigrateResource(config, extensions, stateChan, actions, migrator.DefaultMigrator)
+}
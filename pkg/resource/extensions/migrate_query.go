@@ -0,0 +1,25 @@
+package extensions
+
+import (
+	"github.com/sev-2/raiden/pkg/resource/migrator"
+	"github.com/sev-2/raiden/pkg/supabase/objects"
+	"github.com/sev-2/raiden/pkg/supabase/query"
+)
+
+// BuildMigrateQuery build up and down sql of extension migrate item
+func BuildMigrateQuery(item MigrateItem) (up string, down string, err error) {
+	switch item.Type {
+	case migrator.MigrateTypeCreate:
+		up, down = query.BuildCreateExtensionQuery(&item.NewData), query.BuildDeleteExtensionQuery(&item.NewData)
+	case migrator.MigrateTypeUpdate:
+		oldData := item.MigrationItems.OldData
+		if oldData.Name == "" {
+			oldData = item.OldData
+		}
+		up = query.BuildUpdateExtensionQuery(item.NewData, item.MigrationItems)
+		down = query.BuildUpdateExtensionQuery(oldData, objects.UpdateExtensionParam{OldData: item.NewData, ChangeItems: item.MigrationItems.ChangeItems})
+	case migrator.MigrateTypeDelete:
+		up, down = query.BuildDeleteExtensionQuery(&item.OldData), query.BuildCreateExtensionQuery(&item.OldData)
+	}
+	return
+}
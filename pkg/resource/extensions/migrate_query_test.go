@@ -0,0 +1,47 @@
+package extensions_test
+
+import (
+	"testing"
+
+	"github.com/sev-2/raiden/pkg/resource/extensions"
+	"github.com/sev-2/raiden/pkg/resource/migrator"
+	"github.com/sev-2/raiden/pkg/supabase/objects"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBuildMigrateQuery(t *testing.T) {
+	newExtension := objects.Extension{Name: "pg_trgm", Schema: "extensions", InstalledVersion: "1.6"}
+	oldExtension := objects.Extension{Name: "pg_trgm", Schema: "extensions", InstalledVersion: "1.5"}
+
+	up, down, err := extensions.BuildMigrateQuery(extensions.MigrateItem{Type: migrator.MigrateTypeCreate, NewData: newExtension})
+	assert.NoError(t, err)
+	assert.Contains(t, up, `CREATE EXTENSION IF NOT EXISTS "pg_trgm"`)
+	assert.Equal(t, `DROP EXTENSION IF EXISTS "pg_trgm";`, down)
+
+	up, down, err = extensions.BuildMigrateQuery(extensions.MigrateItem{
+		Type:    migrator.MigrateTypeUpdate,
+		NewData: newExtension,
+		OldData: oldExtension,
+		MigrationItems: objects.UpdateExtensionParam{
+			OldData:     oldExtension,
+			ChangeItems: []objects.UpdateExtensionType{objects.UpdateExtensionVersion},
+		},
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, `ALTER EXTENSION "pg_trgm" UPDATE TO '1.6';`, up)
+	assert.Equal(t, `ALTER EXTENSION "pg_trgm" UPDATE TO '1.5';`, down)
+
+	up, down, err = extensions.BuildMigrateQuery(extensions.MigrateItem{Type: migrator.MigrateTypeDelete, OldData: oldExtension})
+	assert.NoError(t, err)
+	assert.Equal(t, `DROP EXTENSION IF EXISTS "pg_trgm";`, up)
+	assert.Contains(t, down, `CREATE EXTENSION IF NOT EXISTS "pg_trgm"`)
+}
+
+func TestBuildMigrateQuery_QuotedIdentifier(t *testing.T) {
+	extension := objects.Extension{Name: `pg"trgm`, Schema: `my"schema`}
+
+	up, down, err := extensions.BuildMigrateQuery(extensions.MigrateItem{Type: migrator.MigrateTypeCreate, NewData: extension})
+	assert.NoError(t, err)
+	assert.Equal(t, `CREATE EXTENSION IF NOT EXISTS "pg""trgm" WITH SCHEMA "my""schema";`, up)
+	assert.Equal(t, `DROP EXTENSION IF EXISTS "pg""trgm";`, down)
+}
@@ -0,0 +1,91 @@
+package extensions_test
+
+import (
+	"testing"
+
+	"github.com/sev-2/raiden"
+	"github.com/sev-2/raiden/pkg/resource/extensions"
+	"github.com/sev-2/raiden/pkg/resource/migrator"
+	"github.com/sev-2/raiden/pkg/state"
+	"github.com/sev-2/raiden/pkg/supabase/objects"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBuildMigrateData(t *testing.T) {
+	extractedLocalData := state.ExtractExtensionResult{
+		New: []objects.Extension{
+			{Name: "pgcrypto", Schema: "extensions"},
+			{Name: "vector", Schema: "extensions"},
+		},
+		Existing: []objects.Extension{
+			{Name: "pg_trgm", Schema: "public"},
+			{Name: "postgis", Schema: "extensions"},
+		},
+		Delete: []objects.Extension{
+			{Name: "pgjwt"},
+			{Name: "pg_net"},
+		},
+	}
+
+	supabaseExtensions := []objects.Extension{
+		{Name: "pgcrypto", Schema: "extensions"},
+		{Name: "pg_trgm", Schema: "extensions"},
+		{Name: "pgjwt", Schema: "extensions"},
+	}
+
+	migrateData, err := extensions.BuildMigrateData(extractedLocalData, supabaseExtensions)
+	assert.NoError(t, err)
+	assert.Equal(t, 4, len(migrateData))
+
+	assert.Equal(t, migrator.MigrateTypeUpdate, migrateData[0].Type)
+	assert.Equal(t, "pg_trgm", migrateData[0].NewData.Name)
+
+	assert.Equal(t, migrator.MigrateTypeUpdate, migrateData[1].Type)
+	assert.Equal(t, "pgcrypto", migrateData[1].NewData.Name)
+	assert.Len(t, migrateData[1].MigrationItems.ChangeItems, 0)
+
+	assert.Equal(t, migrator.MigrateTypeCreate, migrateData[2].Type)
+	assert.Equal(t, "vector", migrateData[2].NewData.Name)
+
+	assert.Equal(t, migrator.MigrateTypeDelete, migrateData[3].Type)
+	assert.Equal(t, "pgjwt", migrateData[3].OldData.Name)
+}
+
+func TestBuildMigrateItem(t *testing.T) {
+	localExtensions := []objects.Extension{
+		{Name: "pg_trgm", Schema: "extensions"},
+		{Name: "vector", Schema: "extensions", InstalledVersion: "0.7.0"},
+	}
+
+	supabaseExtensions := []objects.Extension{
+		{Name: "pg_trgm", Schema: "extensions", InstalledVersion: "1.6"},
+		{Name: "vector", Schema: "extensions", InstalledVersion: "0.5.1"},
+	}
+
+	migrateData, err := extensions.BuildMigrateItem(supabaseExtensions, localExtensions)
+	assert.NoError(t, err)
+	assert.Equal(t, 2, len(migrateData))
+	assert.Equal(t, migrator.MigrateTypeIgnore, migrateData[0].Type)
+	assert.Equal(t, migrator.MigrateTypeUpdate, migrateData[1].Type)
+}
+
+func TestMigrate(t *testing.T) {
+	config := &raiden.Config{}
+	stateChan := make(chan any, 1)
+	defer close(stateChan)
+
+	migrateItems := []extensions.MigrateItem{
+		{
+			Type:    migrator.MigrateTypeCreate,
+			NewData: objects.Extension{Name: "vector"},
+		},
+		{
+			Type:    migrator.MigrateTypeUpdate,
+			NewData: objects.Extension{Name: "pgcrypto"},
+		},
+	}
+
+	errors := extensions.Migrate(config, migrateItems, stateChan, extensions.ActionFunc)
+	assert.Equal(t, 1, len(errors))
+	assert.Len(t, stateChan, 1)
+}
@@ -0,0 +1,61 @@
+package extensions
+
+import (
+	"github.com/sev-2/raiden/pkg/resource/migrator"
+	"github.com/sev-2/raiden/pkg/supabase/objects"
+)
+
+// GetPlanChanges convert migrate item to plan change,
+// ignored item is not included
+func GetPlanChanges(items []MigrateItem) (changes []migrator.PlanChange) {
+	for i := range items {
+		item := items[i]
+		if item.Type == migrator.MigrateTypeIgnore {
+			continue
+		}
+
+		name := item.NewData.Name
+		if item.Type == migrator.MigrateTypeDelete {
+			name = item.OldData.Name
+		}
+
+		change := migrator.NewPlanChange("extension", name, item)
+		if item.Type == migrator.MigrateTypeDelete {
+			// object owned by the extension like table and type is dropped with the extension
+			change.SetRisk(migrator.RiskLevelDestructive)
+		}
+		if item.Type == migrator.MigrateTypeUpdate {
+			oldData, newData := item.OldData, item.NewData
+			for _, c := range item.MigrationItems.ChangeItems {
+				switch c {
+				case objects.UpdateExtensionSchema:
+					change.AddDiff(string(c), oldData.Schema, newData.Schema)
+				case objects.UpdateExtensionVersion:
+					change.AddDiff(string(c), oldData.InstalledVersion, newData.InstalledVersion)
+				}
+			}
+		}
+		changes = append(changes, change)
+	}
+	return
+}
+
+// GetComparePlanChanges convert conflicted compare result to update plan change,
+// source resource is used as new data and target resource as old data
+func GetComparePlanChanges(diffResult []CompareDiffResult) []migrator.PlanChange {
+	var items []MigrateItem
+	for i := range diffResult {
+		d := diffResult[i]
+		if !d.IsConflict {
+			continue
+		}
+
+		items = append(items, MigrateItem{
+			Type:           migrator.MigrateTypeUpdate,
+			NewData:        d.SourceResource,
+			OldData:        d.TargetResource,
+			MigrationItems: d.DiffItems,
+		})
+	}
+	return GetPlanChanges(items)
+}
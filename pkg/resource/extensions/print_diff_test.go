@@ -0,0 +1,68 @@
+package extensions_test
+
+import (
+	"testing"
+
+	"github.com/sev-2/raiden/pkg/resource/extensions"
+	"github.com/sev-2/raiden/pkg/resource/migrator"
+	"github.com/sev-2/raiden/pkg/supabase/objects"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPrintDiffResult(t *testing.T) {
+	diffResult := []extensions.CompareDiffResult{
+		{
+			IsConflict:     true,
+			SourceResource: objects.Extension{Name: "vector", InstalledVersion: "0.5.1"},
+			TargetResource: objects.Extension{Name: "vector", InstalledVersion: "0.7.0"},
+			DiffItems: objects.UpdateExtensionParam{
+				ChangeItems: []objects.UpdateExtensionType{objects.UpdateExtensionVersion},
+			},
+		},
+	}
+
+	err := extensions.PrintDiffResult(diffResult)
+	assert.EqualError(t, err, "canceled import process, you have conflict in extension. please fix it first")
+}
+
+func TestGetDiffChangeMessage(t *testing.T) {
+	items := []extensions.MigrateItem{
+		{
+			Type:    migrator.MigrateTypeCreate,
+			NewData: objects.Extension{Name: "vector"},
+		},
+		{
+			Type:    migrator.MigrateTypeUpdate,
+			NewData: objects.Extension{Name: "pg_trgm", Schema: "public"},
+			OldData: objects.Extension{Name: "pg_trgm", Schema: "extensions"},
+			MigrationItems: objects.UpdateExtensionParam{
+				ChangeItems: []objects.UpdateExtensionType{objects.UpdateExtensionSchema},
+			},
+		},
+		{
+			Type:    migrator.MigrateTypeUpdate,
+			NewData: objects.Extension{Name: "pgcrypto"},
+		},
+		{
+			Type:    migrator.MigrateTypeDelete,
+			OldData: objects.Extension{Name: "pgjwt"},
+		},
+	}
+
+	diffMessage := extensions.GetDiffChangeMessage(items)
+	assert.Contains(t, diffMessage, "New Extension")
+	assert.Contains(t, diffMessage, "- vector")
+	assert.Contains(t, diffMessage, "Update Extension")
+	assert.Contains(t, diffMessage, "- schema : extensions >>> public")
+	assert.NotContains(t, diffMessage, "pgcrypto")
+	assert.Contains(t, diffMessage, "Delete Extension")
+}
+
+func TestGenerateDiffMessage(t *testing.T) {
+	diffMessage, err := extensions.GenerateDiffMessage("vector", extensions.DiffTypeUpdate, objects.UpdateExtensionVersion, "0.5.1", "0.7.0")
+	assert.NoError(t, err)
+	assert.Contains(t, diffMessage, "Version()")
+
+	_, err = extensions.GenerateDiffMessage("vector", extensions.DiffTypeUpdate, objects.UpdateExtensionType("unknown"), "", "")
+	assert.Error(t, err)
+}
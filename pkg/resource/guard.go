@@ -0,0 +1,70 @@
+package resource
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/sev-2/raiden/pkg/resource/migrator"
+	"github.com/sev-2/raiden/pkg/state"
+)
+
+// CheckDestructiveChange refuse migrate data that contain destructive or lossy change, lossy
+// change is column data type change that can't be casted safely so the data can be lost.
+// the change is allowed when apply run with --allow-destructive or the model is
+// annotated with allowDestructive:"true" in metadata tag
+func CheckDestructiveChange(flags *Flags, data MigrateData, allowedResource map[string]bool) error {
+	plan := BuildApplyPlan(data, flags.DryRun)
+
+	var refused []string
+	for _, c := range plan.Changes {
+		if c.Risk != migrator.RiskLevelLossy && c.Risk != migrator.RiskLevelDestructive {
+			continue
+		}
+
+		if flags.AllowDestructive || allowedResource[getAllowDestructiveKey(c.Kind, c.Name)] {
+			ApplyLogger.Warn("destructive change is allowed", "kind", c.Kind, "name", c.Name, "action", c.Action, "risk", c.Risk)
+			continue
+		}
+
+		if c.Risk == migrator.RiskLevelLossy {
+			refused = append(refused, fmt.Sprintf("- %s %s %s (column data type can't be casted safely)", c.Action, c.Kind, c.Name))
+			continue
+		}
+		refused = append(refused, fmt.Sprintf("- %s %s %s", c.Action, c.Kind, c.Name))
+	}
+
+	if len(refused) == 0 {
+		return nil
+	}
+
+	message := fmt.Sprintf("found destructive change :\n%s", strings.Join(refused, "\n"))
+	if flags.DryRun {
+		ApplyLogger.Warn(message)
+		return nil
+	}
+
+	return errors.New(message + "\nrun with --allow-destructive or add allowDestructive:\"true\" to model metadata to continue")
+}
+
+// getAllowDestructiveResource return list of table that annotated with allowDestructive tag
+func getAllowDestructiveResource(app *appResource) map[string]bool {
+	allowedResource := make(map[string]bool)
+	if app == nil {
+		return allowedResource
+	}
+
+	for _, items := range []state.ExtractTableItems{app.Tables.New, app.Tables.Existing} {
+		for i := range items {
+			t := items[i]
+			if t.AllowDestructive {
+				allowedResource[getAllowDestructiveKey("table", fmt.Sprintf("%s.%s", t.Table.Schema, t.Table.Name))] = true
+			}
+		}
+	}
+	return allowedResource
+}
+
+func getAllowDestructiveKey(kind, name string) string {
+	return kind + ":" + name
+}
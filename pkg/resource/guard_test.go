@@ -0,0 +1,94 @@
+package resource_test
+
+import (
+	"testing"
+
+	"github.com/sev-2/raiden/pkg/resource"
+	"github.com/sev-2/raiden/pkg/resource/extensions"
+	"github.com/sev-2/raiden/pkg/resource/migrator"
+	"github.com/sev-2/raiden/pkg/resource/roles"
+	"github.com/sev-2/raiden/pkg/resource/tables"
+	"github.com/sev-2/raiden/pkg/supabase/objects"
+	"github.com/stretchr/testify/assert"
+)
+
+func newDestructiveTestData() resource.MigrateData {
+	return resource.MigrateData{
+		Roles: []roles.MigrateItem{
+			{Type: migrator.MigrateTypeDelete, OldData: objects.Role{Name: "editor"}},
+		},
+		Tables: []tables.MigrateItem{
+			{
+				Type:    migrator.MigrateTypeUpdate,
+				OldData: objects.Table{Schema: "public", Name: "posts", Columns: []objects.Column{{Name: "id", DataType: "bigint"}, {Name: "views", DataType: "integer"}}},
+				NewData: objects.Table{Schema: "public", Name: "posts", Columns: []objects.Column{{Name: "id", DataType: "bigint"}}},
+				MigrationItems: objects.UpdateTableParam{
+					ChangeColumnItems: []objects.UpdateColumnItem{
+						{Name: "views", UpdateItems: []objects.UpdateColumnType{objects.UpdateColumnDelete}},
+					},
+				},
+			},
+		},
+	}
+}
+
+func TestCheckDestructiveChange(t *testing.T) {
+	data := newDestructiveTestData()
+
+	err := resource.CheckDestructiveChange(&resource.Flags{}, data, nil)
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "update table public.posts")
+	assert.NotContains(t, err.Error(), "editor")
+
+	err = resource.CheckDestructiveChange(&resource.Flags{AllowDestructive: true}, data, nil)
+	assert.NoError(t, err)
+
+	err = resource.CheckDestructiveChange(&resource.Flags{}, data, map[string]bool{"table:public.posts": true})
+	assert.NoError(t, err)
+
+	err = resource.CheckDestructiveChange(&resource.Flags{DryRun: true}, data, nil)
+	assert.NoError(t, err)
+}
+
+func TestCheckDestructiveChange_Lossy(t *testing.T) {
+	oldTable := objects.Table{Schema: "public", Name: "posts", Columns: []objects.Column{{Name: "id", DataType: "bigint"}, {Name: "views", DataType: "text"}}}
+	newTable := objects.Table{Schema: "public", Name: "posts", Columns: []objects.Column{{Name: "id", DataType: "bigint"}, {Name: "views", DataType: "integer"}}}
+	data := resource.MigrateData{
+		Tables: []tables.MigrateItem{
+			{
+				Type:    migrator.MigrateTypeUpdate,
+				OldData: oldTable,
+				NewData: newTable,
+				MigrationItems: objects.UpdateTableParam{
+					ChangeColumnItems: []objects.UpdateColumnItem{
+						{Name: "views", UpdateItems: []objects.UpdateColumnType{objects.UpdateColumnDataType}},
+					},
+				},
+			},
+		},
+	}
+
+	err := resource.CheckDestructiveChange(&resource.Flags{}, data, nil)
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "update table public.posts (column data type can't be casted safely)")
+
+	err = resource.CheckDestructiveChange(&resource.Flags{AllowDestructive: true}, data, nil)
+	assert.NoError(t, err)
+}
+
+func TestCheckDestructiveChange_Extension(t *testing.T) {
+	data := resource.MigrateData{
+		Extensions: []extensions.MigrateItem{
+			{Type: migrator.MigrateTypeDelete, OldData: objects.Extension{Name: "postgis", Schema: "extensions"}},
+			{Type: migrator.MigrateTypeCreate, NewData: objects.Extension{Name: "pg_trgm", Schema: "extensions"}},
+		},
+	}
+
+	err := resource.CheckDestructiveChange(&resource.Flags{}, data, nil)
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "delete extension postgis")
+	assert.NotContains(t, err.Error(), "pg_trgm")
+
+	err = resource.CheckDestructiveChange(&resource.Flags{AllowDestructive: true}, data, nil)
+	assert.NoError(t, err)
+}
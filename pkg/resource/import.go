@@ -1,7 +1,9 @@
 package resource
 
 import (
+	"fmt"
 	"io"
+	"os"
 	"strings"
 	"sync"
 	"time"
@@ -10,11 +12,14 @@ import (
 	"github.com/sev-2/raiden"
 	"github.com/sev-2/raiden/pkg/generator"
 	"github.com/sev-2/raiden/pkg/logger"
+	"github.com/sev-2/raiden/pkg/resource/extensions"
 	"github.com/sev-2/raiden/pkg/resource/roles"
 	"github.com/sev-2/raiden/pkg/resource/rpc"
 	"github.com/sev-2/raiden/pkg/resource/storages"
 	"github.com/sev-2/raiden/pkg/resource/tables"
+	"github.com/sev-2/raiden/pkg/resource/triggers"
 	"github.com/sev-2/raiden/pkg/resource/types"
+	"github.com/sev-2/raiden/pkg/resource/views"
 	"github.com/sev-2/raiden/pkg/state"
 	"github.com/sev-2/raiden/pkg/supabase/objects"
 	"github.com/sev-2/raiden/pkg/utils"
@@ -27,8 +32,16 @@ var ImportLogger hclog.Logger = logger.HcLog().Named("import")
 // [x] import role
 // [x] import function
 // [x] import storage
+// [x] import trigger
+// [x] import extension
 func Import(flags *Flags, config *raiden.Config) error {
-	if flags.DryRun {
+	if err := ValidateOutput(flags.Output); err != nil {
+		return err
+	}
+
+	if flags.Check {
+		ImportLogger.Info("running import in check mode, compare database resource without import")
+	} else if flags.DryRun {
 		ImportLogger.Info("running import in dry run mode")
 	}
 
@@ -47,6 +60,7 @@ func Import(flags *Flags, config *raiden.Config) error {
 	}
 
 	spResource.Tables = tables.AttachIndexAndAction(spResource.Tables, spResource.Indexes, spResource.RelationActions)
+	spResource.Tables = tables.AttachPublication(spResource.Tables, spResource.Publications)
 
 	// create import state
 	ImportLogger.Debug("get native roles")
@@ -67,11 +81,20 @@ func Import(flags *Flags, config *raiden.Config) error {
 
 	ImportLogger.Trace("filter function by schema")
 	spResource.Functions = filterFunctionBySchema(spResource.Functions, strings.Split(flags.AllowedSchema, ",")...)
+
+	ImportLogger.Trace("filter trigger by table")
+	spResource.Triggers = filterTriggerByTables(spResource.Triggers, spResource.Tables, strings.Split(flags.AllowedSchema, ",")...)
 	ImportLogger.Debug("finish filter table and function by allowed schema")
 
 	ImportLogger.Trace("remove native role for supabase list role")
 	spResource.Roles = filterUserRole(spResource.Roles, mapNativeRole)
 
+	releaseState, err := useStateBackend(config, !flags.DryRun && !flags.Check, ImportLogger)
+	if err != nil {
+		return err
+	}
+	defer releaseState()
+
 	// load app resource
 	ImportLogger.Info("load resource from local state")
 	localState, err := state.Load()
@@ -80,14 +103,29 @@ func Import(flags *Flags, config *raiden.Config) error {
 	}
 
 	ImportLogger.Info("extract data from local state")
-	appTables, appRoles, appRpcFunctions, appStorage, appType, err := extractAppResource(flags, localState)
+	appTables, appRoles, appRpcFunctions, appStorage, appType, appTriggers, appViews, appExtensions, err := extractAppResource(flags, localState)
 	if err != nil {
 		return err
 	}
 
+	if flags.Check {
+		app := appResource{
+			Tables: appTables, Roles: appRoles, Rpc: appRpcFunctions, Storages: appStorage,
+			Types: appType, Triggers: appTriggers, Views: appViews, Extensions: appExtensions,
+		}
+		plan, err := buildDriftPlan(flags, config, spResource, localState, &app)
+		if err != nil {
+			return err
+		}
+		return checkDrift(flags, plan)
+	}
+
+	// seed is not imported from database, keep seed state
+	// so seeded row is not upserted again after import
 	importState := state.LocalState{
 		State: state.State{
 			Roles: nativeStateRoles,
+			Seeds: localState.Seeds,
 		},
 	}
 
@@ -96,6 +134,22 @@ func Import(flags *Flags, config *raiden.Config) error {
 	mapModelValidationTags := make(map[string]state.ModelValidationTag)
 
 	// compare resource
+	if (flags.All() || flags.ModelsOnly) && len(appExtensions.Existing) > 0 {
+		if !flags.DryRun {
+			ImportLogger.Debug("start compare extensions")
+		}
+		if err := extensions.Compare(spResource.Extensions, appExtensions.Existing); err != nil {
+			if flags.DryRun {
+				dryRunError = append(dryRunError, err.Error())
+			} else {
+				return err
+			}
+		}
+		if !flags.DryRun {
+			ImportLogger.Debug("finish compare extensions")
+		}
+	}
+
 	if (flags.All() || flags.ModelsOnly) && len(appType.Existing) > 0 {
 		if !flags.DryRun {
 			ImportLogger.Debug("start compare types")
@@ -148,6 +202,38 @@ func Import(flags *Flags, config *raiden.Config) error {
 		}
 	}
 
+	if (flags.All() || flags.ModelsOnly) && len(appTriggers.Existing) > 0 {
+		if !flags.DryRun {
+			ImportLogger.Debug("start compare trigger")
+		}
+		if err := triggers.Compare(spResource.Triggers, appTriggers.Existing); err != nil {
+			if flags.DryRun {
+				dryRunError = append(dryRunError, err.Error())
+			} else {
+				return err
+			}
+		}
+		if !flags.DryRun {
+			ImportLogger.Debug("finish compare trigger")
+		}
+	}
+
+	if (flags.All() || flags.ModelsOnly) && len(appViews.Existing) > 0 {
+		if !flags.DryRun {
+			ImportLogger.Debug("start compare view")
+		}
+		if err := views.Compare(spResource.Views, appViews.Existing); err != nil {
+			if flags.DryRun {
+				dryRunError = append(dryRunError, err.Error())
+			} else {
+				return err
+			}
+		}
+		if !flags.DryRun {
+			ImportLogger.Debug("finish compare view")
+		}
+	}
+
 	if (flags.All() || flags.RolesOnly) && len(appRoles.Existing) > 0 {
 		if !flags.DryRun {
 			ImportLogger.Debug("start compare role")
@@ -206,34 +292,66 @@ func Import(flags *Flags, config *raiden.Config) error {
 
 	// import report
 	importReport := ImportReport{
-		Role:    roles.GetNewCountData(spResource.Roles, appRoles),
-		Table:   tables.GetNewCountData(spResource.Tables, appTables),
-		Storage: storages.GetNewCountData(spResource.Storages, appStorage),
-		Rpc:     rpc.GetNewCountData(spResource.Functions, appRpcFunctions),
-		Types:   types.GetNewCountData(spResource.Types, appType),
+		Role:       roles.GetNewCountData(spResource.Roles, appRoles),
+		Table:      tables.GetNewCountData(spResource.Tables, appTables),
+		Storage:    storages.GetNewCountData(spResource.Storages, appStorage),
+		Rpc:        rpc.GetNewCountData(spResource.Functions, appRpcFunctions),
+		Types:      types.GetNewCountData(spResource.Types, appType),
+		Triggers:   triggers.GetNewCountData(spResource.Triggers, appTriggers),
+		Views:      views.GetNewCountData(spResource.Views, appViews),
+		Extensions: extensions.GetNewCountData(spResource.Extensions, appExtensions),
+	}
+
+	// json plan is built before import, so it show the compared resource
+	var plan Plan
+	if flags.Output == OutputJson {
+		app := appResource{
+			Tables: appTables, Roles: appRoles, Rpc: appRpcFunctions, Storages: appStorage,
+			Types: appType, Triggers: appTriggers, Views: appViews, Extensions: appExtensions,
+		}
+		if plan, err = buildImportPlan(flags, spResource, &app, importReport); err != nil {
+			return err
+		}
+		plan.Errors = dryRunError
 	}
 
 	if !flags.DryRun {
 		if flags.UpdateStateOnly {
-			return updateStateOnly(&importState, spResource, mapModelValidationTags)
+			err = updateStateOnly(&importState, spResource, mapModelValidationTags)
 		} else {
 			// generate resource
-			if err := generateImportResource(config, &importState, flags.ProjectPath, spResource, mapModelValidationTags); err != nil {
-				return err
-			}
-			PrintImportReport(importReport, false)
+			err = generateImportResource(config, &importState, flags.ProjectPath, spResource, mapModelValidationTags)
 		}
+		if err != nil {
+			return err
+		}
+	}
 
-	} else {
+	if flags.Output == OutputJson {
+		if err := PrintPlan(os.Stdout, plan); err != nil {
+			return err
+		}
 
 		if len(dryRunError) > 0 {
-			errMessage := strings.Join(dryRunError, "\n")
-			ImportLogger.Error("got error", "err-msg", errMessage)
-			return nil
+			return fmt.Errorf("found %d conflict between supabase resource and local resource", len(dryRunError))
+		}
+		return nil
+	}
+
+	if !flags.DryRun {
+		if !flags.UpdateStateOnly {
+			PrintImportReport(importReport, false)
 		}
-		PrintImportReport(importReport, true)
+		return nil
 	}
 
+	if len(dryRunError) > 0 {
+		errMessage := strings.Join(dryRunError, "\n")
+		ImportLogger.Error("got error", "err-msg", errMessage)
+		return nil
+	}
+	PrintImportReport(importReport, true)
+
 	return nil
 }
 
@@ -250,6 +368,23 @@ func generateImportResource(config *raiden.Config, importState *state.LocalState
 	go func() {
 		defer wg.Done()
 
+		if len(resource.Extensions) > 0 {
+			ImportLogger.Info("start generate extensions")
+			captureFunc := ImportDecorateFunc(resource.Extensions, func(item objects.Extension, input generator.GenerateInput) bool {
+				if i, ok := input.BindData.(generator.GenerateExtensionData); ok {
+					if i.Name == item.Name {
+						return true
+					}
+				}
+				return false
+			}, stateChan)
+
+			if err := generator.GenerateExtensions(projectPath, resource.Extensions, captureFunc); err != nil {
+				errChan <- err
+			}
+			ImportLogger.Info("finish generate extensions")
+		}
+
 		if len(resource.Types) > 0 {
 			ImportLogger.Info("start generate types")
 			captureFunc := ImportDecorateFunc(resource.Types, func(item objects.Type, input generator.GenerateInput) bool {
@@ -320,7 +455,7 @@ func generateImportResource(config *raiden.Config, importState *state.LocalState
 			ImportLogger.Info("start generate functions")
 			captureFunc := ImportDecorateFunc(resource.Functions, func(item objects.Function, input generator.GenerateInput) bool {
 				if i, ok := input.BindData.(generator.GenerateRpcData); ok {
-					if i.Name == utils.SnakeCaseToPascalCase(item.Name) {
+					if i.FunctionName == item.Name && i.Schema == item.Schema && i.IdentityArgumentTypes == item.IdentityArgumentTypes {
 						return true
 					}
 				}
@@ -349,6 +484,46 @@ func generateImportResource(config *raiden.Config, importState *state.LocalState
 			}
 			ImportLogger.Info("finish generate storages")
 		}
+
+		if len(resource.Triggers) > 0 {
+			ImportLogger.Info("start generate triggers")
+			captureFunc := ImportDecorateFunc(resource.Triggers, func(item objects.Trigger, input generator.GenerateInput) bool {
+				if i, ok := input.BindData.(generator.GenerateTriggerData); ok {
+					if i.StructName == generator.GetTriggerStructName(item) {
+						return true
+					}
+				}
+				return false
+			}, stateChan)
+
+			if err := generator.GenerateTriggers(projectPath, resource.Triggers, captureFunc); err != nil {
+				errChan <- err
+			}
+			ImportLogger.Info("finish generate triggers")
+		}
+
+		if len(resource.Views) > 0 {
+			ImportLogger.Info("start generate views")
+			captureFunc := ImportDecorateFunc(resource.Views, func(item objects.View, input generator.GenerateInput) bool {
+				if i, ok := input.BindData.(generator.GenerateViewData); ok {
+					if i.StructName == generator.GetViewStructName(item) {
+						return true
+					}
+				}
+				return false
+			}, stateChan)
+
+			var mapDataType = make(map[string]objects.Type)
+			for i := range resource.Types {
+				dataType := resource.Types[i]
+				mapDataType[dataType.Name] = dataType
+			}
+
+			if err := generator.GenerateViews(projectPath, config.ProjectName, resource.Views, mapDataType, captureFunc); err != nil {
+				errChan <- err
+			}
+			ImportLogger.Info("finish generate views")
+		}
 	}()
 
 	go func() {
@@ -397,11 +572,12 @@ func updateStateOnly(importState *state.LocalState, resource *Resource, mapModel
 	}
 
 	if len(resource.Functions) > 0 {
+		rpcNames := generator.GetRpcNames(resource.Functions)
 		for i := range resource.Functions {
 			f := resource.Functions[i]
 			importState.AddRpc(state.RpcState{
 				Function:   f,
-				RpcStruct:  utils.SnakeCaseToPascalCase(f.Name),
+				RpcStruct:  utils.SnakeCaseToPascalCase(rpcNames[i]),
 				LastUpdate: time.Now(),
 			})
 		}
@@ -419,6 +595,38 @@ func updateStateOnly(importState *state.LocalState, resource *Resource, mapModel
 			})
 		}
 	}
+	if len(resource.Triggers) > 0 {
+		for i := range resource.Triggers {
+			t := resource.Triggers[i]
+			importState.AddTrigger(state.TriggerState{
+				Trigger:       t,
+				TriggerStruct: generator.GetTriggerStructName(t),
+				LastUpdate:    time.Now(),
+			})
+		}
+	}
+
+	if len(resource.Views) > 0 {
+		for i := range resource.Views {
+			v := resource.Views[i]
+			importState.AddView(state.ViewState{
+				View:       v,
+				ViewStruct: generator.GetViewStructName(v),
+				LastUpdate: time.Now(),
+			})
+		}
+	}
+
+	if len(resource.Extensions) > 0 {
+		for i := range resource.Extensions {
+			e := resource.Extensions[i]
+			importState.AddExtension(state.ExtensionState{
+				Extension:       e,
+				ExtensionStruct: generator.GetExtensionStructName(e),
+				LastUpdate:      time.Now(),
+			})
+		}
+	}
 	return importState.Persist()
 }
 
@@ -488,10 +696,15 @@ func UpdateLocalStateFromImport(localState *state.LocalState, stateChan chan any
 					}
 					localState.AddRole(roleState)
 				case objects.Function:
+					rpcStruct := utils.SnakeCaseToPascalCase(parseItem.Name)
+					if data, ok := genInput.BindData.(generator.GenerateRpcData); ok {
+						rpcStruct = data.Name
+					}
+
 					rpcState := state.RpcState{
 						Function:   parseItem,
 						RpcPath:    genInput.OutputPath,
-						RpcStruct:  utils.SnakeCaseToPascalCase(parseItem.Name),
+						RpcStruct:  rpcStruct,
 						LastUpdate: time.Now(),
 					}
 					localState.AddRpc(rpcState)
@@ -512,6 +725,30 @@ func UpdateLocalStateFromImport(localState *state.LocalState, stateChan chan any
 						LastUpdate: time.Now(),
 					}
 					localState.AddType(typeState)
+				case objects.Trigger:
+					triggerState := state.TriggerState{
+						Trigger:       parseItem,
+						TriggerPath:   genInput.OutputPath,
+						TriggerStruct: generator.GetTriggerStructName(parseItem),
+						LastUpdate:    time.Now(),
+					}
+					localState.AddTrigger(triggerState)
+				case objects.View:
+					viewState := state.ViewState{
+						View:       parseItem,
+						ViewPath:   genInput.OutputPath,
+						ViewStruct: generator.GetViewStructName(parseItem),
+						LastUpdate: time.Now(),
+					}
+					localState.AddView(viewState)
+				case objects.Extension:
+					extensionState := state.ExtensionState{
+						Extension:       parseItem,
+						ExtensionPath:   genInput.OutputPath,
+						ExtensionStruct: generator.GetExtensionStructName(parseItem),
+						LastUpdate:      time.Now(),
+					}
+					localState.AddExtension(extensionState)
 				}
 			}
 		}
@@ -522,28 +759,31 @@ func UpdateLocalStateFromImport(localState *state.LocalState, stateChan chan any
 
 // ----- Print import report -----
 type ImportReport struct {
-	Table   int
-	Role    int
-	Rpc     int
-	Storage int
-	Types   int
+	Table      int
+	Role       int
+	Rpc        int
+	Storage    int
+	Types      int
+	Triggers   int
+	Views      int
+	Extensions int
 }
 
 func PrintImportReport(report ImportReport, dryRun bool) {
 	var message string
 	if !dryRun {
 		message = "import process is complete, your code is up to date"
-		if report.Role > 0 || report.Rpc > 0 || report.Storage > 0 || report.Table > 0 {
+		if report.Role > 0 || report.Rpc > 0 || report.Storage > 0 || report.Table > 0 || report.Triggers > 0 || report.Views > 0 || report.Extensions > 0 {
 			message = "import process is complete, adding several new resources to the codebase"
-			ImportLogger.Info(message, "Table", report.Table, "Role", report.Role, "Rpc", report.Rpc, "Storage", report.Storage)
+			ImportLogger.Info(message, "Table", report.Table, "Role", report.Role, "Rpc", report.Rpc, "Storage", report.Storage, "Trigger", report.Triggers, "View", report.Views, "Extension", report.Extensions)
 			return
 		}
 		ImportLogger.Info(message)
 	} else {
 		message = "finish running import in dry run mode, your code is up to date"
-		if report.Role > 0 || report.Rpc > 0 || report.Storage > 0 || report.Table > 0 {
+		if report.Role > 0 || report.Rpc > 0 || report.Storage > 0 || report.Table > 0 || report.Triggers > 0 || report.Views > 0 || report.Extensions > 0 {
 			message = "finish running import in dry run mode and add several resource"
-			ImportLogger.Info(message, "Table", report.Table, "Role", report.Role, "Rpc", report.Rpc, "Storage", report.Storage)
+			ImportLogger.Info(message, "Table", report.Table, "Role", report.Role, "Rpc", report.Rpc, "Storage", report.Storage, "Trigger", report.Triggers, "View", report.Views, "Extension", report.Extensions)
 			return
 		}
 		ImportLogger.Info(message)
@@ -1,7 +1,10 @@
 package resource_test
 
 import (
+	"encoding/json"
+	"io"
 	"os"
+	"strings"
 	"testing"
 	"time"
 
@@ -820,3 +823,59 @@ func TestImportSvc(t *testing.T) {
 	errReset := state.Save(&state.State{})
 	assert.NoError(t, errReset)
 }
+
+func TestImport_JsonDryRunConflict(t *testing.T) {
+	flags := &resource.Flags{
+		ProjectPath: t.TempDir(),
+		ModelsOnly:  true,
+		DryRun:      true,
+		Output:      resource.OutputJson,
+	}
+	config := loadConfig()
+
+	mock := &mock.MockSupabase{Cfg: config}
+	mock.Activate()
+	defer mock.Deactivate()
+
+	testState := state.State{
+		Tables: []state.TableState{
+			{Table: objects.Table{ID: 1, Name: "other_table", Schema: "public", Columns: []objects.Column{{Name: "id", DataType: "bigint", IsIdentity: true}}}},
+		},
+	}
+	assert.NoError(t, state.Save(&testState))
+	defer func() {
+		assert.NoError(t, state.Save(&state.State{}))
+	}()
+
+	resource.RegisterModels(MockOtherTable{})
+
+	// column type is changed in database, so import conflict with local model
+	err := mock.MockGetTablesWithExpectedResponse(200, []objects.Table{
+		{ID: 1, Name: "other_table", Schema: "public", Columns: []objects.Column{{Name: "id", DataType: "integer", IsIdentity: true}}},
+	})
+	assert.NoError(t, err)
+
+	err = mock.MockGetTypesWithExpectedResponse(200, []objects.Type{})
+	assert.NoError(t, err)
+
+	r, w, err := os.Pipe()
+	assert.NoError(t, err)
+	stdout := os.Stdout
+	os.Stdout = w
+
+	err = resource.Import(flags, config)
+	os.Stdout = stdout
+	assert.NoError(t, w.Close())
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "found 1 conflict")
+
+	output, readErr := io.ReadAll(r)
+	assert.NoError(t, readErr)
+
+	// compare diff is printed before the plan, plan is the last json object
+	rawPlan := output[strings.LastIndex(string(output), "{\n  \"command\""):]
+	var plan resource.Plan
+	assert.NoError(t, json.Unmarshal(rawPlan, &plan))
+	assert.True(t, plan.DryRun)
+	assert.Len(t, plan.Errors, 1)
+}
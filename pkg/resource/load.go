@@ -22,8 +22,12 @@ type Resource struct {
 	Functions       []objects.Function
 	Storages        []objects.Bucket
 	Indexes         []objects.Index
+	Publications    []objects.Publication
 	RelationActions []objects.TablesRelationshipAction
 	Types           []objects.Type
+	Triggers        []objects.Trigger
+	Views           []objects.View
+	Extensions      []objects.Extension
 }
 
 // The Load function loads resources based on the provided flags and project ID, and returns a resource
@@ -54,12 +58,24 @@ func Load(flags *Flags, cfg *raiden.Config) (*Resource, error) {
 		case []objects.Index:
 			resource.Indexes = rs
 			LoadLogger.Debug("finish get Indexes from server")
+		case []objects.Publication:
+			resource.Publications = rs
+			LoadLogger.Debug("finish get Publication from server")
 		case []objects.TablesRelationshipAction:
 			resource.RelationActions = rs
 			LoadLogger.Debug("finish get Relation Action from server")
 		case []objects.Type:
 			resource.Types = rs
 			LoadLogger.Debug("finish get Type from server")
+		case []objects.Trigger:
+			resource.Triggers = rs
+			LoadLogger.Debug("finish get Trigger from server")
+		case []objects.View:
+			resource.Views = rs
+			LoadLogger.Debug("finish get View from server")
+		case []objects.Extension:
+			resource.Extensions = rs
+			LoadLogger.Debug("finish get Extension from server")
 		case error:
 			return nil, rs
 		}
@@ -125,11 +141,35 @@ func loadResource(cfg *raiden.Config, flags *Flags) <-chan any {
 				return supabase.GetIndexes(cfg, supabase.DefaultIncludedSchema[0])
 			})
 
+			wg.Add(1)
+			LoadLogger.Debug("get Publication from server")
+			go loadDatabaseResource(&wg, cfg, outChan, func(cfg *raiden.Config) ([]objects.Publication, error) {
+				return supabase.GetPublications(cfg)
+			})
+
 			wg.Add(1)
 			LoadLogger.Debug("get Table Relation Actions from server")
 			go loadDatabaseResource(&wg, cfg, outChan, func(cfg *raiden.Config) ([]objects.TablesRelationshipAction, error) {
 				return supabase.GetTableRelationshipActions(cfg, supabase.DefaultIncludedSchema[0])
 			})
+
+			wg.Add(1)
+			LoadLogger.Debug("get Trigger from server")
+			go loadDatabaseResource(&wg, cfg, outChan, func(cfg *raiden.Config) ([]objects.Trigger, error) {
+				return supabase.GetTriggers(cfg, getAllowedSchema(flags.AllowedSchema))
+			})
+
+			wg.Add(1)
+			LoadLogger.Debug("get View from server")
+			go loadDatabaseResource(&wg, cfg, outChan, func(cfg *raiden.Config) ([]objects.View, error) {
+				return supabase.GetViews(cfg, getAllowedSchema(flags.AllowedSchema))
+			})
+
+			wg.Add(1)
+			LoadLogger.Debug("get Extension from server")
+			go loadDatabaseResource(&wg, cfg, outChan, func(cfg *raiden.Config) ([]objects.Extension, error) {
+				return supabase.GetExtensions(cfg)
+			})
 		}
 
 		if flags.All() || flags.RolesOnly {
@@ -158,7 +198,7 @@ func loadResource(cfg *raiden.Config, flags *Flags) <-chan any {
 			wg.Add(1)
 			LoadLogger.Debug("get Function from server")
 			go loadDatabaseResource(&wg, cfg, outChan, func(cfg *raiden.Config) ([]objects.Function, error) {
-				return supabase.GetFunctions(cfg)
+				return supabase.GetFunctions(cfg, getAllowedSchema(flags.AllowedSchema))
 			})
 		}
 
@@ -189,16 +229,40 @@ func loadResource(cfg *raiden.Config, flags *Flags) <-chan any {
 			return pgmeta.GetIndexes(cfg, "public")
 		})
 
+		wg.Add(1)
+		LoadLogger.Debug("Get Publication From Pg Meta")
+		go loadDatabaseResource(&wg, cfg, outChan, func(cfg *raiden.Config) ([]objects.Publication, error) {
+			return pgmeta.GetPublications(cfg)
+		})
+
 		wg.Add(1)
 		LoadLogger.Debug("Get Table Relation Actions From Pg Meta")
 		go loadDatabaseResource(&wg, cfg, outChan, func(cfg *raiden.Config) ([]objects.TablesRelationshipAction, error) {
 			return pgmeta.GetTableRelationshipActions(cfg, "public")
 		})
 
+		wg.Add(1)
+		LoadLogger.Debug("Get Trigger From Pg Meta")
+		go loadDatabaseResource(&wg, cfg, outChan, func(cfg *raiden.Config) ([]objects.Trigger, error) {
+			return pgmeta.GetTriggers(cfg, getAllowedSchema(flags.AllowedSchema))
+		})
+
+		wg.Add(1)
+		LoadLogger.Debug("Get View From Pg Meta")
+		go loadDatabaseResource(&wg, cfg, outChan, func(cfg *raiden.Config) ([]objects.View, error) {
+			return pgmeta.GetViews(cfg, getAllowedSchema(flags.AllowedSchema))
+		})
+
+		wg.Add(1)
+		LoadLogger.Debug("Get Extension From Pg Meta")
+		go loadDatabaseResource(&wg, cfg, outChan, func(cfg *raiden.Config) ([]objects.Extension, error) {
+			return pgmeta.GetExtensions(cfg)
+		})
+
 		wg.Add(1)
 		LoadLogger.Debug("Get Function From Pg Meta")
 		go loadDatabaseResource(&wg, cfg, outChan, func(cfg *raiden.Config) ([]objects.Function, error) {
-			return pgmeta.GetFunctions(cfg)
+			return pgmeta.GetFunctions(cfg, getAllowedSchema(flags.AllowedSchema))
 		})
 	}
 
@@ -0,0 +1,337 @@
+package resource
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/sev-2/raiden/pkg/resource/extensions"
+	"github.com/sev-2/raiden/pkg/resource/migrator"
+	"github.com/sev-2/raiden/pkg/resource/policies"
+	"github.com/sev-2/raiden/pkg/resource/roles"
+	"github.com/sev-2/raiden/pkg/resource/rpc"
+	"github.com/sev-2/raiden/pkg/resource/storages"
+	"github.com/sev-2/raiden/pkg/resource/tables"
+	"github.com/sev-2/raiden/pkg/resource/triggers"
+	"github.com/sev-2/raiden/pkg/resource/types"
+	"github.com/sev-2/raiden/pkg/resource/views"
+	"github.com/sev-2/raiden/pkg/utils"
+)
+
+const MigrationFileTimeFormat = "20060102150405"
+
+const migrationTransactionStepTemplate = `-- %s %s %s
+DO $raiden$
+BEGIN
+  EXECUTE $raiden_step$
+%s
+$raiden_step$;
+EXCEPTION WHEN OTHERS THEN
+  RAISE EXCEPTION 'raiden migration step %d failed : %%', SQLERRM USING ERRCODE = SQLSTATE;
+END
+$raiden$;`
+
+var (
+	migrationStepErrorRegex   = regexp.MustCompile(`raiden migration step (\d+) failed`)
+	transactionStatementRegex = regexp.MustCompile(`^(?i)(BEGIN|COMMIT)\s*;`)
+	dollarQuoteTagRegex       = regexp.MustCompile(`^\$[A-Za-z_]*\$`)
+)
+
+type MigrationStep struct {
+	Type     migrator.MigrateType
+	Resource string
+	Name     string
+	Up       string
+	Down     string
+}
+
+type MigrationScript struct {
+	Steps []MigrationStep
+}
+
+// BuildMigrationScript convert migrate data to ordered sql statement,
+// the order follow Migrate function so the script can be replayed
+// with psql or supabase db push and produce the same result
+func BuildMigrationScript(data *MigrateData) (script MigrationScript, err error) {
+	appendStep := func(migrateType migrator.MigrateType, resource, name, up, down string) {
+		if migrateType == migrator.MigrateTypeIgnore || strings.TrimSpace(up) == "" {
+			return
+		}
+		script.Steps = append(script.Steps, MigrationStep{Type: migrateType, Resource: resource, Name: name, Up: up, Down: down})
+	}
+
+	// role must be created first because will be use when create rls
+	for _, item := range data.Roles {
+		up, down, e := roles.BuildMigrateQuery(item)
+		if e != nil {
+			return script, e
+		}
+		appendStep(item.Type, "role", getMigrateItemName(item.Type, item.NewData.Name, item.OldData.Name), up, down)
+	}
+
+	// extension and type must be exist before table
+	for _, item := range data.Extensions {
+		up, down, e := extensions.BuildMigrateQuery(item)
+		if e != nil {
+			return script, e
+		}
+		appendStep(item.Type, "extension", getMigrateItemName(item.Type, item.NewData.Name, item.OldData.Name), up, down)
+	}
+
+	var deleteTypes []types.MigrateItem
+	for _, item := range data.Types {
+		if item.Type == migrator.MigrateTypeDelete {
+			deleteTypes = append(deleteTypes, item)
+			continue
+		}
+
+		up, down, e := types.BuildMigrateQuery(item)
+		if e != nil {
+			return script, e
+		}
+		appendStep(item.Type, "type", fmt.Sprintf("%s.%s", item.NewData.Schema, item.NewData.Name), up, down)
+	}
+
+	for _, item := range data.Tables {
+		up, down, e := tables.BuildMigrateQuery(item)
+		if e != nil {
+			return script, e
+		}
+		appendStep(item.Type, "table", getMigrateItemName(item.Type, getTableName(item.NewData.Schema, item.NewData.Name), getTableName(item.OldData.Schema, item.OldData.Name)), up, down)
+	}
+
+	// foreign key is created after all table is created
+	for _, item := range data.Tables {
+		up, down, e := tables.BuildRelationMigrateQuery(item)
+		if e != nil {
+			return script, e
+		}
+		appendStep(migrator.MigrateTypeUpdate, "relation", getTableName(item.NewData.Schema, item.NewData.Name), up, down)
+	}
+
+	for _, item := range data.Rpc {
+		up, down, e := rpc.BuildMigrateQuery(item)
+		if e != nil {
+			return script, e
+		}
+		appendStep(item.Type, "rpc", getMigrateItemName(item.Type, item.NewData.Name, item.OldData.Name), up, down)
+	}
+
+	// view is ordered by dependency, view that depend on other view is deleted first
+	var deleteViews, upsertViews []views.MigrateItem
+	for _, item := range data.Views {
+		switch item.Type {
+		case migrator.MigrateTypeDelete:
+			deleteViews = append(deleteViews, item)
+		case migrator.MigrateTypeCreate, migrator.MigrateTypeUpdate:
+			upsertViews = append(upsertViews, item)
+		}
+	}
+
+	sortedDeleteViews := views.SortByDependency(deleteViews)
+	for i := len(sortedDeleteViews) - 1; i >= 0; i-- {
+		item := sortedDeleteViews[i]
+		up, down, e := views.BuildMigrateQuery(item)
+		if e != nil {
+			return script, e
+		}
+		appendStep(item.Type, "view", item.OldData.Name, up, down)
+	}
+
+	for _, item := range views.SortByDependency(upsertViews) {
+		up, down, e := views.BuildMigrateQuery(item)
+		if e != nil {
+			return script, e
+		}
+		appendStep(item.Type, "view", item.NewData.Name, up, down)
+	}
+
+	for _, item := range data.Triggers {
+		up, down, e := triggers.BuildMigrateQuery(item)
+		if e != nil {
+			return script, e
+		}
+		appendStep(item.Type, "trigger", getMigrateItemName(item.Type, item.NewData.Name, item.OldData.Name), up, down)
+	}
+
+	for _, item := range data.Policies {
+		up, down, e := policies.BuildMigrateQuery(item)
+		if e != nil {
+			return script, e
+		}
+		appendStep(item.Type, "policy", getMigrateItemName(item.Type, item.NewData.Name, item.OldData.Name), up, down)
+	}
+
+	for _, item := range data.Storages {
+		up, down, e := storages.BuildMigrateQuery(item)
+		if e != nil {
+			return script, e
+		}
+		appendStep(item.Type, "storage", getMigrateItemName(item.Type, item.NewData.Name, item.OldData.Name), up, down)
+	}
+
+	// type is deleted after table because column can still use the type
+	for _, item := range deleteTypes {
+		up, down, e := types.BuildMigrateQuery(item)
+		if e != nil {
+			return script, e
+		}
+		appendStep(item.Type, "type", fmt.Sprintf("%s.%s", item.OldData.Schema, item.OldData.Name), up, down)
+	}
+
+	return
+}
+
+func (s MigrationScript) IsEmpty() bool {
+	return len(s.Steps) == 0
+}
+
+// Up return sql that apply all migration step
+func (s MigrationScript) Up() string {
+	var sqlArr []string
+	for _, step := range s.Steps {
+		sqlArr = append(sqlArr, fmt.Sprintf("-- %s %s %s\n%s", step.Type, step.Resource, step.Name, strings.TrimSpace(step.Up)))
+	}
+	return strings.Join(sqlArr, "\n\n")
+}
+
+// Down return sql that revert all migration step in reverse order,
+// deleted table is created again but the data can't be restored
+func (s MigrationScript) Down() string {
+	var sqlArr []string
+	for i := len(s.Steps) - 1; i >= 0; i-- {
+		step := s.Steps[i]
+		if strings.TrimSpace(step.Down) == "" {
+			continue
+		}
+		sqlArr = append(sqlArr, fmt.Sprintf("-- revert %s %s %s\n%s", step.Type, step.Resource, step.Name, strings.TrimSpace(step.Down)))
+	}
+	return strings.Join(sqlArr, "\n\n")
+}
+
+// Transaction return sql that run all migration step in single transaction,
+// every step is wrapped in DO block so the failed step can be found from error
+// message and any error will rollback all step
+func (s MigrationScript) Transaction() string {
+	sqlArr := []string{"BEGIN;"}
+	for i, step := range s.Steps {
+		stepSql := strings.TrimSpace(removeTransactionStatement(step.Up))
+		sqlArr = append(sqlArr, fmt.Sprintf(migrationTransactionStepTemplate, step.Type, step.Resource, step.Name, stepSql, i+1))
+	}
+	sqlArr = append(sqlArr, "COMMIT;")
+	return strings.Join(sqlArr, "\n\n")
+}
+
+// FindFailedStep return step that raise error when running transaction script
+func (s MigrationScript) FindFailedStep(err error) (index int, step MigrationStep, found bool) {
+	if err == nil {
+		return
+	}
+
+	match := migrationStepErrorRegex.FindStringSubmatch(err.Error())
+	if len(match) < 2 {
+		return
+	}
+
+	number, convErr := strconv.Atoi(match[1])
+	if convErr != nil || number < 1 || number > len(s.Steps) {
+		return
+	}
+	return number - 1, s.Steps[number-1], true
+}
+
+// removeTransactionStatement remove top level BEGIN; and COMMIT; statement so query can be
+// run inside another transaction, string literal and dollar quoted body is not changed
+func removeTransactionStatement(q string) string {
+	var sb strings.Builder
+	for i := 0; i < len(q); {
+		switch c := q[i]; {
+		case c == '\'' || c == '"':
+			end := strings.IndexByte(q[i+1:], c)
+			if end < 0 {
+				sb.WriteString(q[i:])
+				return sb.String()
+			}
+			sb.WriteString(q[i : i+end+2])
+			i += end + 2
+		case c == '$' && dollarQuoteTagRegex.MatchString(q[i:]):
+			tag := dollarQuoteTagRegex.FindString(q[i:])
+			end := strings.Index(q[i+len(tag):], tag)
+			if end < 0 {
+				sb.WriteString(q[i:])
+				return sb.String()
+			}
+			n := len(tag) + end + len(tag)
+			sb.WriteString(q[i : i+n])
+			i += n
+		default:
+			if i == 0 || !isIdentifierChar(q[i-1]) {
+				if m := transactionStatementRegex.FindString(q[i:]); m != "" {
+					i += len(m)
+					continue
+				}
+			}
+			sb.WriteByte(c)
+			i++
+		}
+	}
+	return sb.String()
+}
+
+func isIdentifierChar(c byte) bool {
+	return c == '_' || c >= '0' && c <= '9' || c >= 'a' && c <= 'z' || c >= 'A' && c <= 'Z'
+}
+
+// EmitMigrations write migrate data as timestamped up and down sql file in dir,
+// nothing is written when there is no change
+func EmitMigrations(dir string, data *MigrateData, now time.Time) (upPath string, downPath string, err error) {
+	script, err := BuildMigrationScript(data)
+	if err != nil {
+		return
+	}
+
+	if script.IsEmpty() {
+		ApplyLogger.Info("no change found, skip emit migration file")
+		return
+	}
+
+	if !utils.IsFolderExists(dir) {
+		if err = os.MkdirAll(dir, os.ModePerm); err != nil {
+			return
+		}
+	}
+
+	version := now.UTC().Format(MigrationFileTimeFormat)
+	upPath = filepath.Join(dir, fmt.Sprintf("%s_apply.up.sql", version))
+	downPath = filepath.Join(dir, fmt.Sprintf("%s_apply.down.sql", version))
+
+	header := fmt.Sprintf("-- generated by raiden apply at %s\n\n", now.UTC().Format(time.RFC3339))
+	if err = os.WriteFile(upPath, []byte(header+script.Up()+"\n"), 0644); err != nil {
+		return
+	}
+
+	if err = os.WriteFile(downPath, []byte(header+script.Down()+"\n"), 0644); err != nil {
+		return
+	}
+
+	ApplyLogger.Info("migration file emitted", "up", upPath, "down", downPath)
+	return
+}
+
+func getMigrateItemName(migrateType migrator.MigrateType, newName, oldName string) string {
+	if migrateType == migrator.MigrateTypeDelete {
+		return oldName
+	}
+	return newName
+}
+
+func getTableName(schema, name string) string {
+	if schema == "" {
+		schema = "public"
+	}
+	return fmt.Sprintf("%s.%s", schema, name)
+}
@@ -0,0 +1,211 @@
+package resource
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"os/user"
+	"strings"
+	"time"
+
+	"github.com/sev-2/raiden"
+	"github.com/sev-2/raiden/pkg/connector/pgmeta"
+	"github.com/sev-2/raiden/pkg/state"
+	"github.com/sev-2/raiden/pkg/supabase"
+	"github.com/sev-2/raiden/pkg/supabase/objects"
+)
+
+// ListMigrations return migration history from raiden.migrations table, latest first
+func ListMigrations(config *raiden.Config) ([]objects.Migration, error) {
+	if config.Mode == raiden.SvcMode {
+		return pgmeta.GetMigrations(config)
+	}
+	return supabase.GetMigrations(config)
+}
+
+func getMigration(config *raiden.Config, id int) (objects.Migration, error) {
+	if config.Mode == raiden.SvcMode {
+		return pgmeta.GetMigration(config, id)
+	}
+	return supabase.GetMigration(config, id)
+}
+
+func createMigration(config *raiden.Config, m objects.Migration) (objects.Migration, error) {
+	if config.Mode == raiden.SvcMode {
+		return pgmeta.CreateMigration(config, m)
+	}
+	return supabase.CreateMigration(config, m)
+}
+
+// Rollback revert change of migration with the given id, state snapshot
+// stored in the migration is used as desired resource and compared
+// with supabase resource to compute the inverse change set
+func Rollback(flags *Flags, config *raiden.Config, id int) error {
+	var localState state.LocalState
+
+	if flags.DryRun {
+		ApplyLogger.Info("running rollback in dry run mode")
+	}
+
+	ApplyLogger.Info("load migration history", "id", id)
+	migration, err := getMigration(config, id)
+	if err != nil {
+		return err
+	}
+
+	if len(migration.Snapshot) == 0 || string(migration.Snapshot) == "null" {
+		return fmt.Errorf("migration %d does not have state snapshot", id)
+	}
+
+	snapshotState, err := state.UnmarshalSnapshot(migration.Snapshot)
+	if err != nil {
+		return err
+	}
+
+	ApplyLogger.Info("load Native log")
+	mapNativeRole, err := loadMapNativeRole()
+	if err != nil {
+		return err
+	}
+
+	releaseState, err := useStateBackend(config, !flags.DryRun, ApplyLogger)
+	if err != nil {
+		return err
+	}
+	defer releaseState()
+
+	ApplyLogger.Info("load resource from local state")
+	latestLocalState, err := state.Load()
+	if err != nil {
+		return err
+	}
+	localState.State = *latestLocalState
+
+	ApplyLogger.Info("extract resource from migration snapshot")
+	var app appResource
+	app.Tables, app.Roles, app.Rpc, app.Storages, app.Types, app.Triggers, app.Views, app.Extensions = state.ExtractSnapshot(latestLocalState, snapshotState)
+	app.Policies = mergeAllPolicy(app.Tables, app.Storages)
+
+	ApplyLogger.Info("load resource from supabase")
+	resource, err := Load(flags, config)
+	if err != nil {
+		return err
+	}
+
+	migrateData, err := buildMigrateData(flags, config, resource, mapNativeRole, &app)
+	if err != nil {
+		return err
+	}
+
+	// snapshot does not contain model annotation, destructive change is only allowed by flag
+	if err := CheckDestructiveChange(flags, migrateData, nil); err != nil {
+		return err
+	}
+	ApplyLogger.Info("finish build rollback data")
+
+	if !flags.DryRun {
+		snapshot, err := state.MarshalSnapshot(latestLocalState)
+		if err != nil {
+			return err
+		}
+
+		startTime := time.Now()
+		migrateErr := runMigrate(flags, config, &localState, &migrateData)
+		recordMigration(config, objects.MigrationActionRollback, &id, snapshot, &localState, &migrateData, startTime, migrateErr)
+		if len(migrateErr) > 0 {
+			return joinMigrateErrors(migrateErr)
+		}
+		ApplyLogger.Info("finish rollback resource", "id", id)
+	}
+	PrintApplyChangeReport(migrateData)
+	return nil
+}
+
+// recordMigration store apply run to migration history, failed to store
+// history is only logged because the change is already applied
+func recordMigration(config *raiden.Config, action objects.MigrationAction, rollbackOf *int, snapshot []byte, localState *state.LocalState, data *MigrateData, startTime time.Time, migrateErr []error) {
+	m := objects.Migration{
+		Action:     action,
+		Snapshot:   snapshot,
+		DurationMs: time.Since(startTime).Milliseconds(),
+		AppliedBy:  getMigrationUser(),
+		RollbackOf: rollbackOf,
+	}
+
+	for _, e := range migrateErr {
+		m.Errors = append(m.Errors, e.Error())
+	}
+
+	localState.Mutex.RLock()
+	checksum, err := state.Checksum(&localState.State)
+	localState.Mutex.RUnlock()
+	if err != nil {
+		ApplyLogger.Error("failed calculate state checksum", "message", err.Error())
+		return
+	}
+	m.Checksum = checksum
+
+	items, err := buildMigrationItems(data)
+	if err != nil {
+		ApplyLogger.Error("failed build migration history item", "message", err.Error())
+		return
+	}
+	m.Items = items
+
+	rs, err := createMigration(config, m)
+	if err != nil {
+		ApplyLogger.Error("failed save migration history", "message", err.Error())
+		return
+	}
+	ApplyLogger.Info("migration history saved", "id", rs.ID, "action", rs.Action)
+}
+
+func buildMigrationItems(data *MigrateData) (items []objects.MigrationItem, err error) {
+	script, err := BuildMigrationScript(data)
+	if err != nil {
+		return
+	}
+
+	for _, s := range script.Steps {
+		items = append(items, objects.MigrationItem{Type: string(s.Type), Resource: s.Resource, Name: s.Name})
+	}
+	return
+}
+
+func getMigrationUser() string {
+	if u, err := user.Current(); err == nil && u.Username != "" {
+		return u.Username
+	}
+	return os.Getenv("USER")
+}
+
+func joinMigrateErrors(migrateErr []error) error {
+	var errMessages []string
+	for _, e := range migrateErr {
+		errMessages = append(errMessages, e.Error())
+	}
+	return errors.New(strings.Join(errMessages, ","))
+}
+
+func PrintMigrations(migrations []objects.Migration) {
+	if len(migrations) == 0 {
+		ApplyLogger.Info("migration history is empty")
+		return
+	}
+
+	var sb strings.Builder
+	sb.WriteString(fmt.Sprintf("%-6s %-9s %-20s %-12s %-8s %-7s %s\n", "ID", "ACTION", "APPLIED AT", "BY", "CHANGES", "ERRORS", "DURATION"))
+	for _, m := range migrations {
+		action := string(m.Action)
+		if m.RollbackOf != nil {
+			action = fmt.Sprintf("%s(%d)", action, *m.RollbackOf)
+		}
+
+		sb.WriteString(fmt.Sprintf(
+			"%-6d %-9s %-20s %-12s %-8d %-7d %s\n",
+			m.ID, action, m.AppliedAt.Local().Format("2006-01-02 15:04:05"), m.AppliedBy,
+			len(m.Items), len(m.Errors), time.Duration(m.DurationMs)*time.Millisecond,
+		))
+	}
+	fmt.Print(sb.String())
+}
@@ -0,0 +1,45 @@
+package resource_test
+
+import (
+	"testing"
+
+	"github.com/sev-2/raiden"
+	"github.com/sev-2/raiden/pkg/mock"
+	"github.com/sev-2/raiden/pkg/resource"
+	"github.com/sev-2/raiden/pkg/supabase/objects"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestListMigrations(t *testing.T) {
+	cfg := &raiden.Config{
+		DeploymentTarget:    raiden.DeploymentTargetCloud,
+		ProjectId:           "test-project-id",
+		SupabaseApiBasePath: "/v1",
+		SupabaseApiUrl:      "http://supabase.cloud.com",
+		SupabasePublicUrl:   "http://supabase.cloud.com",
+		Mode:                raiden.BffMode,
+	}
+
+	_, err := resource.ListMigrations(cfg)
+	assert.Error(t, err)
+
+	mock := &mock.MockSupabase{Cfg: cfg}
+	mock.Activate()
+	defer mock.Deactivate()
+
+	rollbackOf := 1
+	migrations := []objects.Migration{
+		{ID: 2, Action: objects.MigrationActionRollback, RollbackOf: &rollbackOf},
+		{ID: 1, Action: objects.MigrationActionApply, Items: []objects.MigrationItem{{Type: "create", Resource: "table", Name: "public.posts"}}},
+	}
+	err = mock.MockGetMigrationsWithExpectedResponse(200, migrations)
+	assert.NoError(t, err)
+
+	rs, err := resource.ListMigrations(cfg)
+	assert.NoError(t, err)
+	assert.Equal(t, 2, len(rs))
+	assert.Equal(t, 1, *rs[0].RollbackOf)
+	assert.Equal(t, "public.posts", rs[1].Items[0].Name)
+
+	resource.PrintMigrations(rs)
+}
@@ -0,0 +1,151 @@
+package resource_test
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/sev-2/raiden"
+	"github.com/sev-2/raiden/pkg/mock"
+	"github.com/sev-2/raiden/pkg/resource"
+	"github.com/sev-2/raiden/pkg/resource/migrator"
+	"github.com/sev-2/raiden/pkg/resource/roles"
+	"github.com/sev-2/raiden/pkg/resource/tables"
+	"github.com/sev-2/raiden/pkg/resource/types"
+	"github.com/sev-2/raiden/pkg/state"
+	"github.com/sev-2/raiden/pkg/supabase/objects"
+	"github.com/stretchr/testify/assert"
+)
+
+func newMigrationTestData() *resource.MigrateData {
+	return &resource.MigrateData{
+		Roles: []roles.MigrateItem{
+			{Type: migrator.MigrateTypeCreate, NewData: objects.Role{Name: "editor", ConnectionLimit: 10}},
+		},
+		Types: []types.MigrateItem{
+			{Type: migrator.MigrateTypeDelete, OldData: objects.Type{Schema: "public", Name: "old_status", Enums: []string{"a"}}},
+			{Type: migrator.MigrateTypeCreate, NewData: objects.Type{Schema: "public", Name: "status", Enums: []string{"active"}}},
+		},
+		Tables: []tables.MigrateItem{
+			{
+				Type: migrator.MigrateTypeCreate,
+				NewData: objects.Table{
+					Schema:  "public",
+					Name:    "posts",
+					Columns: []objects.Column{{Schema: "public", Table: "posts", Name: "id", DataType: "bigint"}},
+				},
+			},
+			{Type: migrator.MigrateTypeIgnore, NewData: objects.Table{Schema: "public", Name: "ignored"}},
+		},
+	}
+}
+
+func TestBuildMigrationScript(t *testing.T) {
+	script, err := resource.BuildMigrationScript(newMigrationTestData())
+	assert.NoError(t, err)
+	assert.False(t, script.IsEmpty())
+
+	var names []string
+	for _, s := range script.Steps {
+		names = append(names, s.Resource+":"+s.Name)
+	}
+	assert.Equal(t, []string{"role:editor", "type:public.status", "table:public.posts", "type:public.old_status"}, names)
+
+	up := script.Up()
+	assert.True(t, strings.HasPrefix(up, "-- create role editor"))
+	assert.Less(t, strings.Index(up, "public.status"), strings.Index(up, "public.posts"))
+
+	down := script.Down()
+	assert.True(t, strings.HasPrefix(down, "-- revert delete type public.old_status"))
+	assert.Less(t, strings.Index(down, "posts"), strings.Index(down, "editor"))
+}
+
+func TestEmitMigrations(t *testing.T) {
+	dir := filepath.Join(t.TempDir(), "migrations", "nested")
+	now := time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC)
+
+	upPath, downPath, err := resource.EmitMigrations(dir, newMigrationTestData(), now)
+	assert.NoError(t, err)
+	assert.Equal(t, filepath.Join(dir, "20240102030405_apply.up.sql"), upPath)
+	assert.Equal(t, filepath.Join(dir, "20240102030405_apply.down.sql"), downPath)
+
+	upContent, err := os.ReadFile(upPath)
+	assert.NoError(t, err)
+	assert.Contains(t, string(upContent), "-- generated by raiden apply at 2024-01-02T03:04:05Z")
+	assert.Contains(t, string(upContent), "CREATE ROLE")
+
+	downContent, err := os.ReadFile(downPath)
+	assert.NoError(t, err)
+	assert.Contains(t, string(downContent), "DROP ROLE")
+}
+
+func TestEmitMigrations_NoChange(t *testing.T) {
+	dir := filepath.Join(t.TempDir(), "migrations")
+
+	upPath, downPath, err := resource.EmitMigrations(dir, &resource.MigrateData{}, time.Now())
+	assert.NoError(t, err)
+	assert.Empty(t, upPath)
+	assert.Empty(t, downPath)
+	assert.NoDirExists(t, dir)
+}
+
+func TestMigrationScript_Transaction(t *testing.T) {
+	script := resource.MigrationScript{
+		Steps: []resource.MigrationStep{
+			{Type: migrator.MigrateTypeCreate, Resource: "role", Name: "editor", Up: "BEGIN;\ndo $$\nBEGIN\n  CREATE ROLE editor;\nEND $$;\nCOMMIT;"},
+			{Type: migrator.MigrateTypeUpdate, Resource: "rpc", Name: "get_posts", Up: "BEGIN; DROP FUNCTION public.get_posts; CREATE FUNCTION public.get_posts() RETURNS void AS $function$ BEGIN; COMMIT; $function$ LANGUAGE plpgsql; COMMIT;"},
+		},
+	}
+
+	sql := script.Transaction()
+	assert.True(t, strings.HasPrefix(sql, "BEGIN;\n"))
+	assert.True(t, strings.HasSuffix(sql, "\nCOMMIT;"))
+	assert.Equal(t, 1, strings.Count(sql, "BEGIN;\n"))
+	assert.Contains(t, sql, "do $$\nBEGIN\n  CREATE ROLE editor;\nEND $$;")
+	assert.Contains(t, sql, "$function$ BEGIN; COMMIT; $function$")
+	assert.Contains(t, sql, "raiden migration step 1 failed")
+	assert.Contains(t, sql, "raiden migration step 2 failed")
+	assert.Contains(t, sql, "-- update rpc get_posts")
+
+	index, step, found := script.FindFailedStep(errors.New("ERROR: raiden migration step 2 failed : function does not exist"))
+	assert.True(t, found)
+	assert.Equal(t, 1, index)
+	assert.Equal(t, "get_posts", step.Name)
+
+	_, _, found = script.FindFailedStep(errors.New("ERROR: raiden migration step 3 failed : out of range"))
+	assert.False(t, found)
+
+	_, _, found = script.FindFailedStep(errors.New("connection refused"))
+	assert.False(t, found)
+}
+
+func TestMigrateTransaction(t *testing.T) {
+	cfg := &raiden.Config{
+		DeploymentTarget:    raiden.DeploymentTargetCloud,
+		ProjectId:           "test-project-id",
+		SupabaseApiBasePath: "/v1",
+		SupabaseApiUrl:      "http://supabase.cloud.com",
+		SupabasePublicUrl:   "http://supabase.cloud.com",
+		Mode:                raiden.BffMode,
+	}
+	flags := &resource.Flags{Transactional: true}
+	localState := &state.LocalState{}
+
+	err := resource.MigrateTransaction(flags, cfg, localState, &resource.MigrateData{})
+	assert.NoError(t, err)
+
+	mock := &mock.MockSupabase{Cfg: cfg}
+	mock.Activate()
+	defer mock.Deactivate()
+
+	err = mock.MockRunMigrationScriptWithExpectedResponse(400, map[string]any{"message": "ERROR: raiden migration step 3 failed : relation \"public.posts\" does not exist"})
+	assert.NoError(t, err)
+
+	err = resource.MigrateTransaction(flags, cfg, localState, newMigrationTestData())
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "apply is rolled back, step 3 of 4 failed (create table public.posts)")
+	assert.Contains(t, err.Error(), "CREATE TABLE IF NOT EXISTS")
+}
@@ -0,0 +1,117 @@
+package resource
+
+import (
+	"fmt"
+
+	"github.com/sev-2/raiden"
+	"github.com/sev-2/raiden/pkg/connector/pgmeta"
+	"github.com/sev-2/raiden/pkg/resource/migrator"
+	"github.com/sev-2/raiden/pkg/state"
+	"github.com/sev-2/raiden/pkg/supabase"
+	"github.com/sev-2/raiden/pkg/supabase/objects"
+)
+
+// MigrateTransaction run all migrate data as single ordered script in one transaction,
+// any error will rollback all change and the error contain failed statement
+func MigrateTransaction(flags *Flags, config *raiden.Config, localState *state.LocalState, resource *MigrateData) error {
+	script, err := BuildMigrationScript(resource)
+	if err != nil {
+		return err
+	}
+
+	if script.IsEmpty() {
+		ApplyLogger.Info("no change found, skip run migration transaction")
+		return nil
+	}
+
+	ApplyLogger.Info("run migration transaction", "total-step", len(script.Steps))
+	ApplyLogger.Trace("migration transaction script", "sql", script.Transaction())
+	if err := runMigrationScript(config, script.Transaction()); err != nil {
+		if index, step, found := script.FindFailedStep(err); found {
+			return fmt.Errorf(
+				"apply is rolled back, step %d of %d failed (%s %s %s) : %s\nfailed statement :\n%s",
+				index+1, len(script.Steps), step.Type, step.Resource, step.Name, err, step.Up,
+			)
+		}
+		return fmt.Errorf("apply is rolled back : %s", err)
+	}
+
+	// created resource id is generated by database,
+	// load latest resource for update local state
+	ApplyLogger.Info("load resource from supabase for update local state")
+	latestResource, err := Load(flags, config)
+	if err != nil {
+		return err
+	}
+
+	stateChan := make(chan any)
+	doneListen := UpdateLocalStateFromApply(flags.ProjectPath, localState, stateChan)
+	sendMigrateState(stateChan, resource.Roles, func(r objects.Role) (objects.Role, bool) {
+		return findMigrateData(latestResource.Roles, func(lr objects.Role) bool { return lr.Name == r.Name })
+	})
+	sendMigrateState(stateChan, resource.Extensions, func(e objects.Extension) (objects.Extension, bool) {
+		return findMigrateData(latestResource.Extensions, func(le objects.Extension) bool { return le.Name == e.Name })
+	})
+	sendMigrateState(stateChan, resource.Types, func(t objects.Type) (objects.Type, bool) {
+		return findMigrateData(latestResource.Types, func(lt objects.Type) bool { return lt.Schema == t.Schema && lt.Name == t.Name })
+	})
+	sendMigrateState(stateChan, resource.Tables, func(t objects.Table) (objects.Table, bool) {
+		return findMigrateData(latestResource.Tables, func(lt objects.Table) bool { return lt.Schema == t.Schema && lt.Name == t.Name })
+	})
+	sendMigrateState(stateChan, resource.Rpc, func(f objects.Function) (objects.Function, bool) {
+		return findMigrateData(latestResource.Functions, func(lf objects.Function) bool { return state.GetRpcKey(lf) == state.GetRpcKey(f) })
+	})
+	sendMigrateState(stateChan, resource.Views, func(v objects.View) (objects.View, bool) {
+		return findMigrateData(latestResource.Views, func(lv objects.View) bool { return lv.Schema == v.Schema && lv.Name == v.Name })
+	})
+	sendMigrateState(stateChan, resource.Triggers, func(t objects.Trigger) (objects.Trigger, bool) {
+		return findMigrateData(latestResource.Triggers, func(lt objects.Trigger) bool {
+			return lt.Schema == t.Schema && lt.Table == t.Table && lt.Name == t.Name
+		})
+	})
+	sendMigrateState(stateChan, resource.Policies, func(p objects.Policy) (objects.Policy, bool) {
+		return findMigrateData(latestResource.Policies, func(lp objects.Policy) bool {
+			return lp.Schema == p.Schema && lp.Table == p.Table && lp.Name == p.Name
+		})
+	})
+	sendMigrateState(stateChan, resource.Storages, func(b objects.Bucket) (objects.Bucket, bool) {
+		return findMigrateData(latestResource.Storages, func(lb objects.Bucket) bool { return lb.Name == b.Name })
+	})
+	close(stateChan)
+
+	return <-doneListen
+}
+
+func runMigrationScript(config *raiden.Config, script string) error {
+	if config.Mode == raiden.SvcMode {
+		return pgmeta.RunMigrationScript(config, script)
+	}
+	return supabase.RunMigrationScript(config, script)
+}
+
+// sendMigrateState send applied migrate item to local state listener,
+// new data of created resource is replaced with the latest resource
+func sendMigrateState[T, D any](stateChan chan any, items []migrator.MigrateItem[T, D], findFn func(data T) (T, bool)) {
+	for i := range items {
+		item := items[i]
+		if item.Type == migrator.MigrateTypeIgnore {
+			continue
+		}
+
+		if item.Type == migrator.MigrateTypeCreate {
+			if latest, found := findFn(item.NewData); found {
+				item.NewData = latest
+			}
+		}
+		stateChan <- &item
+	}
+}
+
+func findMigrateData[T any](list []T, matchFn func(data T) bool) (data T, found bool) {
+	for i := range list {
+		if matchFn(list[i]) {
+			return list[i], true
+		}
+	}
+	return
+}
@@ -0,0 +1,56 @@
+package migrator
+
+// RiskLevel is classification of data loss possibility from migrate item
+type RiskLevel string
+
+const (
+	RiskLevelSafe        RiskLevel = "safe"
+	RiskLevelLossy       RiskLevel = "lossy"
+	RiskLevelDestructive RiskLevel = "destructive"
+)
+
+var riskLevelOrder = map[RiskLevel]int{
+	RiskLevelSafe:        0,
+	RiskLevelLossy:       1,
+	RiskLevelDestructive: 2,
+}
+
+// PlanDiff is single field that changed in update action
+type PlanDiff struct {
+	Field string `json:"field"`
+	Old   any    `json:"old"`
+	New   any    `json:"new"`
+}
+
+// PlanChange is machine readable representation of migrate item,
+// destructive is true when change will remove existing data
+type PlanChange struct {
+	Kind        string      `json:"kind"`
+	Name        string      `json:"name"`
+	Action      MigrateType `json:"action"`
+	Risk        RiskLevel   `json:"risk"`
+	Destructive bool        `json:"destructive"`
+	Diffs       []PlanDiff  `json:"diffs,omitempty"`
+}
+
+func NewPlanChange[T, D any](kind string, name string, item MigrateItem[T, D]) PlanChange {
+	return PlanChange{
+		Kind:   kind,
+		Name:   name,
+		Action: item.Type,
+		Risk:   RiskLevelSafe,
+	}
+}
+
+func (p *PlanChange) AddDiff(field string, oldValue, newValue any) {
+	p.Diffs = append(p.Diffs, PlanDiff{Field: field, Old: oldValue, New: newValue})
+}
+
+// SetRisk raise risk level of change, lower risk level is ignored
+// so the highest risk from all diff is kept
+func (p *PlanChange) SetRisk(risk RiskLevel) {
+	if riskLevelOrder[risk] > riskLevelOrder[p.Risk] {
+		p.Risk = risk
+	}
+	p.Destructive = p.Risk == RiskLevelDestructive
+}
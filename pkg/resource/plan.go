@@ -0,0 +1,199 @@
+package resource
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+
+	"github.com/sev-2/raiden/pkg/resource/extensions"
+	"github.com/sev-2/raiden/pkg/resource/migrator"
+	"github.com/sev-2/raiden/pkg/resource/policies"
+	"github.com/sev-2/raiden/pkg/resource/roles"
+	"github.com/sev-2/raiden/pkg/resource/rpc"
+	"github.com/sev-2/raiden/pkg/resource/storages"
+	"github.com/sev-2/raiden/pkg/resource/tables"
+	"github.com/sev-2/raiden/pkg/resource/triggers"
+	"github.com/sev-2/raiden/pkg/resource/types"
+	"github.com/sev-2/raiden/pkg/resource/views"
+	"github.com/sev-2/raiden/pkg/supabase/objects"
+)
+
+const (
+	OutputText = "text"
+	OutputJson = "json"
+)
+
+// planKindOrder is order of resource kind in plan, follow apply migration order
+var planKindOrder = []string{"role", "extension", "type", "table", "rpc", "view", "trigger", "policy", "storage"}
+
+type PlanSummary struct {
+	Create      int `json:"create"`
+	Update      int `json:"update"`
+	Delete      int `json:"delete"`
+	Destructive int `json:"destructive"`
+}
+
+// Plan is machine readable list of change from apply or import,
+// change is sorted by kind and name so the output is stable
+type Plan struct {
+	Command string                `json:"command"`
+	DryRun  bool                  `json:"dry_run"`
+	Summary PlanSummary           `json:"summary"`
+	Changes []migrator.PlanChange `json:"changes"`
+	Errors  []string              `json:"errors,omitempty"`
+}
+
+func (p Plan) HasDestructive() bool {
+	return p.Summary.Destructive > 0
+}
+
+func ValidateOutput(output string) error {
+	switch output {
+	case "", OutputText, OutputJson:
+		return nil
+	default:
+		return fmt.Errorf("invalid output format '%s', available format is %s and %s", output, OutputText, OutputJson)
+	}
+}
+
+func BuildApplyPlan(data MigrateData, dryRun bool) Plan {
+	var changes []migrator.PlanChange
+	changes = append(changes, roles.GetPlanChanges(data.Roles)...)
+	changes = append(changes, extensions.GetPlanChanges(data.Extensions)...)
+	changes = append(changes, types.GetPlanChanges(data.Types)...)
+	changes = append(changes, tables.GetPlanChanges(data.Tables)...)
+	changes = append(changes, rpc.GetPlanChanges(data.Rpc)...)
+	changes = append(changes, views.GetPlanChanges(data.Views)...)
+	changes = append(changes, triggers.GetPlanChanges(data.Triggers)...)
+	changes = append(changes, policies.GetPlanChanges(data.Policies)...)
+	changes = append(changes, storages.GetPlanChanges(data.Storages)...)
+	return newPlan("apply", dryRun, changes)
+}
+
+// buildImportPlan return plan of resource in database that conflict with local resource,
+// new resource that will be imported is only counted in summary
+func buildImportPlan(flags *Flags, spResource *Resource, app *appResource, report ImportReport) (plan Plan, err error) {
+	changes, err := buildComparePlanChanges(flags, spResource, app)
+	if err != nil {
+		return plan, err
+	}
+
+	plan = newPlan("import", flags.DryRun, changes)
+	plan.Summary.Create += report.Table + report.Role + report.Rpc + report.Storage + report.Types + report.Triggers + report.Views + report.Extensions
+	return plan, nil
+}
+
+// buildComparePlanChanges compare database resource with existing local resource
+// using CompareList from each resource and return conflicted resource as update change
+func buildComparePlanChanges(flags *Flags, spResource *Resource, app *appResource) (changes []migrator.PlanChange, err error) {
+	if flags.All() || flags.RolesOnly {
+		diffResult, err := roles.CompareList(spResource.Roles, app.Roles.Existing)
+		if err != nil {
+			return changes, err
+		}
+		changes = append(changes, roles.GetComparePlanChanges(diffResult)...)
+	}
+
+	if flags.All() || flags.ModelsOnly {
+		diffExtensions, err := extensions.CompareList(spResource.Extensions, app.Extensions.Existing)
+		if err != nil {
+			return changes, err
+		}
+		changes = append(changes, extensions.GetComparePlanChanges(diffExtensions)...)
+
+		diffTypes, err := types.CompareList(spResource.Types, app.Types.Existing)
+		if err != nil {
+			return changes, err
+		}
+		changes = append(changes, types.GetComparePlanChanges(diffTypes)...)
+
+		var compareTables []objects.Table
+		for i := range app.Tables.Existing {
+			compareTables = append(compareTables, app.Tables.Existing[i].Table)
+		}
+		diffTables, err := tables.CompareList(tables.CompareModeImport, spResource.Tables, compareTables)
+		if err != nil {
+			return changes, err
+		}
+		changes = append(changes, tables.GetComparePlanChanges(diffTables)...)
+
+		diffViews, err := views.CompareList(spResource.Views, app.Views.Existing)
+		if err != nil {
+			return changes, err
+		}
+		changes = append(changes, views.GetComparePlanChanges(diffViews)...)
+
+		diffTriggers, err := triggers.CompareList(spResource.Triggers, app.Triggers.Existing)
+		if err != nil {
+			return changes, err
+		}
+		changes = append(changes, triggers.GetComparePlanChanges(diffTriggers)...)
+	}
+
+	if flags.All() || flags.RpcOnly {
+		diffResult, err := rpc.CompareList(spResource.Functions, app.Rpc.Existing)
+		if err != nil {
+			return changes, err
+		}
+		changes = append(changes, rpc.GetComparePlanChanges(diffResult)...)
+	}
+
+	if flags.All() || flags.StoragesOnly {
+		var compareStorages []objects.Bucket
+		for i := range app.Storages.Existing {
+			compareStorages = append(compareStorages, app.Storages.Existing[i].Storage)
+		}
+		diffResult, err := storages.CompareList(spResource.Storages, compareStorages)
+		if err != nil {
+			return changes, err
+		}
+		changes = append(changes, storages.GetComparePlanChanges(diffResult)...)
+	}
+	return changes, nil
+}
+
+func newPlan(command string, dryRun bool, changes []migrator.PlanChange) Plan {
+	mapKindOrder := make(map[string]int)
+	for i, k := range planKindOrder {
+		mapKindOrder[k] = i
+	}
+
+	sort.SliceStable(changes, func(i, j int) bool {
+		if changes[i].Kind != changes[j].Kind {
+			return mapKindOrder[changes[i].Kind] < mapKindOrder[changes[j].Kind]
+		}
+		return changes[i].Name < changes[j].Name
+	})
+
+	plan := Plan{Command: command, DryRun: dryRun, Changes: make([]migrator.PlanChange, 0, len(changes))}
+	for _, c := range changes {
+		switch c.Action {
+		case migrator.MigrateTypeCreate:
+			plan.Summary.Create++
+		case migrator.MigrateTypeUpdate:
+			plan.Summary.Update++
+		case migrator.MigrateTypeDelete:
+			plan.Summary.Delete++
+		}
+
+		if c.Destructive {
+			plan.Summary.Destructive++
+		}
+		plan.Changes = append(plan.Changes, c)
+	}
+	return plan
+}
+
+// PrintPlan write plan as indented json, log is written to stderr
+// so the output can be piped directly to other tools
+func PrintPlan(w io.Writer, plan Plan) error {
+	b, err := json.MarshalIndent(plan, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	_, err = fmt.Fprintln(w, strings.TrimSpace(string(b)))
+	return err
+}
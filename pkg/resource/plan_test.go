@@ -0,0 +1,63 @@
+package resource_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"testing"
+
+	"github.com/sev-2/raiden/pkg/resource"
+	"github.com/sev-2/raiden/pkg/resource/migrator"
+	"github.com/sev-2/raiden/pkg/resource/roles"
+	"github.com/sev-2/raiden/pkg/resource/tables"
+	"github.com/sev-2/raiden/pkg/supabase/objects"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBuildApplyPlan(t *testing.T) {
+	data := newMigrationTestData()
+	data.Roles = append(data.Roles, roles.MigrateItem{
+		Type:           migrator.MigrateTypeUpdate,
+		OldData:        objects.Role{Name: "admin", CanLogin: false},
+		NewData:        objects.Role{Name: "admin", CanLogin: true},
+		MigrationItems: objects.UpdateRoleParam{ChangeItems: []objects.UpdateRoleType{objects.UpdateRoleCanLogin}},
+	})
+	data.Tables = append(data.Tables, tables.MigrateItem{
+		Type:    migrator.MigrateTypeDelete,
+		OldData: objects.Table{Schema: "public", Name: "comments"},
+	})
+
+	plan := resource.BuildApplyPlan(*data, true)
+	assert.Equal(t, "apply", plan.Command)
+	assert.True(t, plan.DryRun)
+	assert.Equal(t, resource.PlanSummary{Create: 3, Update: 1, Delete: 2, Destructive: 2}, plan.Summary)
+	assert.True(t, plan.HasDestructive())
+
+	var names []string
+	for _, c := range plan.Changes {
+		names = append(names, c.Kind+":"+c.Name)
+	}
+	assert.Equal(t, []string{
+		"role:admin", "role:editor",
+		"type:public.old_status", "type:public.status",
+		"table:public.comments", "table:public.posts",
+	}, names)
+
+	assert.Equal(t, []migrator.PlanDiff{{Field: "can_login", Old: false, New: true}}, plan.Changes[0].Diffs)
+}
+
+func TestPrintPlan(t *testing.T) {
+	var buf bytes.Buffer
+	err := resource.PrintPlan(&buf, resource.BuildApplyPlan(resource.MigrateData{}, false))
+	assert.NoError(t, err)
+
+	var result map[string]any
+	assert.NoError(t, json.Unmarshal(buf.Bytes(), &result))
+	assert.Equal(t, "apply", result["command"])
+	assert.Equal(t, []any{}, result["changes"])
+}
+
+func TestValidateOutput(t *testing.T) {
+	assert.NoError(t, resource.ValidateOutput(""))
+	assert.NoError(t, resource.ValidateOutput(resource.OutputJson))
+	assert.Error(t, resource.ValidateOutput("yaml"))
+}
@@ -112,6 +112,7 @@ func BuildMigrateItem(supabaseData, localData []objects.Policy) (migrateData []M
 		migrateData = append(migrateData, MigrateItem{
 			Type:           migrateType,
 			NewData:        r.SourceResource,
+			OldData:        r.TargetResource,
 			MigrationItems: r.DiffItems,
 		})
 	}
@@ -0,0 +1,21 @@
+package policies
+
+import (
+	"github.com/sev-2/raiden/pkg/resource/migrator"
+	"github.com/sev-2/raiden/pkg/supabase/objects"
+	"github.com/sev-2/raiden/pkg/supabase/query"
+)
+
+// BuildMigrateQuery build up and down sql of policy migrate item
+func BuildMigrateQuery(item MigrateItem) (up string, down string, err error) {
+	switch item.Type {
+	case migrator.MigrateTypeCreate:
+		up, down = query.BuildCreatePolicyQuery(item.NewData), query.BuildDeletePolicyQuery(item.NewData)
+	case migrator.MigrateTypeUpdate:
+		up = query.BuildUpdatePolicyQuery(item.NewData, item.MigrationItems)
+		down = query.BuildUpdatePolicyQuery(item.OldData, objects.UpdatePolicyParam{Name: item.NewData.Name, ChangeItems: item.MigrationItems.ChangeItems})
+	case migrator.MigrateTypeDelete:
+		up, down = query.BuildDeletePolicyQuery(item.OldData), query.BuildCreatePolicyQuery(item.OldData)
+	}
+	return
+}
@@ -0,0 +1,38 @@
+package policies_test
+
+import (
+	"testing"
+
+	"github.com/sev-2/raiden/pkg/resource/migrator"
+	"github.com/sev-2/raiden/pkg/resource/policies"
+	"github.com/sev-2/raiden/pkg/supabase/objects"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBuildMigrateQuery(t *testing.T) {
+	newPolicy := objects.Policy{Name: "read_posts", Schema: "public", Table: "posts", Command: objects.PolicyCommandSelect, Definition: "published = true", Roles: []string{"anon"}}
+	oldPolicy := objects.Policy{Name: "read_posts", Schema: "public", Table: "posts", Command: objects.PolicyCommandSelect, Definition: "true", Roles: []string{"anon"}}
+
+	up, down, err := policies.BuildMigrateQuery(policies.MigrateItem{Type: migrator.MigrateTypeCreate, NewData: newPolicy})
+	assert.NoError(t, err)
+	assert.Contains(t, up, "CREATE POLICY")
+	assert.Contains(t, down, "DROP POLICY")
+
+	up, down, err = policies.BuildMigrateQuery(policies.MigrateItem{
+		Type:    migrator.MigrateTypeUpdate,
+		NewData: newPolicy,
+		OldData: oldPolicy,
+		MigrationItems: objects.UpdatePolicyParam{
+			Name:        "read_posts",
+			ChangeItems: []objects.UpdatePolicyType{objects.UpdatePolicyDefinition},
+		},
+	})
+	assert.NoError(t, err)
+	assert.Contains(t, up, "USING (published = true)")
+	assert.Contains(t, down, "USING (true)")
+
+	up, down, err = policies.BuildMigrateQuery(policies.MigrateItem{Type: migrator.MigrateTypeDelete, OldData: oldPolicy})
+	assert.NoError(t, err)
+	assert.Contains(t, up, "DROP POLICY")
+	assert.Contains(t, down, "CREATE POLICY")
+}
@@ -0,0 +1,43 @@
+package policies
+
+import (
+	"fmt"
+
+	"github.com/sev-2/raiden/pkg/resource/migrator"
+	"github.com/sev-2/raiden/pkg/supabase/objects"
+)
+
+// GetPlanChanges convert migrate item to plan change,
+// ignored item is not included
+func GetPlanChanges(items []MigrateItem) (changes []migrator.PlanChange) {
+	for i := range items {
+		item := items[i]
+		if item.Type == migrator.MigrateTypeIgnore {
+			continue
+		}
+
+		data := item.NewData
+		if item.Type == migrator.MigrateTypeDelete {
+			data = item.OldData
+		}
+
+		change := migrator.NewPlanChange("policy", fmt.Sprintf("%s.%s.%s", data.Schema, data.Table, data.Name), item)
+		if item.Type == migrator.MigrateTypeUpdate {
+			oldData, newData := item.OldData, item.NewData
+			for _, c := range item.MigrationItems.ChangeItems {
+				switch c {
+				case objects.UpdatePolicyName:
+					change.AddDiff(string(c), oldData.Name, newData.Name)
+				case objects.UpdatePolicyDefinition:
+					change.AddDiff(string(c), oldData.Definition, newData.Definition)
+				case objects.UpdatePolicyCheck:
+					change.AddDiff(string(c), oldData.Check, newData.Check)
+				case objects.UpdatePolicyRoles:
+					change.AddDiff(string(c), oldData.Roles, newData.Roles)
+				}
+			}
+		}
+		changes = append(changes, change)
+	}
+	return
+}
@@ -0,0 +1,292 @@
+package resource
+
+import (
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/sev-2/raiden"
+	"github.com/sev-2/raiden/pkg/resource/tables"
+	"github.com/sev-2/raiden/pkg/state"
+	"github.com/sev-2/raiden/pkg/supabase"
+	"github.com/sev-2/raiden/pkg/supabase/objects"
+)
+
+// Promote apply resource from source database to target database, source resource
+// is used as desired resource so target database will have the same schema as source.
+// local project state is not changed because the change does not come from project code
+func Promote(flags *Flags, sourceConfig *raiden.Config, targetConfig *raiden.Config) error {
+	var localState state.LocalState
+
+	if err := ValidateOutput(flags.Output); err != nil {
+		return err
+	}
+
+	if err := ValidatePromoteTarget(sourceConfig, targetConfig); err != nil {
+		return err
+	}
+
+	if flags.DryRun {
+		ApplyLogger.Info("running promote in dry run mode")
+	}
+
+	ApplyLogger.Info("load Native log")
+	mapNativeRole, err := loadMapNativeRole()
+	if err != nil {
+		return err
+	}
+
+	ApplyLogger.Info("load resource from source", "project", sourceConfig.ProjectName)
+	sourceResource, err := Load(flags, sourceConfig)
+	if err != nil {
+		return err
+	}
+
+	ApplyLogger.Info("load resource from target", "project", targetConfig.ProjectName)
+	targetResource, err := Load(flags, targetConfig)
+	if err != nil {
+		return err
+	}
+
+	filterMigrateResource(flags, sourceConfig, sourceResource, mapNativeRole)
+	sourceResource.Tables = tables.AttachIndexAndAction(sourceResource.Tables, sourceResource.Indexes, sourceResource.RelationActions)
+	sourceResource.Tables = tables.AttachPublication(sourceResource.Tables, sourceResource.Publications)
+
+	// filter target before build promote resource so resource that is not
+	// allowed to migrate is not marked as delete
+	filterMigrateResource(flags, targetConfig, targetResource, mapNativeRole)
+
+	ApplyLogger.Info("compare source and target resource")
+	app := buildPromoteResource(flags, sourceResource, targetResource)
+
+	migrateData, err := buildMigrateData(flags, targetConfig, targetResource, mapNativeRole, &app)
+	if err != nil {
+		return err
+	}
+
+	ApplyLogger.Info("check destructive change")
+	if err := CheckDestructiveChange(flags, migrateData, nil); err != nil {
+		return err
+	}
+	ApplyLogger.Info("finish build promote data")
+
+	if !flags.DryRun {
+		// migrate update state in the same way as apply, keep it in memory
+		// so project state is not replaced with target resource
+		state.SetBackend(&state.MemoryBackend{})
+		defer state.SetBackend(nil)
+
+		startTime := time.Now()
+		migrateErr := runMigrate(flags, targetConfig, &localState, &migrateData)
+		recordMigration(targetConfig, objects.MigrationActionPromote, nil, nil, &localState, &migrateData, startTime, migrateErr)
+		if len(migrateErr) > 0 {
+			return joinMigrateErrors(migrateErr)
+		}
+		ApplyLogger.Info("finish promote resource")
+	}
+
+	if flags.Output == OutputJson {
+		plan := BuildApplyPlan(migrateData, flags.DryRun)
+		plan.Command = "promote"
+		return PrintPlan(os.Stdout, plan)
+	}
+	PrintApplyChangeReport(migrateData)
+	return nil
+}
+
+// ValidatePromoteTarget refuse source and target that point to the same project,
+// environment variable override is applied to every profile so both profile
+// can be resolved to the same project even when the profile is different
+func ValidatePromoteTarget(sourceConfig *raiden.Config, targetConfig *raiden.Config) error {
+	checks := []struct {
+		key    string
+		source string
+		target string
+	}{
+		{"project id", sourceConfig.ProjectId, targetConfig.ProjectId},
+		{"pg meta url", sourceConfig.PgMetaUrl, targetConfig.PgMetaUrl},
+		{"database url", sourceConfig.DatabaseUrl, targetConfig.DatabaseUrl},
+	}
+
+	for _, c := range checks {
+		if c.source != "" && c.source == c.target {
+			return fmt.Errorf("source and target use the same %s, check RAIDEN_* environment variable that override both profile", c.key)
+		}
+	}
+	return nil
+}
+
+// buildPromoteResource convert source resource to app resource, resource that exist in target
+// use id from target because migrate data is compared by id
+func buildPromoteResource(flags *Flags, source *Resource, target *Resource) (app appResource) {
+	mapSchema := getAllowedSchemaMap(flags.AllowedSchema)
+
+	// table
+	mapTargetTableId := make(map[string]int)
+	for _, t := range target.Tables {
+		mapTargetTableId[getTableName(t.Schema, t.Name)] = t.ID
+	}
+
+	existingTables, newTables, deleteTables := splitPromoteResource(source.Tables, target.Tables, func(t objects.Table) string {
+		return getTableName(t.Schema, t.Name)
+	}, func(s *objects.Table, t objects.Table) {
+		s.ID = t.ID
+
+		// column id only identify column in one database, source column use id
+		// of target column with the same name so column is never detected as renamed
+		mapTargetColumnId := make(map[string]string)
+		for _, c := range t.Columns {
+			mapTargetColumnId[c.Name] = c.ID
+		}
+
+		s.Columns = append([]objects.Column(nil), s.Columns...)
+		for i := range s.Columns {
+			s.Columns[i].TableID = t.ID
+			s.Columns[i].ID = mapTargetColumnId[s.Columns[i].Name]
+		}
+	})
+	for _, t := range existingTables {
+		app.Tables.Existing = append(app.Tables.Existing, state.ExtractTableItem{Table: t})
+	}
+	for _, t := range newTables {
+		app.Tables.New = append(app.Tables.New, state.ExtractTableItem{Table: t})
+	}
+	for _, t := range deleteTables {
+		app.Tables.Delete = append(app.Tables.Delete, state.ExtractTableItem{Table: t})
+	}
+
+	// role
+	app.Roles.Existing, app.Roles.New, app.Roles.Delete = splitPromoteResource(source.Roles, target.Roles, func(r objects.Role) string {
+		return r.Name
+	}, func(s *objects.Role, t objects.Role) {
+		s.ID = t.ID
+	})
+
+	// rpc
+	app.Rpc.Existing, app.Rpc.New, app.Rpc.Delete = splitPromoteResource(source.Functions, target.Functions, state.GetRpcKey, func(s *objects.Function, t objects.Function) {
+		s.ID = t.ID
+	})
+
+	// storage
+	existingStorages, newStorages, deleteStorages := splitPromoteResource(source.Storages, target.Storages, func(b objects.Bucket) string {
+		return b.Name
+	}, func(s *objects.Bucket, t objects.Bucket) {
+		s.ID = t.ID
+	})
+	for _, s := range existingStorages {
+		app.Storages.Existing = append(app.Storages.Existing, state.ExtractStorageItem{Storage: s})
+	}
+	for _, s := range newStorages {
+		app.Storages.New = append(app.Storages.New, state.ExtractStorageItem{Storage: s})
+	}
+	for _, s := range deleteStorages {
+		app.Storages.Delete = append(app.Storages.Delete, state.ExtractStorageItem{Storage: s})
+	}
+
+	// type and view only promoted in allowed schema
+	isAllowedType := func(t objects.Type) bool { return mapSchema[t.Schema] }
+	app.Types.Existing, app.Types.New, app.Types.Delete = splitPromoteResource(filterSlice(source.Types, isAllowedType), filterSlice(target.Types, isAllowedType), func(t objects.Type) string {
+		return getTableName(t.Schema, t.Name)
+	}, func(s *objects.Type, t objects.Type) {
+		s.ID = t.ID
+	})
+
+	isAllowedView := func(v objects.View) bool { return mapSchema[v.Schema] }
+	app.Views.Existing, app.Views.New, app.Views.Delete = splitPromoteResource(filterSlice(source.Views, isAllowedView), filterSlice(target.Views, isAllowedView), func(v objects.View) string {
+		return getTableName(v.Schema, v.Name)
+	}, func(s *objects.View, t objects.View) {
+		s.ID = t.ID
+	})
+
+	// trigger
+	app.Triggers.Existing, app.Triggers.New, app.Triggers.Delete = splitPromoteResource(source.Triggers, target.Triggers, state.GetTriggerKey, func(s *objects.Trigger, t objects.Trigger) {
+		s.ID = t.ID
+		s.TableID = t.TableID
+	})
+
+	// extension
+	app.Extensions.Existing, app.Extensions.New, app.Extensions.Delete = splitPromoteResource(source.Extensions, target.Extensions, func(e objects.Extension) string {
+		return e.Name
+	}, func(s *objects.Extension, t objects.Extension) {})
+
+	// policy only promoted for promoted table and storage
+	mapSourceTable, mapTargetTable := make(map[string]bool), make(map[string]bool)
+	for _, t := range source.Tables {
+		mapSourceTable[getTableName(t.Schema, t.Name)] = true
+	}
+	for _, t := range target.Tables {
+		mapTargetTable[getTableName(t.Schema, t.Name)] = true
+	}
+
+	isPromotedPolicy := func(mapTable map[string]bool) func(objects.Policy) bool {
+		return func(p objects.Policy) bool {
+			if p.Schema == supabase.DefaultStorageSchema {
+				return flags.All() || flags.StoragesOnly
+			}
+			return mapTable[getTableName(p.Schema, p.Table)]
+		}
+	}
+
+	var existingPolicies, newPolicies []objects.Policy
+	existingPolicies, newPolicies, app.Policies.Delete = splitPromoteResource(filterSlice(source.Policies, isPromotedPolicy(mapSourceTable)), filterSlice(target.Policies, isPromotedPolicy(mapTargetTable)), func(p objects.Policy) string {
+		return fmt.Sprintf("%s.%s.%s", p.Schema, p.Table, p.Name)
+	}, func(s *objects.Policy, t objects.Policy) {
+		s.ID = t.ID
+		s.TableID = t.TableID
+	})
+	for i := range newPolicies {
+		if id, exist := mapTargetTableId[getTableName(newPolicies[i].Schema, newPolicies[i].Table)]; exist {
+			newPolicies[i].TableID = id
+		}
+	}
+	app.Policies.Existing = existingPolicies
+	app.Policies.New = newPolicies
+
+	return app
+}
+
+// splitPromoteResource split source resource to resource that already exist in target
+// and new resource, resource that only exist in target is returned as delete
+func splitPromoteResource[T any](source []T, target []T, keyFn func(T) string, bindFn func(s *T, t T)) (existing []T, newData []T, deleted []T) {
+	mapTarget := make(map[string]T)
+	for _, t := range target {
+		mapTarget[keyFn(t)] = t
+	}
+
+	mapSource := make(map[string]bool)
+	for _, s := range source {
+		key := keyFn(s)
+		mapSource[key] = true
+
+		if t, exist := mapTarget[key]; exist {
+			bindFn(&s, t)
+			existing = append(existing, s)
+			continue
+		}
+		newData = append(newData, s)
+	}
+
+	for _, t := range target {
+		if !mapSource[keyFn(t)] {
+			deleted = append(deleted, t)
+		}
+	}
+	return
+}
+
+func filterSlice[T any](input []T, fn func(T) bool) (output []T) {
+	for _, i := range input {
+		if fn(i) {
+			output = append(output, i)
+		}
+	}
+	return
+}
+
+func getAllowedSchemaMap(allowedSchema string) map[string]bool {
+	mapSchema := make(map[string]bool)
+	for _, s := range getAllowedSchema(allowedSchema) {
+		mapSchema[s] = true
+	}
+	return mapSchema
+}
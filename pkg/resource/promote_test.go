@@ -0,0 +1,108 @@
+package resource_test
+
+import (
+	"testing"
+
+	"github.com/sev-2/raiden/pkg/mock"
+	"github.com/sev-2/raiden/pkg/resource"
+	"github.com/sev-2/raiden/pkg/supabase/objects"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPromote(t *testing.T) {
+	sourceConfig, targetConfig := loadConfig(), loadConfig()
+	sourceConfig.ProjectId, sourceConfig.SupabaseApiUrl = "staging-project-id", "http://staging.supabase.cloud.com"
+	targetConfig.ProjectId, targetConfig.SupabaseApiUrl = "prod-project-id", "http://prod.supabase.cloud.com"
+
+	sourceMock := &mock.MockSupabase{Cfg: sourceConfig}
+	targetMock := &mock.MockSupabase{Cfg: targetConfig}
+	sourceMock.Activate()
+	defer sourceMock.Deactivate()
+
+	// table id in source and target is different, table is matched by name
+	err := sourceMock.MockGetTablesWithExpectedResponse(200, []objects.Table{
+		{ID: 10, Name: "promoted_table", Schema: "public"},
+		{ID: 11, Name: "new_table", Schema: "public"},
+	})
+	assert.NoError(t, err)
+	assert.NoError(t, sourceMock.MockGetTypesWithExpectedResponse(200, []objects.Type{}))
+
+	err = targetMock.MockGetTablesWithExpectedResponse(200, []objects.Table{
+		{ID: 1, Name: "promoted_table", Schema: "public"},
+		{ID: 2, Name: "old_table", Schema: "public"},
+	})
+	assert.NoError(t, err)
+	assert.NoError(t, targetMock.MockGetTypesWithExpectedResponse(200, []objects.Type{}))
+
+	flags := &resource.Flags{ModelsOnly: true, DryRun: true}
+	err = resource.Promote(flags, sourceConfig, targetConfig)
+	assert.NoError(t, err)
+
+	// table that only exist in target is deleted
+	flags.DryRun = false
+	err = resource.Promote(flags, sourceConfig, targetConfig)
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "delete table public.old_table")
+	assert.NotContains(t, err.Error(), "promoted_table")
+
+	err = resource.Promote(&resource.Flags{Output: "yaml"}, sourceConfig, targetConfig)
+	assert.Error(t, err)
+}
+
+func TestPromote_DifferentColumnLayout(t *testing.T) {
+	sourceConfig, targetConfig := loadConfig(), loadConfig()
+	sourceConfig.ProjectId, sourceConfig.SupabaseApiUrl = "staging-project-id", "http://staging.supabase.cloud.com"
+	targetConfig.ProjectId, targetConfig.SupabaseApiUrl = "prod-project-id", "http://prod.supabase.cloud.com"
+
+	sourceMock := &mock.MockSupabase{Cfg: sourceConfig}
+	targetMock := &mock.MockSupabase{Cfg: targetConfig}
+	sourceMock.Activate()
+	defer sourceMock.Deactivate()
+
+	// table oid and column attnum is the same in both project
+	// but the column in the same position has different name
+	err := sourceMock.MockGetTablesWithExpectedResponse(200, []objects.Table{
+		{ID: 1, Name: "promoted_table", Schema: "public", Columns: []objects.Column{
+			{ID: "1.1", TableID: 1, Schema: "public", Table: "promoted_table", Name: "id", DataType: "bigint"},
+			{ID: "1.2", TableID: 1, Schema: "public", Table: "promoted_table", Name: "title", DataType: "text"},
+		}},
+	})
+	assert.NoError(t, err)
+	assert.NoError(t, sourceMock.MockGetTypesWithExpectedResponse(200, []objects.Type{}))
+
+	err = targetMock.MockGetTablesWithExpectedResponse(200, []objects.Table{
+		{ID: 1, Name: "promoted_table", Schema: "public", Columns: []objects.Column{
+			{ID: "1.1", TableID: 1, Schema: "public", Table: "promoted_table", Name: "id", DataType: "bigint"},
+			{ID: "1.2", TableID: 1, Schema: "public", Table: "promoted_table", Name: "body", DataType: "text"},
+		}},
+	})
+	assert.NoError(t, err)
+	assert.NoError(t, targetMock.MockGetTypesWithExpectedResponse(200, []objects.Type{}))
+
+	// body is dropped and title is created instead of renaming body to title
+	err = resource.Promote(&resource.Flags{ModelsOnly: true}, sourceConfig, targetConfig)
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "found destructive change")
+	assert.Contains(t, err.Error(), "update table public.promoted_table")
+}
+
+func TestPromote_SameTarget(t *testing.T) {
+	sourceConfig, targetConfig := loadConfig(), loadConfig()
+	sourceConfig.ProjectId, targetConfig.ProjectId = "same-project-id", "same-project-id"
+
+	err := resource.Promote(&resource.Flags{ModelsOnly: true, DryRun: true}, sourceConfig, targetConfig)
+	assert.EqualError(t, err, "source and target use the same project id, check RAIDEN_* environment variable that override both profile")
+
+	targetConfig.ProjectId = "prod-project-id"
+	sourceConfig.PgMetaUrl, targetConfig.PgMetaUrl = "http://localhost:8080", "http://localhost:8080"
+	err = resource.ValidatePromoteTarget(sourceConfig, targetConfig)
+	assert.ErrorContains(t, err, "same pg meta url")
+
+	targetConfig.PgMetaUrl = "http://prod:8080"
+	sourceConfig.DatabaseUrl, targetConfig.DatabaseUrl = "postgres://localhost/db", "postgres://localhost/db"
+	err = resource.ValidatePromoteTarget(sourceConfig, targetConfig)
+	assert.ErrorContains(t, err, "same database url")
+
+	targetConfig.DatabaseUrl = "postgres://prod/db"
+	assert.NoError(t, resource.ValidatePromoteTarget(sourceConfig, targetConfig))
+}
@@ -0,0 +1,22 @@
+package roles
+
+import (
+	"github.com/sev-2/raiden/pkg/resource/migrator"
+	"github.com/sev-2/raiden/pkg/supabase/objects"
+	"github.com/sev-2/raiden/pkg/supabase/query"
+)
+
+// BuildMigrateQuery build up and down sql of role migrate item
+func BuildMigrateQuery(item MigrateItem) (up string, down string, err error) {
+	switch item.Type {
+	case migrator.MigrateTypeCreate:
+		up, down = query.BuildCreateRoleQuery(item.NewData), query.BuildDeleteRoleQuery(item.NewData)
+	case migrator.MigrateTypeUpdate:
+		oldData := item.MigrationItems.OldData
+		up = query.BuildUpdateRoleQuery(item.NewData, item.MigrationItems)
+		down = query.BuildUpdateRoleQuery(oldData, objects.UpdateRoleParam{OldData: item.NewData, ChangeItems: item.MigrationItems.ChangeItems})
+	case migrator.MigrateTypeDelete:
+		up, down = query.BuildDeleteRoleQuery(item.OldData), query.BuildCreateRoleQuery(item.OldData)
+	}
+	return
+}
@@ -0,0 +1,34 @@
+package roles_test
+
+import (
+	"testing"
+
+	"github.com/sev-2/raiden/pkg/resource/migrator"
+	"github.com/sev-2/raiden/pkg/resource/roles"
+	"github.com/sev-2/raiden/pkg/supabase/objects"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBuildMigrateQuery(t *testing.T) {
+	up, down, err := roles.BuildMigrateQuery(roles.MigrateItem{Type: migrator.MigrateTypeCreate, NewData: objects.Role{Name: "editor"}})
+	assert.NoError(t, err)
+	assert.Contains(t, up, "CREATE ROLE editor")
+	assert.Contains(t, down, "DROP ROLE")
+
+	up, down, err = roles.BuildMigrateQuery(roles.MigrateItem{
+		Type:    migrator.MigrateTypeUpdate,
+		NewData: objects.Role{Name: "editor", ConnectionLimit: 10},
+		MigrationItems: objects.UpdateRoleParam{
+			OldData:     objects.Role{Name: "editor", ConnectionLimit: 5},
+			ChangeItems: []objects.UpdateRoleType{objects.UpdateConnectionLimit},
+		},
+	})
+	assert.NoError(t, err)
+	assert.Contains(t, up, "CONNECTION LIMIT 10")
+	assert.Contains(t, down, "CONNECTION LIMIT 5")
+
+	up, down, err = roles.BuildMigrateQuery(roles.MigrateItem{Type: migrator.MigrateTypeDelete, OldData: objects.Role{Name: "editor"}})
+	assert.NoError(t, err)
+	assert.Contains(t, up, "DROP ROLE")
+	assert.Contains(t, down, "CREATE ROLE editor")
+}
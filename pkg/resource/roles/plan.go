@@ -0,0 +1,75 @@
+package roles
+
+import (
+	"github.com/sev-2/raiden/pkg/resource/migrator"
+	"github.com/sev-2/raiden/pkg/supabase/objects"
+)
+
+// GetPlanChanges convert migrate item to plan change,
+// ignored item is not included
+func GetPlanChanges(items []MigrateItem) (changes []migrator.PlanChange) {
+	for i := range items {
+		item := items[i]
+		if item.Type == migrator.MigrateTypeIgnore {
+			continue
+		}
+
+		name := item.NewData.Name
+		if item.Type == migrator.MigrateTypeDelete {
+			name = item.OldData.Name
+		}
+
+		change := migrator.NewPlanChange("role", name, item)
+		if item.Type == migrator.MigrateTypeUpdate {
+			oldData, newData := item.OldData, item.NewData
+			for _, c := range item.MigrationItems.ChangeItems {
+				switch c {
+				case objects.UpdateConnectionLimit:
+					change.AddDiff(string(c), oldData.ConnectionLimit, newData.ConnectionLimit)
+				case objects.UpdateRoleName:
+					change.AddDiff(string(c), oldData.Name, newData.Name)
+				case objects.UpdateRoleIsReplication:
+					change.AddDiff(string(c), oldData.IsReplicationRole, newData.IsReplicationRole)
+				case objects.UpdateRoleIsSuperUser:
+					change.AddDiff(string(c), oldData.IsSuperuser, newData.IsSuperuser)
+				case objects.UpdateRoleInheritRole:
+					change.AddDiff(string(c), oldData.InheritRole, newData.InheritRole)
+				case objects.UpdateRoleCanCreateDb:
+					change.AddDiff(string(c), oldData.CanCreateDB, newData.CanCreateDB)
+				case objects.UpdateRoleCanCreateRole:
+					change.AddDiff(string(c), oldData.CanCreateRole, newData.CanCreateRole)
+				case objects.UpdateRoleCanLogin:
+					change.AddDiff(string(c), oldData.CanLogin, newData.CanLogin)
+				case objects.UpdateRoleCanBypassRls:
+					change.AddDiff(string(c), oldData.CanBypassRLS, newData.CanBypassRLS)
+				case objects.UpdateRoleConfig:
+					change.AddDiff(string(c), oldData.Config, newData.Config)
+				case objects.UpdateRoleValidUntil:
+					change.AddDiff(string(c), oldData.ValidUntil, newData.ValidUntil)
+				}
+			}
+		}
+		changes = append(changes, change)
+	}
+	return
+}
+
+// GetComparePlanChanges convert conflicted compare result to update plan change,
+// source resource is used as new data and target resource as old data
+func GetComparePlanChanges(diffResult []CompareDiffResult) []migrator.PlanChange {
+	var items []MigrateItem
+	for i := range diffResult {
+		d := diffResult[i]
+		if !d.IsConflict {
+			continue
+		}
+
+		items = append(items, MigrateItem{
+			Type:           migrator.MigrateTypeUpdate,
+			NewData:        d.SourceResource,
+			OldData:        d.TargetResource,
+			MigrationItems: d.DiffItems,
+		})
+	}
+	return GetPlanChanges(items)
+}
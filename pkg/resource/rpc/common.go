@@ -12,7 +12,7 @@ func GetNewCountData(supabaseData []objects.Function, localData state.ExtractRpc
 	for i := range supabaseData {
 		r := supabaseData[i]
 
-		if _, exist := mapData[r.Name]; exist {
+		if _, exist := mapData[state.GetRpcKey(r)]; exist {
 			newCount++
 		}
 	}
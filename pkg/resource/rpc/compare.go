@@ -3,6 +3,7 @@ package rpc
 import (
 	"strings"
 
+	"github.com/sev-2/raiden/pkg/state"
 	"github.com/sev-2/raiden/pkg/supabase/objects"
 	"github.com/sev-2/raiden/pkg/utils"
 )
@@ -26,7 +27,7 @@ func CompareList(sourceFn []objects.Function, targetFn []objects.Function) (diff
 	mapTargetFn := make(map[string]objects.Function)
 	for i := range targetFn {
 		f := targetFn[i]
-		mapTargetFn[f.Name] = f
+		mapTargetFn[state.GetRpcKey(f)] = f
 		Logger.Debug("TargetFn", "target-name", f)
 	}
 
@@ -35,7 +36,7 @@ func CompareList(sourceFn []objects.Function, targetFn []objects.Function) (diff
 		s.CompleteStatement = strings.ReplaceAll(s.CompleteStatement, "search_path TO", "search_path =")
 		Logger.Debug("SourceFn", "source-name", s)
 
-		t, isExist := mapTargetFn[s.Name]
+		t, isExist := mapTargetFn[state.GetRpcKey(s)]
 		if !isExist {
 			continue
 		}
@@ -60,7 +60,7 @@ func BuildMigrateData(extractedLocalData state.ExtractRpcResult, supabaseData []
 			isExist := false
 			for i := range supabaseData {
 				tt := supabaseData[i]
-				if tt.Name == t.Name {
+				if state.GetRpcKey(tt) == state.GetRpcKey(t) {
 					isExist = true
 					break
 				}
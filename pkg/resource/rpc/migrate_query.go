@@ -0,0 +1,28 @@
+package rpc
+
+import (
+	"github.com/sev-2/raiden/pkg/resource/migrator"
+	"github.com/sev-2/raiden/pkg/supabase/query"
+)
+
+// BuildMigrateQuery build up and down sql of rpc migrate item
+func BuildMigrateQuery(item MigrateItem) (up string, down string, err error) {
+	switch item.Type {
+	case migrator.MigrateTypeCreate:
+		if up, err = query.BuildFunctionQuery(query.FunctionActionCreate, &item.NewData); err != nil {
+			return
+		}
+		down, err = query.BuildFunctionQuery(query.FunctionActionDelete, &item.NewData)
+	case migrator.MigrateTypeUpdate:
+		if up, err = query.BuildFunctionQuery(query.FunctionActionUpdate, &item.NewData); err != nil {
+			return
+		}
+		down, err = query.BuildFunctionQuery(query.FunctionActionUpdate, &item.OldData)
+	case migrator.MigrateTypeDelete:
+		if up, err = query.BuildFunctionQuery(query.FunctionActionDelete, &item.OldData); err != nil {
+			return
+		}
+		down, err = query.BuildFunctionQuery(query.FunctionActionCreate, &item.OldData)
+	}
+	return
+}
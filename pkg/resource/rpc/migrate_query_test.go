@@ -0,0 +1,42 @@
+package rpc_test
+
+import (
+	"testing"
+
+	"github.com/sev-2/raiden/pkg/resource/migrator"
+	"github.com/sev-2/raiden/pkg/resource/rpc"
+	"github.com/sev-2/raiden/pkg/supabase/objects"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBuildMigrateQuery(t *testing.T) {
+	newFn := objects.Function{Schema: "public", Name: "get_user", CompleteStatement: "CREATE OR REPLACE FUNCTION public.get_user() RETURNS int AS $$ SELECT 2 $$ LANGUAGE sql"}
+	oldFn := objects.Function{Schema: "public", Name: "get_user", CompleteStatement: "CREATE OR REPLACE FUNCTION public.get_user() RETURNS int AS $$ SELECT 1 $$ LANGUAGE sql"}
+
+	up, down, err := rpc.BuildMigrateQuery(rpc.MigrateItem{Type: migrator.MigrateTypeCreate, NewData: newFn})
+	assert.NoError(t, err)
+	assert.Contains(t, up, "SELECT 2")
+	assert.Equal(t, "DROP FUNCTION public.get_user();", down)
+
+	up, down, err = rpc.BuildMigrateQuery(rpc.MigrateItem{Type: migrator.MigrateTypeUpdate, NewData: newFn, OldData: oldFn})
+	assert.NoError(t, err)
+	assert.Contains(t, up, "SELECT 2")
+	assert.Contains(t, down, "SELECT 1")
+
+	up, down, err = rpc.BuildMigrateQuery(rpc.MigrateItem{Type: migrator.MigrateTypeDelete, OldData: oldFn})
+	assert.NoError(t, err)
+	assert.Equal(t, "DROP FUNCTION public.get_user();", up)
+	assert.Contains(t, down, "SELECT 1")
+}
+
+func TestBuildMigrateQuery_Overload(t *testing.T) {
+	fn := objects.Function{Schema: "public", Name: "get_user", IdentityArgumentTypes: "in_id integer", CompleteStatement: "CREATE OR REPLACE FUNCTION public.get_user(in_id integer) RETURNS int AS $$ SELECT in_id $$ LANGUAGE sql"}
+
+	up, _, err := rpc.BuildMigrateQuery(rpc.MigrateItem{Type: migrator.MigrateTypeDelete, OldData: fn})
+	assert.NoError(t, err)
+	assert.Equal(t, "DROP FUNCTION public.get_user(in_id integer);", up)
+
+	up, _, err = rpc.BuildMigrateQuery(rpc.MigrateItem{Type: migrator.MigrateTypeUpdate, NewData: fn, OldData: fn})
+	assert.NoError(t, err)
+	assert.Contains(t, up, "DROP FUNCTION public.get_user(in_id integer);")
+}
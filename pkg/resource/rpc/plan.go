@@ -0,0 +1,48 @@
+package rpc
+
+import (
+	"github.com/sev-2/raiden/pkg/resource/migrator"
+	"github.com/sev-2/raiden/pkg/state"
+)
+
+// GetPlanChanges convert migrate item to plan change,
+// ignored item is not included
+func GetPlanChanges(items []MigrateItem) (changes []migrator.PlanChange) {
+	for i := range items {
+		item := items[i]
+		if item.Type == migrator.MigrateTypeIgnore {
+			continue
+		}
+
+		data := item.NewData
+		if item.Type == migrator.MigrateTypeDelete {
+			data = item.OldData
+		}
+
+		change := migrator.NewPlanChange("rpc", state.GetRpcKey(data), item)
+		if item.Type == migrator.MigrateTypeUpdate && item.OldData.CompleteStatement != item.NewData.CompleteStatement {
+			change.AddDiff("complete_statement", item.OldData.CompleteStatement, item.NewData.CompleteStatement)
+		}
+		changes = append(changes, change)
+	}
+	return
+}
+
+// GetComparePlanChanges convert conflicted compare result to update plan change,
+// source resource is used as new data and target resource as old data
+func GetComparePlanChanges(diffResult []CompareDiffResult) []migrator.PlanChange {
+	var items []MigrateItem
+	for i := range diffResult {
+		d := diffResult[i]
+		if !d.IsConflict {
+			continue
+		}
+
+		items = append(items, MigrateItem{
+			Type:    migrator.MigrateTypeUpdate,
+			NewData: d.SourceResource,
+			OldData: d.TargetResource,
+		})
+	}
+	return GetPlanChanges(items)
+}
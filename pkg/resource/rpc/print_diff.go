@@ -8,6 +8,7 @@ import (
 	"text/template"
 
 	"github.com/fatih/color"
+	"github.com/sev-2/raiden/pkg/generator"
 	"github.com/sev-2/raiden/pkg/resource/migrator"
 	"github.com/sev-2/raiden/pkg/utils"
 )
@@ -42,7 +43,7 @@ func PrintDiff(diffData CompareDiffResult) {
 		return
 	}
 
-	printScope("*** Found diff in %s/%s.go ***\n", "/internal/rpc", fileName)
+	printScope("*** Found diff in /%s/%s.go ***\n", generator.GetRpcDir(diffData.TargetResource.Schema), fileName)
 	fmt.Println(diffMessage)
 	printScope("*** End found diff ***\n")
 }
@@ -0,0 +1,449 @@
+package resource
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/csv"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/go-hclog"
+	"github.com/sev-2/raiden"
+	"github.com/sev-2/raiden/pkg/db"
+	"github.com/sev-2/raiden/pkg/logger"
+	"github.com/sev-2/raiden/pkg/state"
+	"github.com/sev-2/raiden/pkg/utils"
+)
+
+var SeedLogger hclog.Logger = logger.HcLog().Named("seed")
+
+// SeedDir is folder of seed declaration and seed file, seed file is named
+// by table name with optional schema, for example countries.json or public.countries.csv
+const SeedDir = "internal/seeds"
+
+type (
+	// SeedTable is seed row of one table, row is map of column name and value
+	SeedTable struct {
+		Table       string
+		Model       any
+		PrimaryKeys []string
+		Rows        []map[string]any
+	}
+
+	SeedReport struct {
+		Table     string `json:"table"`
+		Inserted  int    `json:"inserted"`
+		Updated   int    `json:"updated"`
+		Unchanged int    `json:"unchanged"`
+	}
+
+	// SeedPlan is changed row of seed table that need to be upserted,
+	// states is new checksum of changed row
+	SeedPlan struct {
+		Table  SeedTable
+		Report SeedReport
+		Rows   []any
+		States []state.SeedState
+	}
+
+	SeedResult struct {
+		Command string       `json:"command"`
+		DryRun  bool         `json:"dry_run"`
+		Tables  []SeedReport `json:"tables"`
+	}
+)
+
+// Seed upsert seed data that is changed since last seed, checksum of seeded row
+// is stored in state so running seed again does not change unchanged row
+func Seed(flags *Flags, config *raiden.Config) error {
+	if err := ValidateOutput(flags.Output); err != nil {
+		return err
+	}
+
+	if flags.DryRun {
+		SeedLogger.Info("running seed in dry run mode")
+	}
+
+	releaseState, err := useStateBackend(config, !flags.DryRun, SeedLogger)
+	if err != nil {
+		return err
+	}
+	defer releaseState()
+
+	SeedLogger.Info("load seed data")
+	seedTables, err := LoadSeed(flags.ProjectPath, registeredSeeds, RegisteredModels)
+	if err != nil {
+		return err
+	}
+
+	SeedLogger.Info("load seed state")
+	latestState, err := state.Load()
+	if err != nil {
+		return err
+	}
+
+	plans, err := BuildSeedPlan(seedTables, latestState.Seeds)
+	if err != nil {
+		return err
+	}
+
+	if !flags.DryRun {
+		db.SetConfig(config)
+		for i := range plans {
+			p := plans[i]
+			if len(p.Rows) == 0 {
+				continue
+			}
+
+			SeedLogger.Info("upsert seed data", "table", p.Table.Table, "row", len(p.Rows))
+			err := db.NewQuery(nil).AsSystem().From(p.Table.Model).Upsert(p.Rows, db.UpsertOptions{OnConflict: db.MergeDuplicates})
+			if err != nil {
+				return fmt.Errorf("failed seed table %s : %s", p.Table.Table, err)
+			}
+
+			// save state after every table, so seeded table is not upserted again when next table is failed
+			latestState.Seeds = mergeSeedState(latestState.Seeds, p.States)
+			if err := state.Save(latestState); err != nil {
+				return err
+			}
+		}
+		SeedLogger.Info("finish seed data")
+	}
+
+	result := SeedResult{Command: "seed", DryRun: flags.DryRun, Tables: make([]SeedReport, 0, len(plans))}
+	for _, p := range plans {
+		result.Tables = append(result.Tables, p.Report)
+	}
+
+	if flags.Output == OutputJson {
+		return PrintSeedResult(os.Stdout, result)
+	}
+	PrintSeedReport(result)
+	return nil
+}
+
+// LoadSeed collect seed row from registered seed and seed file in project,
+// seed file table must have registered model for getting primary key
+func LoadSeed(projectPath string, seeds []raiden.Seed, models []any) ([]SeedTable, error) {
+	var seedTables []SeedTable
+	mapSeedTable := make(map[string]int)
+
+	appendRows := func(model any, rows []map[string]any) error {
+		table := getSeedTableName(model)
+		index, exist := mapSeedTable[table]
+		if !exist {
+			primaryKeys := getSeedPrimaryKeys(model)
+			if len(primaryKeys) == 0 {
+				return fmt.Errorf("model of seed table %s does not have primary key", table)
+			}
+
+			seedTables = append(seedTables, SeedTable{Table: table, Model: model, PrimaryKeys: primaryKeys})
+			index = len(seedTables) - 1
+			mapSeedTable[table] = index
+		}
+		seedTables[index].Rows = append(seedTables[index].Rows, rows...)
+		return nil
+	}
+
+	for _, s := range seeds {
+		var rows []map[string]any
+		for _, d := range s.Data() {
+			row, err := toSeedRow(d)
+			if err != nil {
+				return nil, err
+			}
+			rows = append(rows, row)
+		}
+
+		if err := appendRows(s.Model(), rows); err != nil {
+			return nil, err
+		}
+	}
+
+	seedDir := filepath.Join(projectPath, SeedDir)
+	if !utils.IsFolderExists(seedDir) {
+		return seedTables, nil
+	}
+
+	entries, err := os.ReadDir(seedDir)
+	if err != nil {
+		return nil, err
+	}
+
+	mapModel := make(map[string]any)
+	for _, m := range models {
+		mapModel[getSeedTableName(m)] = m
+	}
+
+	// entries is sorted by file name, so seed file is upserted in file name order
+	for _, e := range entries {
+		ext := filepath.Ext(e.Name())
+		if e.IsDir() || (ext != ".json" && ext != ".csv") {
+			continue
+		}
+
+		table := strings.TrimSuffix(e.Name(), ext)
+		if !strings.Contains(table, ".") {
+			table = "public." + table
+		}
+
+		model, exist := mapModel[table]
+		if !exist {
+			return nil, fmt.Errorf("model of seed file %s is not registered", e.Name())
+		}
+
+		rows, err := readSeedFile(filepath.Join(seedDir, e.Name()))
+		if err != nil {
+			return nil, fmt.Errorf("invalid seed file %s : %s", e.Name(), err)
+		}
+
+		if err := appendRows(model, rows); err != nil {
+			return nil, err
+		}
+	}
+
+	return seedTables, nil
+}
+
+// BuildSeedPlan compare checksum of seed row with seed state,
+// row that not exist in state is inserted and row with different checksum is updated
+func BuildSeedPlan(seedTables []SeedTable, seedStates []state.SeedState) ([]SeedPlan, error) {
+	mapChecksum := make(map[string]string)
+	for _, s := range seedStates {
+		mapChecksum[getSeedStateKey(s.Table, s.Key)] = s.Checksum
+	}
+
+	plans := make([]SeedPlan, 0, len(seedTables))
+	for _, t := range seedTables {
+		p := SeedPlan{Table: t, Report: SeedReport{Table: t.Table}}
+		mapKey := make(map[string]bool)
+
+		for _, row := range t.Rows {
+			key, err := getSeedRowKey(t, row)
+			if err != nil {
+				return nil, err
+			}
+
+			if mapKey[key] {
+				return nil, fmt.Errorf("duplicate seed row with primary key %s in table %s", key, t.Table)
+			}
+			mapKey[key] = true
+
+			checksum, err := getSeedRowChecksum(row)
+			if err != nil {
+				return nil, err
+			}
+
+			lastChecksum, exist := mapChecksum[getSeedStateKey(t.Table, key)]
+			switch {
+			case !exist:
+				p.Report.Inserted++
+			case lastChecksum != checksum:
+				p.Report.Updated++
+			default:
+				p.Report.Unchanged++
+				continue
+			}
+
+			p.Rows = append(p.Rows, row)
+			p.States = append(p.States, state.SeedState{Table: t.Table, Key: key, Checksum: checksum, LastUpdate: time.Now()})
+		}
+		plans = append(plans, p)
+	}
+	return plans, nil
+}
+
+func PrintSeedReport(result SeedResult) {
+	if len(result.Tables) == 0 {
+		SeedLogger.Info("no seed data found")
+		return
+	}
+
+	for _, t := range result.Tables {
+		SeedLogger.Info("seed report", "table", t.Table, "inserted", t.Inserted, "updated", t.Updated, "unchanged", t.Unchanged)
+	}
+}
+
+func PrintSeedResult(w io.Writer, result SeedResult) error {
+	b, err := json.MarshalIndent(result, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	_, err = fmt.Fprintln(w, string(b))
+	return err
+}
+
+func mergeSeedState(seedStates []state.SeedState, changed []state.SeedState) []state.SeedState {
+	mapIndex := make(map[string]int)
+	for i, s := range seedStates {
+		mapIndex[getSeedStateKey(s.Table, s.Key)] = i
+	}
+
+	for _, s := range changed {
+		if i, exist := mapIndex[getSeedStateKey(s.Table, s.Key)]; exist {
+			seedStates[i] = s
+			continue
+		}
+		seedStates = append(seedStates, s)
+	}
+	return seedStates
+}
+
+func getSeedStateKey(table string, key string) string {
+	return table + "\x00" + key
+}
+
+func getSeedRowKey(t SeedTable, row map[string]any) (string, error) {
+	values := make([]string, 0, len(t.PrimaryKeys))
+	for _, k := range t.PrimaryKeys {
+		v, exist := row[k]
+		if !exist || v == nil {
+			return "", fmt.Errorf("seed row of table %s does not have value for primary key %s", t.Table, k)
+		}
+		values = append(values, fmt.Sprint(v))
+	}
+	return strings.Join(values, ","), nil
+}
+
+// getSeedRowChecksum return sha256 of row json, json object key
+// is sorted so the same row always produce the same checksum
+func getSeedRowChecksum(row map[string]any) (string, error) {
+	b, err := json.Marshal(row)
+	if err != nil {
+		return "", err
+	}
+
+	sum := sha256.Sum256(b)
+	return hex.EncodeToString(sum[:]), nil
+}
+
+// toSeedRow convert model data to row using column tag of the model, zero value
+// is included so every row of the table has the same column and zero value can be seeded
+func toSeedRow(data any) (map[string]any, error) {
+	rv := reflect.ValueOf(data)
+	if rv.Kind() == reflect.Ptr {
+		rv = rv.Elem()
+	}
+
+	if rv.Kind() != reflect.Struct {
+		return nil, fmt.Errorf("seed data must be model struct, got %T", data)
+	}
+
+	row := make(map[string]any)
+	rt := rv.Type()
+	for i := 0; i < rt.NumField(); i++ {
+		tag := rt.Field(i).Tag.Get("column")
+		if tag == "" {
+			continue
+		}
+
+		ct := raiden.UnmarshalColumnTag(tag)
+		if ct.Name == "" {
+			continue
+		}
+
+		// value is encoded and decoded as json so value
+		// has the same type as row loaded from seed file
+		b, err := json.Marshal(rv.Field(i).Interface())
+		if err != nil {
+			return nil, err
+		}
+
+		var value any
+		if err := decodeSeedJson(b, &value); err != nil {
+			return nil, err
+		}
+		row[ct.Name] = value
+	}
+	return row, nil
+}
+
+func readSeedFile(path string) ([]map[string]any, error) {
+	b, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	if filepath.Ext(path) == ".json" {
+		var rows []map[string]any
+		if err := decodeSeedJson(b, &rows); err != nil {
+			return nil, err
+		}
+		return rows, nil
+	}
+
+	records, err := csv.NewReader(bytes.NewReader(b)).ReadAll()
+	if err != nil {
+		return nil, err
+	}
+
+	if len(records) == 0 {
+		return nil, nil
+	}
+
+	// first record is column name and empty value is stored as null
+	var rows []map[string]any
+	columns := records[0]
+	for _, r := range records[1:] {
+		row := make(map[string]any)
+		for i, c := range columns {
+			if i >= len(r) || r[i] == "" {
+				row[c] = nil
+				continue
+			}
+			row[c] = r[i]
+		}
+		rows = append(rows, row)
+	}
+	return rows, nil
+}
+
+// decodeSeedJson decode number as json.Number, so big integer id
+// is not changed to float when upserted
+func decodeSeedJson(data []byte, v any) error {
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	decoder.UseNumber()
+	return decoder.Decode(v)
+}
+
+func getSeedTableName(model any) string {
+	schema := "public"
+	rt := reflect.TypeOf(model)
+	if rt.Kind() == reflect.Ptr {
+		rt = rt.Elem()
+	}
+
+	if field, found := rt.FieldByName("Metadata"); found {
+		if s := field.Tag.Get("schema"); s != "" {
+			schema = s
+		}
+	}
+	return fmt.Sprintf("%s.%s", schema, raiden.GetTableName(model))
+}
+
+func getSeedPrimaryKeys(model any) (primaryKeys []string) {
+	rt := reflect.TypeOf(model)
+	if rt.Kind() == reflect.Ptr {
+		rt = rt.Elem()
+	}
+
+	for i := 0; i < rt.NumField(); i++ {
+		tag := rt.Field(i).Tag.Get("column")
+		if tag == "" {
+			continue
+		}
+
+		if ct := raiden.UnmarshalColumnTag(tag); ct.PrimaryKey && ct.Name != "" {
+			primaryKeys = append(primaryKeys, ct.Name)
+		}
+	}
+	return
+}
@@ -0,0 +1,176 @@
+package resource_test
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"sort"
+	"testing"
+
+	"github.com/sev-2/raiden"
+	"github.com/sev-2/raiden/pkg/resource"
+	"github.com/sev-2/raiden/pkg/state"
+	"github.com/stretchr/testify/assert"
+)
+
+type MockCountry struct {
+	raiden.ModelBase
+	Id   int64  `json:"id,omitempty" column:"name:id;type:bigint;primaryKey;nullable:false"`
+	Code string `json:"code,omitempty" column:"name:code;type:varchar;nullable:false"`
+	Name string `json:"name,omitempty" column:"name:name;type:varchar;nullable:false"`
+
+	// Table information
+	Metadata string `json:"-" schema:"public" tableName:"countries" rlsEnable:"false" rlsForced:"false"`
+}
+
+type MockCurrency struct {
+	raiden.ModelBase
+	Code string `json:"code,omitempty" column:"name:code;type:varchar;primaryKey;nullable:false"`
+	Name string `json:"name,omitempty" column:"name:name;type:varchar;nullable:false"`
+
+	// Table information
+	Metadata string `json:"-" schema:"master" tableName:"currencies" rlsEnable:"false" rlsForced:"false"`
+}
+
+type MockCountrySeed struct {
+	raiden.SeedBase
+}
+
+func (s *MockCountrySeed) Model() any {
+	return &MockCountry{}
+}
+
+func (s *MockCountrySeed) Data() []any {
+	return []any{
+		MockCountry{Id: 1, Code: "ID", Name: "Indonesia"},
+		MockCountry{Id: 2, Code: "SG", Name: "Singapore"},
+	}
+}
+
+func TestLoadSeed(t *testing.T) {
+	dir := t.TempDir()
+	seedDir := filepath.Join(dir, resource.SeedDir)
+	assert.NoError(t, os.MkdirAll(seedDir, 0755))
+
+	err := os.WriteFile(filepath.Join(seedDir, "countries.json"), []byte(`[{"id": 3, "code": "MY", "name": "Malaysia"}]`), 0644)
+	assert.NoError(t, err)
+
+	err = os.WriteFile(filepath.Join(seedDir, "master.currencies.csv"), []byte("code,name\nIDR,Rupiah\nSGD,\n"), 0644)
+	assert.NoError(t, err)
+
+	seedTables, err := resource.LoadSeed(dir, []raiden.Seed{&MockCountrySeed{}}, []any{&MockCountry{}, &MockCurrency{}})
+	assert.NoError(t, err)
+	assert.Len(t, seedTables, 2)
+
+	assert.Equal(t, "public.countries", seedTables[0].Table)
+	assert.Equal(t, []string{"id"}, seedTables[0].PrimaryKeys)
+	assert.Len(t, seedTables[0].Rows, 3)
+	assert.Equal(t, "Malaysia", seedTables[0].Rows[2]["name"])
+
+	assert.Equal(t, "master.currencies", seedTables[1].Table)
+	assert.Equal(t, []string{"code"}, seedTables[1].PrimaryKeys)
+	assert.Len(t, seedTables[1].Rows, 2)
+	assert.Nil(t, seedTables[1].Rows[1]["name"])
+}
+
+func TestLoadSeed_UnregisteredModel(t *testing.T) {
+	dir := t.TempDir()
+	seedDir := filepath.Join(dir, resource.SeedDir)
+	assert.NoError(t, os.MkdirAll(seedDir, 0755))
+
+	err := os.WriteFile(filepath.Join(seedDir, "cities.json"), []byte(`[]`), 0644)
+	assert.NoError(t, err)
+
+	_, err = resource.LoadSeed(dir, nil, []any{&MockCountry{}})
+	assert.EqualError(t, err, "model of seed file cities.json is not registered")
+}
+
+type MockFeature struct {
+	raiden.ModelBase
+	Id       int64  `json:"id,omitempty" column:"name:id;type:bigint;primaryKey;nullable:false"`
+	Name     string `json:"name,omitempty" column:"name:name;type:varchar;nullable:false"`
+	Enabled  bool   `json:"enabled,omitempty" column:"name:enabled;type:boolean;nullable:false"`
+	Priority int    `json:"priority,omitempty" column:"name:priority;type:integer;nullable:false"`
+
+	// Table information
+	Metadata string `json:"-" schema:"public" tableName:"features" rlsEnable:"false" rlsForced:"false"`
+}
+
+type MockFeatureSeed struct {
+	raiden.SeedBase
+}
+
+func (s *MockFeatureSeed) Model() any {
+	return &MockFeature{}
+}
+
+func (s *MockFeatureSeed) Data() []any {
+	return []any{
+		MockFeature{Id: 1, Name: "beta", Enabled: true, Priority: 10},
+		&MockFeature{Id: 2, Name: "legacy", Enabled: false, Priority: 0},
+	}
+}
+
+func TestLoadSeed_ZeroValue(t *testing.T) {
+	seedTables, err := resource.LoadSeed(t.TempDir(), []raiden.Seed{&MockFeatureSeed{}}, nil)
+	assert.NoError(t, err)
+	assert.Len(t, seedTables, 1)
+	assert.Len(t, seedTables[0].Rows, 2)
+
+	// zero value is seeded and every row has the same column
+	row := seedTables[0].Rows[1]
+	assert.Equal(t, false, row["enabled"])
+	assert.Equal(t, json.Number("0"), row["priority"])
+	assert.Equal(t, []string{"enabled", "id", "name", "priority"}, getSortedKeys(seedTables[0].Rows[0]))
+	assert.Equal(t, getSortedKeys(seedTables[0].Rows[0]), getSortedKeys(row))
+}
+
+func getSortedKeys(row map[string]any) []string {
+	keys := make([]string, 0, len(row))
+	for k := range row {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+func TestBuildSeedPlan(t *testing.T) {
+	seedTables, err := resource.LoadSeed(t.TempDir(), []raiden.Seed{&MockCountrySeed{}}, nil)
+	assert.NoError(t, err)
+
+	// first seed insert all row
+	plans, err := resource.BuildSeedPlan(seedTables, nil)
+	assert.NoError(t, err)
+	assert.Len(t, plans, 1)
+	assert.Equal(t, resource.SeedReport{Table: "public.countries", Inserted: 2}, plans[0].Report)
+	assert.Len(t, plans[0].Rows, 2)
+	assert.Len(t, plans[0].States, 2)
+
+	// seed again with the same data does not change any row
+	seedStates := plans[0].States
+	plans, err = resource.BuildSeedPlan(seedTables, seedStates)
+	assert.NoError(t, err)
+	assert.Equal(t, resource.SeedReport{Table: "public.countries", Unchanged: 2}, plans[0].Report)
+	assert.Empty(t, plans[0].Rows)
+
+	// changed row is updated
+	seedTables[0].Rows[1]["name"] = "Republic of Singapore"
+	plans, err = resource.BuildSeedPlan(seedTables, seedStates)
+	assert.NoError(t, err)
+	assert.Equal(t, resource.SeedReport{Table: "public.countries", Updated: 1, Unchanged: 1}, plans[0].Report)
+	assert.Equal(t, []state.SeedState{{Table: "public.countries", Key: "2", Checksum: plans[0].States[0].Checksum, LastUpdate: plans[0].States[0].LastUpdate}}, plans[0].States)
+}
+
+func TestBuildSeedPlan_InvalidRow(t *testing.T) {
+	seedTables := []resource.SeedTable{
+		{Table: "public.countries", PrimaryKeys: []string{"id"}, Rows: []map[string]any{{"name": "Indonesia"}}},
+	}
+	_, err := resource.BuildSeedPlan(seedTables, nil)
+	assert.EqualError(t, err, "seed row of table public.countries does not have value for primary key id")
+
+	seedTables = []resource.SeedTable{
+		{Table: "public.countries", PrimaryKeys: []string{"id"}, Rows: []map[string]any{{"id": 1}, {"id": 1}}},
+	}
+	_, err = resource.BuildSeedPlan(seedTables, nil)
+	assert.EqualError(t, err, "duplicate seed row with primary key 1 in table public.countries")
+}
@@ -0,0 +1,22 @@
+package storages
+
+import (
+	"github.com/sev-2/raiden/pkg/resource/migrator"
+	"github.com/sev-2/raiden/pkg/supabase/objects"
+	"github.com/sev-2/raiden/pkg/supabase/query"
+)
+
+// BuildMigrateQuery build up and down sql of bucket migrate item
+func BuildMigrateQuery(item MigrateItem) (up string, down string, err error) {
+	switch item.Type {
+	case migrator.MigrateTypeCreate:
+		up, down = query.BuildCreateBucketQuery(&item.NewData), query.BuildDeleteBucketQuery(&item.NewData)
+	case migrator.MigrateTypeUpdate:
+		oldData := item.MigrationItems.OldData
+		up = query.BuildUpdateBucketQuery(&item.NewData, item.MigrationItems)
+		down = query.BuildUpdateBucketQuery(&oldData, objects.UpdateBucketParam{OldData: item.NewData, ChangeItems: item.MigrationItems.ChangeItems})
+	case migrator.MigrateTypeDelete:
+		up, down = query.BuildDeleteBucketQuery(&item.OldData), query.BuildCreateBucketQuery(&item.OldData)
+	}
+	return
+}
@@ -0,0 +1,38 @@
+package storages_test
+
+import (
+	"testing"
+
+	"github.com/sev-2/raiden/pkg/resource/migrator"
+	"github.com/sev-2/raiden/pkg/resource/storages"
+	"github.com/sev-2/raiden/pkg/supabase/objects"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBuildMigrateQuery(t *testing.T) {
+	limit := 1024
+	newBucket := objects.Bucket{ID: "avatars", Name: "avatars", Public: true, FileSizeLimit: &limit, AllowedMimeTypes: []string{"image/png"}}
+	oldBucket := objects.Bucket{ID: "avatars", Name: "avatars", Public: false}
+
+	up, down, err := storages.BuildMigrateQuery(storages.MigrateItem{Type: migrator.MigrateTypeCreate, NewData: newBucket})
+	assert.NoError(t, err)
+	assert.Equal(t, "INSERT INTO storage.buckets (id, name, public, file_size_limit, allowed_mime_types) VALUES ('avatars', 'avatars', true, 1024, ARRAY['image/png']::text[]) ON CONFLICT (id) DO NOTHING;", up)
+	assert.Equal(t, "DELETE FROM storage.buckets WHERE id = 'avatars';", down)
+
+	up, down, err = storages.BuildMigrateQuery(storages.MigrateItem{
+		Type:    migrator.MigrateTypeUpdate,
+		NewData: newBucket,
+		MigrationItems: objects.UpdateBucketParam{
+			OldData:     oldBucket,
+			ChangeItems: []objects.UpdateBucketType{objects.UpdateBucketIsPublic, objects.UpdateBucketFileSizeLimit},
+		},
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, "UPDATE storage.buckets SET public = true, file_size_limit = 1024 WHERE id = 'avatars';", up)
+	assert.Equal(t, "UPDATE storage.buckets SET public = false, file_size_limit = NULL WHERE id = 'avatars';", down)
+
+	up, down, err = storages.BuildMigrateQuery(storages.MigrateItem{Type: migrator.MigrateTypeDelete, OldData: oldBucket})
+	assert.NoError(t, err)
+	assert.Equal(t, "DELETE FROM storage.buckets WHERE id = 'avatars';", up)
+	assert.Contains(t, down, "INSERT INTO storage.buckets")
+}
@@ -0,0 +1,62 @@
+package storages
+
+import (
+	"github.com/sev-2/raiden/pkg/resource/migrator"
+	"github.com/sev-2/raiden/pkg/supabase/objects"
+)
+
+// GetPlanChanges convert migrate item to plan change,
+// ignored item is not included
+func GetPlanChanges(items []MigrateItem) (changes []migrator.PlanChange) {
+	for i := range items {
+		item := items[i]
+		if item.Type == migrator.MigrateTypeIgnore {
+			continue
+		}
+
+		name := item.NewData.Name
+		if item.Type == migrator.MigrateTypeDelete {
+			name = item.OldData.Name
+		}
+
+		change := migrator.NewPlanChange("storage", name, item)
+		if item.Type == migrator.MigrateTypeDelete {
+			change.SetRisk(migrator.RiskLevelDestructive)
+		}
+		if item.Type == migrator.MigrateTypeUpdate {
+			oldData, newData := item.OldData, item.NewData
+			for _, c := range item.MigrationItems.ChangeItems {
+				switch c {
+				case objects.UpdateBucketIsPublic:
+					change.AddDiff(string(c), oldData.Public, newData.Public)
+				case objects.UpdateBucketFileSizeLimit:
+					change.AddDiff(string(c), oldData.FileSizeLimit, newData.FileSizeLimit)
+				case objects.UpdateBucketAllowedMimeTypes:
+					change.AddDiff(string(c), oldData.AllowedMimeTypes, newData.AllowedMimeTypes)
+				}
+			}
+		}
+		changes = append(changes, change)
+	}
+	return
+}
+
+// GetComparePlanChanges convert conflicted compare result to update plan change,
+// source resource is used as new data and target resource as old data
+func GetComparePlanChanges(diffResult []CompareDiffResult) []migrator.PlanChange {
+	var items []MigrateItem
+	for i := range diffResult {
+		d := diffResult[i]
+		if !d.IsConflict {
+			continue
+		}
+
+		items = append(items, MigrateItem{
+			Type:           migrator.MigrateTypeUpdate,
+			NewData:        d.SourceResource,
+			OldData:        d.TargetResource,
+			MigrationItems: d.DiffItems,
+		})
+	}
+	return GetPlanChanges(items)
+}
@@ -2,6 +2,7 @@ package tables
 
 import (
 	"fmt"
+	"regexp"
 	"strings"
 
 	"github.com/sev-2/raiden/pkg/supabase/objects"
@@ -64,11 +65,25 @@ func CompareList(mode CompareMode, sourceTable, targetTable []objects.Table) (di
 func CompareItem(mode CompareMode, source, target objects.Table) (diffResult CompareDiffResult) {
 	var updateItem objects.UpdateTableParam
 
-	// create map pk for compare target pk with source pk
+	// compare columns
+	updateItem.ChangeColumnItems = compareColumns(source.Columns, target.Columns)
+
+	// create map pk for compare target pk with source pk, primary key
+	// follow renamed table and column so only the column name is compared
+	mapRenamedColumn := make(map[string]string)
+	for _, c := range updateItem.ChangeColumnItems {
+		if c.OldName != "" {
+			mapRenamedColumn[c.OldName] = c.Name
+		}
+	}
+
 	mapTargetPrimaryKey := make(map[string]bool)
 	for i := range target.PrimaryKeys {
 		pk := target.PrimaryKeys[i]
-		key := fmt.Sprintf("%s.%s.%s", pk.Schema, pk.TableName, pk.Name)
+		key := pk.Name
+		if newName, isRenamed := mapRenamedColumn[pk.Name]; isRenamed {
+			key = newName
+		}
 		mapTargetPrimaryKey[key] = true
 	}
 
@@ -93,27 +108,41 @@ func CompareItem(mode CompareMode, source, target objects.Table) (diffResult Com
 		updateItem.ChangeItems = append(updateItem.ChangeItems, objects.UpdateTableRlsForced)
 	}
 
+	if getStringValue(source.Comment) != getStringValue(target.Comment) {
+		updateItem.ChangeItems = append(updateItem.ChangeItems, objects.UpdateTableComment)
+	}
+
 	for i := range source.PrimaryKeys {
 		pk := source.PrimaryKeys[i]
-		key := fmt.Sprintf("%s.%s.%s", pk.Schema, pk.TableName, pk.Name)
-		if _, exist := mapTargetPrimaryKey[key]; !exist {
+		if _, exist := mapTargetPrimaryKey[pk.Name]; !exist {
 			updateItem.ChangeItems = append(updateItem.ChangeItems, objects.UpdateTablePrimaryKey)
 			break
 		}
 	}
 
-	// compare columns
-	updateItem.ChangeColumnItems = compareColumns(source.Columns, target.Columns)
-
 	// compare relations
 	updateItem.ChangeRelationItems = compareRelations(mode, &source, source.Relationships, target.Relationships)
 
-	if len(updateItem.ChangeItems) == 0 && len(updateItem.ChangeColumnItems) == 0 && len(updateItem.ChangeRelationItems) == 0 {
+	// compare indexes, index is only managed when apply
+	// because index is not always declared in model
+	if mode == CompareModeApply {
+		updateItem.ChangeIndexItems = compareIndexes(source.Indexes, target.Indexes)
+	}
+
+	// compare publication membership, model is the source of truth when apply
+	if mode == CompareModeApply {
+		updateItem.ChangePublicationItems = comparePublications(source.Publications, target.Publications)
+	}
+
+	if len(updateItem.ChangeItems) == 0 && len(updateItem.ChangeColumnItems) == 0 && len(updateItem.ChangeRelationItems) == 0 &&
+		len(updateItem.ChangeIndexItems) == 0 && len(updateItem.ChangePublicationItems) == 0 {
 		diffResult.IsConflict = false
 	} else {
 		Logger.Debug("changeItems", "conflict-check", updateItem.ChangeItems)
 		Logger.Debug("changeColumnItems", "conflict-check", updateItem.ChangeColumnItems)
 		Logger.Debug("changeRelationItems", "conflict-check", updateItem.ChangeRelationItems)
+		Logger.Debug("changeIndexItems", "conflict-check", updateItem.ChangeIndexItems)
+		Logger.Debug("changePublicationItems", "conflict-check", updateItem.ChangePublicationItems)
 		diffResult.IsConflict = true
 	}
 
@@ -123,15 +152,40 @@ func CompareItem(mode CompareMode, source, target objects.Table) (diffResult Com
 
 func compareColumns(source, target []objects.Column) (updateItems []objects.UpdateColumnItem) {
 	mapTargetColumn := make(map[string]objects.Column)
+	mapTargetColumnId := make(map[string]string)
 	for i := range target {
 		c := target[i]
 		mapTargetColumn[c.Name] = c
+		if c.ID != "" {
+			mapTargetColumnId[c.ID] = c.Name
+		}
+	}
+
+	// column id is table id and column ordinal position, it is not changed
+	// when column is renamed so column with the same id is compared first.
+	// id only identify column in one database, caller that compare resource
+	// from different database must bind source column id by name
+	mapRenamedColumn := make(map[string]string)
+	for i := range source {
+		sc := source[i]
+		if sc.ID == "" {
+			continue
+		}
+
+		if name, exist := mapTargetColumnId[sc.ID]; exist && name != sc.Name {
+			mapRenamedColumn[sc.Name] = name
+		}
 	}
 
 	for i := range source {
 		sc := source[i]
 
-		tc, exist := mapTargetColumn[sc.Name]
+		targetName := sc.Name
+		if oldName, isRenamed := mapRenamedColumn[sc.Name]; isRenamed {
+			targetName = oldName
+		}
+
+		tc, exist := mapTargetColumn[targetName]
 		if !exist {
 			updateItems = append(updateItems, objects.UpdateColumnItem{
 				Name:        sc.Name,
@@ -142,7 +196,9 @@ func compareColumns(source, target []objects.Column) (updateItems []objects.Upda
 
 		var updateColumnItems []objects.UpdateColumnType
 
+		var oldName string
 		if sc.Name != tc.Name {
+			oldName = tc.Name
 			updateColumnItems = append(updateColumnItems, objects.UpdateColumnName)
 		}
 
@@ -169,7 +225,13 @@ func compareColumns(source, target []objects.Column) (updateItems []objects.Upda
 			targetDefault = nil
 		}
 
-		if (sourceDefault != nil && targetDefault == nil) ||
+		// default value of generated column is the generation expression,
+		// generated column can't have default value so only expression is compared
+		if sc.IsGenerated || tc.IsGenerated {
+			if sc.IsGenerated != tc.IsGenerated || normalizeIndexExpression(getStringValue(sc.DefaultValue)) != normalizeIndexExpression(getStringValue(tc.DefaultValue)) {
+				updateColumnItems = append(updateColumnItems, objects.UpdateColumnGenerated)
+			}
+		} else if (sourceDefault != nil && targetDefault == nil) ||
 			(sourceDefault == nil && targetDefault != nil) ||
 			(sourceDefault != nil && targetDefault != nil && utils.CleanDoubleColonPattern(*sourceDefault) != utils.CleanDoubleColonPattern(*targetDefault)) {
 			updateColumnItems = append(updateColumnItems, objects.UpdateColumnDefaultValue)
@@ -188,20 +250,30 @@ func compareColumns(source, target []objects.Column) (updateItems []objects.Upda
 			updateColumnItems = append(updateColumnItems, objects.UpdateColumnNullable)
 		}
 
-		if sc.IsIdentity != tc.IsIdentity {
+		if sc.IsIdentity != tc.IsIdentity ||
+			(sc.IsIdentity && tc.IsIdentity && getIdentityGeneration(sc) != getIdentityGeneration(tc)) {
 			updateColumnItems = append(updateColumnItems, objects.UpdateColumnIdentity)
 		}
 
+		if normalizeIndexExpression(getStringValue(sc.Check)) != normalizeIndexExpression(getStringValue(tc.Check)) {
+			updateColumnItems = append(updateColumnItems, objects.UpdateColumnCheck)
+		}
+
+		if getStringValue(sc.Comment) != getStringValue(tc.Comment) {
+			updateColumnItems = append(updateColumnItems, objects.UpdateColumnComment)
+		}
+
 		if len(updateColumnItems) == 0 {
-			delete(mapTargetColumn, sc.Name)
+			delete(mapTargetColumn, tc.Name)
 			continue
 		}
 
 		updateItems = append(updateItems, objects.UpdateColumnItem{
 			Name:        sc.Name,
+			OldName:     oldName,
 			UpdateItems: updateColumnItems,
 		})
-		delete(mapTargetColumn, sc.Name)
+		delete(mapTargetColumn, tc.Name)
 	}
 
 	if len(mapTargetColumn) > 0 {
@@ -319,3 +391,183 @@ func compareRelations(mode CompareMode, table *objects.Table, source, target []o
 
 	return
 }
+
+func compareIndexes(source, target []objects.Index) (updateItems []objects.UpdateIndexItem) {
+	mapTargetIndex := make(map[string]objects.Index)
+	for i := range target {
+		idx := target[i]
+		mapTargetIndex[idx.Name] = idx
+	}
+
+	for i := range source {
+		si := source[i]
+
+		ti, exist := mapTargetIndex[si.Name]
+		if !exist {
+			updateItems = append(updateItems, objects.UpdateIndexItem{
+				Data: si,
+				Type: objects.UpdateIndexCreate,
+			})
+			continue
+		}
+		delete(mapTargetIndex, si.Name)
+
+		if !isIndexEqual(si, ti) {
+			Logger.Debug("update index, definition not match", "index-name", si.Name)
+			updateItems = append(updateItems, objects.UpdateIndexItem{
+				Data: si,
+				Type: objects.UpdateIndexUpdate,
+			})
+		}
+	}
+
+	for _, ti := range mapTargetIndex {
+		updateItems = append(updateItems, objects.UpdateIndexItem{
+			Data: ti,
+			Type: objects.UpdateIndexDelete,
+		})
+	}
+
+	return
+}
+
+func isIndexEqual(source, target objects.Index) bool {
+	if source.IsUnique != target.IsUnique {
+		return false
+	}
+
+	if getIndexMethod(source) != getIndexMethod(target) {
+		return false
+	}
+
+	if len(source.Columns) != len(target.Columns) {
+		return false
+	}
+
+	for i := range source.Columns {
+		if normalizeIndexElement(source.Columns[i]) != normalizeIndexElement(target.Columns[i]) {
+			return false
+		}
+	}
+
+	return normalizeIndexExpression(source.Predicate) == normalizeIndexExpression(target.Predicate)
+}
+
+func getIndexMethod(index objects.Index) string {
+	if index.Method == "" {
+		return "btree"
+	}
+	return strings.ToLower(index.Method)
+}
+
+var (
+	indexCastRegex         = regexp.MustCompile(`::(character varying|timestamp with time zone|timestamp without time zone|double precision|[a-z_0-9]+)(\[\])?`)
+	indexPlainElementRegex = regexp.MustCompile(`^[a-z_][a-z0-9_]*(\s+[a-z_][a-z0-9_]*)*$`)
+)
+
+// normalizeIndexElement normalize column element, database only return
+// column name and descending order so operator class and default order is omitted
+func normalizeIndexElement(element string) string {
+	element = strings.ToLower(strings.TrimSpace(strings.ReplaceAll(element, `"`, "")))
+	if indexPlainElementRegex.MatchString(element) {
+		words := strings.Fields(element)
+		for _, w := range words[1:] {
+			if w == "desc" {
+				return words[0] + " desc"
+			}
+		}
+		return words[0]
+	}
+	return normalizeIndexExpression(element)
+}
+
+// normalizeIndexExpression normalize expression returned from database,
+// type cast, quote, parentheses and whitespace is removed before compared
+func normalizeIndexExpression(expression string) string {
+	expression = strings.ToLower(expression)
+	expression = indexCastRegex.ReplaceAllString(expression, "")
+	return strings.NewReplacer(`"`, "", "(", "", ")", "", " ", "", "\n", "", "\t", "").Replace(expression)
+}
+
+func comparePublications(source, target []objects.TablePublication) (updateItems []objects.UpdatePublicationItem) {
+	mapTargetPublication := make(map[string]objects.TablePublication)
+	for i := range target {
+		p := target[i]
+		mapTargetPublication[p.Name] = p
+	}
+
+	for i := range source {
+		sp := source[i]
+
+		tp, exist := mapTargetPublication[sp.Name]
+		if !exist {
+			updateItems = append(updateItems, objects.UpdatePublicationItem{
+				Data: sp,
+				Type: objects.UpdatePublicationAdd,
+			})
+			continue
+		}
+		delete(mapTargetPublication, sp.Name)
+
+		if !isPublicationEqual(sp, tp) {
+			Logger.Debug("update publication, column list or row filter not match", "publication-name", sp.Name)
+			updateItems = append(updateItems, objects.UpdatePublicationItem{
+				Data: sp,
+				Type: objects.UpdatePublicationUpdate,
+			})
+		}
+	}
+
+	for _, tp := range mapTargetPublication {
+		updateItems = append(updateItems, objects.UpdatePublicationItem{
+			Data: tp,
+			Type: objects.UpdatePublicationDrop,
+		})
+	}
+
+	return
+}
+
+// isPublicationEqual compare column list regardless of order,
+// database always return column list ordered by column position
+func isPublicationEqual(source, target objects.TablePublication) bool {
+	if len(source.Columns) != len(target.Columns) {
+		return false
+	}
+
+	mapColumn := make(map[string]bool)
+	for _, c := range target.Columns {
+		mapColumn[c] = true
+	}
+
+	for _, c := range source.Columns {
+		if !mapColumn[c] {
+			return false
+		}
+	}
+
+	return normalizeIndexExpression(source.RowFilter) == normalizeIndexExpression(target.RowFilter)
+}
+
+// getIdentityGeneration return identity generation of identity column,
+// empty generation is treated as postgres default BY DEFAULT
+func getIdentityGeneration(column objects.Column) string {
+	if generation := strings.ToUpper(getStringValue(column.IdentityGeneration)); generation != "" {
+		return generation
+	}
+	return "BY DEFAULT"
+}
+
+// getStringValue return string of nullable column attribute,
+// nil and empty string is treated as the same value
+func getStringValue(value any) string {
+	switch v := value.(type) {
+	case string:
+		return v
+	case *string:
+		if v != nil {
+			return *v
+		}
+	}
+	return ""
+}
@@ -231,3 +231,160 @@ func TestCompareItemWithoutIndex(t *testing.T) {
 	assert.Equal(t, []objects.UpdateColumnType{objects.UpdateColumnNullable}, diffResult.DiffItems.ChangeColumnItems[0].UpdateItems)
 	assert.Equal(t, []objects.UpdateColumnType{objects.UpdateColumnNullable}, diffResult.DiffItems.ChangeColumnItems[1].UpdateItems)
 }
+
+func TestCompareItemTableIndex(t *testing.T) {
+	source := objects.Table{
+		ID:     1,
+		Name:   "users",
+		Schema: "public",
+		Indexes: []objects.Index{
+			{Name: "idx_users_email", Columns: []string{"lower(email)"}, IsUnique: true, Predicate: "deleted_at is null"},
+			{Name: "idx_users_created_at", Columns: []string{"created_at desc"}},
+			{Name: "idx_users_tags", Columns: []string{"tags"}, Method: "gin"},
+			{Name: "idx_users_name", Columns: []string{"name gin_trgm_ops"}, Method: "gin"},
+		},
+	}
+
+	target := objects.Table{
+		ID:     1,
+		Name:   "users",
+		Schema: "public",
+		Indexes: []objects.Index{
+			{Name: "idx_users_email", Columns: []string{"lower(email::text)"}, IsUnique: true, Method: "btree", Predicate: "(deleted_at IS NULL)"},
+			{Name: "idx_users_created_at", Columns: []string{"created_at"}, Method: "btree"},
+			{Name: "idx_users_name", Columns: []string{"name"}, Method: "gin"},
+			{Name: "idx_users_manual", Columns: []string{"batch"}, Method: "btree"},
+		},
+	}
+
+	diffResult := tables.CompareItem(tables.CompareModeApply, source, target)
+	assert.True(t, diffResult.IsConflict)
+
+	mapChange := make(map[string]objects.UpdateIndexType)
+	for _, item := range diffResult.DiffItems.ChangeIndexItems {
+		mapChange[item.Data.Name] = item.Type
+	}
+	assert.Equal(t, 3, len(mapChange))
+	assert.Equal(t, objects.UpdateIndexUpdate, mapChange["idx_users_created_at"])
+	assert.Equal(t, objects.UpdateIndexCreate, mapChange["idx_users_tags"])
+	assert.Equal(t, objects.UpdateIndexDelete, mapChange["idx_users_manual"])
+
+	// index is ignored when import
+	diffResult = tables.CompareItem(tables.CompareModeImport, source, target)
+	assert.False(t, diffResult.IsConflict)
+	assert.Equal(t, 0, len(diffResult.DiffItems.ChangeIndexItems))
+}
+
+func TestCompareItemTablePublication(t *testing.T) {
+	source := objects.Table{
+		ID:     1,
+		Name:   "messages",
+		Schema: "public",
+		Publications: []objects.TablePublication{
+			{Name: "supabase_realtime", Columns: []string{"body", "id"}, RowFilter: "is_hidden = false"},
+			{Name: "audit", Columns: []string{"id"}},
+			{Name: "analytics"},
+		},
+	}
+
+	target := objects.Table{
+		ID:     1,
+		Name:   "messages",
+		Schema: "public",
+		Publications: []objects.TablePublication{
+			{Name: "supabase_realtime", Columns: []string{"id", "body"}, RowFilter: "(is_hidden = false)"},
+			{Name: "audit", Columns: []string{"id", "body"}},
+			{Name: "legacy"},
+		},
+	}
+
+	diffResult := tables.CompareItem(tables.CompareModeApply, source, target)
+	assert.True(t, diffResult.IsConflict)
+
+	mapChange := make(map[string]objects.UpdatePublicationType)
+	for _, item := range diffResult.DiffItems.ChangePublicationItems {
+		mapChange[item.Data.Name] = item.Type
+	}
+	assert.Equal(t, 3, len(mapChange))
+	assert.Equal(t, objects.UpdatePublicationUpdate, mapChange["audit"])
+	assert.Equal(t, objects.UpdatePublicationAdd, mapChange["analytics"])
+	assert.Equal(t, objects.UpdatePublicationDrop, mapChange["legacy"])
+
+	// publication is ignored when import
+	diffResult = tables.CompareItem(tables.CompareModeImport, source, target)
+	assert.False(t, diffResult.IsConflict)
+	assert.Equal(t, 0, len(diffResult.DiffItems.ChangePublicationItems))
+}
+
+func TestCompareItemColumnCheckCommentAndGenerated(t *testing.T) {
+	source := objects.Table{
+		ID:      1,
+		Name:    "products",
+		Schema:  "public",
+		Comment: "list of product",
+		Columns: []objects.Column{
+			{Name: "id", DataType: "bigint", IsIdentity: true, IdentityGeneration: "ALWAYS"},
+			{Name: "price", DataType: "numeric", Check: "price > 0", Comment: "price in rupiah"},
+			{Name: "total", DataType: "numeric", IsGenerated: true, DefaultValue: "price * 3"},
+			{Name: "code", DataType: "text", Check: "length(code) > 2"},
+		},
+	}
+
+	target := objects.Table{
+		ID:     1,
+		Name:   "products",
+		Schema: "public",
+		Columns: []objects.Column{
+			{Name: "id", DataType: "bigint", IsIdentity: true, IdentityGeneration: "BY DEFAULT"},
+			{Name: "price", DataType: "numeric", Check: "(price > (0)::numeric)"},
+			{Name: "total", DataType: "numeric", IsGenerated: true, DefaultValue: "(price * (2)::numeric)"},
+			{Name: "code", DataType: "text", Check: "(length(code) > 2)"},
+		},
+	}
+
+	diffResult := tables.CompareItem(tables.CompareModeApply, source, target)
+	assert.True(t, diffResult.IsConflict)
+	assert.Contains(t, diffResult.DiffItems.ChangeItems, objects.UpdateTableComment)
+
+	mapChange := make(map[string][]objects.UpdateColumnType)
+	for _, item := range diffResult.DiffItems.ChangeColumnItems {
+		mapChange[item.Name] = item.UpdateItems
+	}
+	assert.Equal(t, 3, len(mapChange))
+	assert.Equal(t, []objects.UpdateColumnType{objects.UpdateColumnIdentity}, mapChange["id"])
+	assert.Equal(t, []objects.UpdateColumnType{objects.UpdateColumnComment}, mapChange["price"])
+	assert.Equal(t, []objects.UpdateColumnType{objects.UpdateColumnGenerated}, mapChange["total"])
+}
+
+func TestCompareItemRenamedTableAndColumn(t *testing.T) {
+	source := objects.Table{
+		ID:     1,
+		Name:   "member",
+		Schema: "public",
+		Columns: []objects.Column{
+			{ID: "1.1", Name: "id", DataType: "bigint"},
+			{ID: "1.2", Name: "full_name", DataType: "text"},
+			{Name: "email", DataType: "text"},
+		},
+		PrimaryKeys: []objects.PrimaryKey{{Name: "id", Schema: "public", TableName: "member"}},
+	}
+
+	target := objects.Table{
+		ID:     1,
+		Name:   "members",
+		Schema: "public",
+		Columns: []objects.Column{
+			{ID: "1.1", Name: "id", DataType: "bigint"},
+			{ID: "1.2", Name: "name", DataType: "text"},
+		},
+		PrimaryKeys: []objects.PrimaryKey{{Name: "id", Schema: "public", TableName: "members"}},
+	}
+
+	diffResult := tables.CompareItem(tables.CompareModeApply, source, target)
+	assert.True(t, diffResult.IsConflict)
+	assert.Equal(t, []objects.UpdateTableType{objects.UpdateTableName}, diffResult.DiffItems.ChangeItems)
+	assert.Equal(t, []objects.UpdateColumnItem{
+		{Name: "full_name", OldName: "name", UpdateItems: []objects.UpdateColumnType{objects.UpdateColumnName}},
+		{Name: "email", UpdateItems: []objects.UpdateColumnType{objects.UpdateColumnNew}},
+	}, diffResult.DiffItems.ChangeColumnItems)
+}
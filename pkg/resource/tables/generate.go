@@ -167,6 +167,29 @@ func mergeGenerateManyToManyCandidate(candidates []*ManyToManyTable, mapRelation
 	}
 }
 
+// --- attach publication membership to table
+func AttachPublication(allTable []objects.Table, allPublication []objects.Publication) []objects.Table {
+	mapTablePublication := make(map[string][]objects.TablePublication)
+	for _, p := range allPublication {
+		for _, t := range p.Tables {
+			key := fmt.Sprintf("%s.%s", t.Schema, t.Name)
+			mapTablePublication[key] = append(mapTablePublication[key], objects.TablePublication{
+				Name:      p.Name,
+				Columns:   t.Columns,
+				RowFilter: t.RowFilter,
+			})
+		}
+	}
+
+	for i := range allTable {
+		table := allTable[i]
+		table.Publications = mapTablePublication[fmt.Sprintf("%s.%s", table.Schema, table.Name)]
+		allTable[i] = table
+	}
+
+	return allTable
+}
+
 // --- attach index and action to relation
 func AttachIndexAndAction(allTable []objects.Table, allIndex []objects.Index, allAction []objects.TablesRelationshipAction) []objects.Table {
 	// build map index
@@ -182,6 +205,7 @@ func AttachIndexAndAction(allTable []objects.Table, allIndex []objects.Index, al
 		mapAction[key] = v
 	}
 
+	mapRelationIndex := make(map[string]bool)
 	for iTable := range allTable {
 		table := allTable[iTable]
 		for i := range table.Relationships {
@@ -210,11 +234,33 @@ func AttachIndexAndAction(allTable []objects.Table, allIndex []objects.Index, al
 
 			// replace with new value
 			table.Relationships[i] = r
+			if r.Index != nil {
+				mapRelationIndex[r.Index.Name] = true
+			}
 		}
 
 		allTable[iTable] = table
 	}
 
+	// attach index that is not managed by constraint or relation
+	for iTable := range allTable {
+		table := allTable[iTable]
+		table.Indexes = nil
+		for i := range allIndex {
+			idx := allIndex[i]
+			if idx.Table != table.Name || idx.Schema != table.Schema || idx.IsConstraint {
+				continue
+			}
+
+			if _, isRelationIndex := mapRelationIndex[idx.Name]; isRelationIndex {
+				continue
+			}
+
+			table.Indexes = append(table.Indexes, idx)
+		}
+		allTable[iTable] = table
+	}
+
 	return allTable
 }
 
@@ -50,3 +50,67 @@ func TestAttachActionAndIndex(t *testing.T) {
 
 	tables.AttachIndexAndAction(tbls, indexes, actions)
 }
+
+func TestAttachIndexAndAction_TableIndex(t *testing.T) {
+	tbls := []objects.Table{
+		{
+			ID:     1,
+			Name:   "submission",
+			Schema: "public",
+			Relationships: []objects.TablesRelationship{
+				{
+					ConstraintName:    "submission_candidate_id_fkey",
+					SourceSchema:      "public",
+					SourceTableName:   "submission",
+					SourceColumnName:  "candidate_id",
+					TargetTableSchema: "public",
+					TargetTableName:   "candidate",
+					TargetColumnName:  "id",
+				},
+			},
+		},
+	}
+
+	indexes := []objects.Index{
+		{Schema: "public", Table: "submission", Name: "submission_pkey", Columns: []string{"id"}, IsUnique: true, IsConstraint: true},
+		{Schema: "public", Table: "submission", Name: "ix_submission_candidate_id", Columns: []string{"candidate_id"}},
+		{Schema: "public", Table: "submission", Name: "idx_submission_score", Columns: []string{"score DESC"}, Method: "btree"},
+		{Schema: "public", Table: "candidate", Name: "idx_candidate_name", Columns: []string{"name"}, Method: "btree"},
+	}
+
+	rs := tables.AttachIndexAndAction(tbls, indexes, nil)
+	assert.NotNil(t, rs[0].Relationships[0].Index)
+	assert.Equal(t, 1, len(rs[0].Indexes))
+	assert.Equal(t, "idx_submission_score", rs[0].Indexes[0].Name)
+}
+
+func TestAttachPublication(t *testing.T) {
+	tbls := []objects.Table{
+		{Name: "messages", Schema: "public", Publications: []objects.TablePublication{{Name: "stale"}}},
+		{Name: "rooms", Schema: "public"},
+	}
+
+	publications := []objects.Publication{
+		{
+			Name: "supabase_realtime",
+			Tables: []objects.PublicationTable{
+				{Name: "messages", Schema: "public", Columns: []string{"id", "body"}, RowFilter: "(is_hidden = false)"},
+				{Name: "messages", Schema: "private"},
+			},
+		},
+		{
+			Name: "audit",
+			Tables: []objects.PublicationTable{
+				{Name: "messages", Schema: "public"},
+			},
+		},
+	}
+
+	rs := tables.AttachPublication(tbls, publications)
+	assert.Equal(t, 2, len(rs[0].Publications))
+	assert.Equal(t, "supabase_realtime", rs[0].Publications[0].Name)
+	assert.Equal(t, []string{"id", "body"}, rs[0].Publications[0].Columns)
+	assert.Equal(t, "(is_hidden = false)", rs[0].Publications[0].RowFilter)
+	assert.Equal(t, "audit", rs[0].Publications[1].Name)
+	assert.Equal(t, 0, len(rs[1].Publications))
+}
@@ -0,0 +1,356 @@
+package tables
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/sev-2/raiden/pkg/resource/migrator"
+	"github.com/sev-2/raiden/pkg/supabase/objects"
+	"github.com/sev-2/raiden/pkg/supabase/query"
+)
+
+// BuildMigrateQuery build up and down sql of table migrate item,
+// foreign key change is not included because referenced table
+// can be created in the same migration, use BuildRelationMigrateQuery
+// after all table is created
+func BuildMigrateQuery(item MigrateItem) (up string, down string, err error) {
+	switch item.Type {
+	case migrator.MigrateTypeCreate:
+		if up, err = query.BuildCreateTableQuery(item.NewData); err != nil {
+			return
+		}
+		down = query.BuildDeleteTableQuery(getQueryTable(item.NewData), true)
+	case migrator.MigrateTypeUpdate:
+		updateItems := item.MigrationItems
+		updateItems.ChangeRelationItems, updateItems.ForceCreateRelation = nil, false
+		if up, err = BuildUpdateQuery(item.NewData, updateItems); err != nil {
+			return
+		}
+		oldData, reverseItems := ReverseUpdateParam(item.NewData, updateItems)
+		down, err = BuildUpdateQuery(oldData, reverseItems)
+	case migrator.MigrateTypeDelete:
+		up = query.BuildDeleteTableQuery(getQueryTable(item.OldData), true)
+		// data in deleted table can't be restored, only the structure is created again
+		if down, err = query.BuildCreateTableQuery(item.OldData); err != nil {
+			return
+		}
+
+		var relationSql string
+		if relationSql, err = buildRelationsQuery(item.OldData, nil, true); err != nil {
+			return
+		}
+		down += relationSql
+	}
+	return
+}
+
+// BuildRelationMigrateQuery build up and down sql for foreign key of new table
+// and foreign key change of existing table
+func BuildRelationMigrateQuery(item MigrateItem) (up string, down string, err error) {
+	if item.Type == migrator.MigrateTypeUpdate && len(item.MigrationItems.ChangeRelationItems) > 0 {
+		if up, err = buildRelationsQuery(getQueryTable(item.NewData), item.MigrationItems.ChangeRelationItems, false); err != nil {
+			return
+		}
+
+		oldData, reverseItems := ReverseUpdateParam(item.NewData, objects.UpdateTableParam{
+			OldData:             item.MigrationItems.OldData,
+			ChangeRelationItems: item.MigrationItems.ChangeRelationItems,
+		})
+		down, err = buildRelationsQuery(getQueryTable(oldData), reverseItems.ChangeRelationItems, false)
+		return
+	}
+
+	if item.Type != migrator.MigrateTypeCreate || len(item.NewData.Relationships) == 0 {
+		return
+	}
+
+	if up, err = buildRelationsQuery(item.NewData, nil, true); err != nil {
+		return
+	}
+
+	var deleteItems []objects.UpdateRelationItem
+	for _, r := range item.NewData.Relationships {
+		r.ConstraintName = getConstraintName(r)
+		deleteItems = append(deleteItems, objects.UpdateRelationItem{Data: r, Type: objects.UpdateRelationDelete})
+	}
+	down, err = buildRelationsQuery(item.NewData, deleteItems, false)
+	return
+}
+
+// BuildUpdateQuery build update table sql with the same order as
+// update table in supabase driver, table - column - relation - index - publication
+func BuildUpdateQuery(newTable objects.Table, updateItem objects.UpdateTableParam) (string, error) {
+	newTable = getQueryTable(newTable)
+	updateItem.OldData = getQueryTable(updateItem.OldData)
+
+	var sqlArr []string
+	if len(updateItem.ChangeItems) > 0 {
+		sqlArr = append(sqlArr, query.BuildUpdateTableQuery(newTable, updateItem))
+	}
+
+	columnSql, err := buildColumnsQuery(newTable, updateItem)
+	if err != nil {
+		return "", err
+	}
+	sqlArr = append(sqlArr, columnSql...)
+
+	if len(updateItem.ChangeRelationItems) > 0 || updateItem.ForceCreateRelation {
+		relationSql, err := buildRelationsQuery(newTable, updateItem.ChangeRelationItems, updateItem.ForceCreateRelation)
+		if err != nil {
+			return "", err
+		}
+		sqlArr = append(sqlArr, relationSql)
+	}
+
+	for i := range updateItem.ChangeIndexItems {
+		idx := updateItem.ChangeIndexItems[i]
+		indexSql, err := query.BuildIndexQuery(idx.Type, &idx.Data)
+		if err != nil {
+			return "", err
+		}
+		sqlArr = append(sqlArr, indexSql)
+	}
+
+	for i := range updateItem.ChangePublicationItems {
+		p := updateItem.ChangePublicationItems[i]
+		publicationSql, err := query.BuildTablePublicationQuery(p.Type, newTable.Schema, newTable.Name, &p.Data)
+		if err != nil {
+			return "", err
+		}
+		sqlArr = append(sqlArr, publicationSql)
+	}
+
+	return strings.Join(sqlArr, "\n"), nil
+}
+
+// ReverseUpdateParam return old table and update param that
+// revert change from old table to new table
+func ReverseUpdateParam(newTable objects.Table, updateItem objects.UpdateTableParam) (objects.Table, objects.UpdateTableParam) {
+	oldTable := updateItem.OldData
+	reverse := objects.UpdateTableParam{
+		OldData:     newTable,
+		ChangeItems: updateItem.ChangeItems,
+	}
+
+	for _, c := range updateItem.ChangeColumnItems {
+		var reverseTypes []objects.UpdateColumnType
+		for _, t := range c.UpdateItems {
+			switch t {
+			case objects.UpdateColumnNew:
+				reverseTypes = append(reverseTypes, objects.UpdateColumnDelete)
+			case objects.UpdateColumnDelete:
+				reverseTypes = append(reverseTypes, objects.UpdateColumnNew)
+			default:
+				reverseTypes = append(reverseTypes, t)
+			}
+		}
+
+		reverseItem := objects.UpdateColumnItem{Name: c.Name, UpdateItems: reverseTypes}
+		if c.OldName != "" {
+			reverseItem.Name, reverseItem.OldName = c.OldName, c.Name
+		}
+		reverse.ChangeColumnItems = append(reverse.ChangeColumnItems, reverseItem)
+	}
+
+	mapOldRelation := make(map[string]objects.TablesRelationship)
+	for _, r := range oldTable.Relationships {
+		mapOldRelation[getConstraintName(r)] = r
+	}
+
+	for _, r := range updateItem.ChangeRelationItems {
+		switch r.Type {
+		case objects.UpdateRelationCreate:
+			reverse.ChangeRelationItems = append(reverse.ChangeRelationItems, objects.UpdateRelationItem{Data: r.Data, Type: objects.UpdateRelationDelete})
+		case objects.UpdateRelationDelete:
+			reverse.ChangeRelationItems = append(reverse.ChangeRelationItems, objects.UpdateRelationItem{Data: r.Data, Type: objects.UpdateRelationCreate})
+		default:
+			if oldRelation, exist := mapOldRelation[r.Data.ConstraintName]; exist {
+				reverse.ChangeRelationItems = append(reverse.ChangeRelationItems, objects.UpdateRelationItem{Data: oldRelation, Type: r.Type})
+			}
+		}
+	}
+
+	mapOldIndex := make(map[string]objects.Index)
+	for _, idx := range oldTable.Indexes {
+		mapOldIndex[idx.Name] = idx
+	}
+
+	for _, idx := range updateItem.ChangeIndexItems {
+		switch idx.Type {
+		case objects.UpdateIndexCreate:
+			reverse.ChangeIndexItems = append(reverse.ChangeIndexItems, objects.UpdateIndexItem{Data: idx.Data, Type: objects.UpdateIndexDelete})
+		case objects.UpdateIndexDelete:
+			reverse.ChangeIndexItems = append(reverse.ChangeIndexItems, objects.UpdateIndexItem{Data: idx.Data, Type: objects.UpdateIndexCreate})
+		case objects.UpdateIndexUpdate:
+			if oldIndex, exist := mapOldIndex[idx.Data.Name]; exist {
+				reverse.ChangeIndexItems = append(reverse.ChangeIndexItems, objects.UpdateIndexItem{Data: oldIndex, Type: objects.UpdateIndexUpdate})
+			}
+		}
+	}
+
+	mapOldPublication := make(map[string]objects.TablePublication)
+	for _, p := range oldTable.Publications {
+		mapOldPublication[p.Name] = p
+	}
+
+	for _, p := range updateItem.ChangePublicationItems {
+		switch p.Type {
+		case objects.UpdatePublicationAdd:
+			reverse.ChangePublicationItems = append(reverse.ChangePublicationItems, objects.UpdatePublicationItem{Data: p.Data, Type: objects.UpdatePublicationDrop})
+		case objects.UpdatePublicationDrop:
+			reverse.ChangePublicationItems = append(reverse.ChangePublicationItems, objects.UpdatePublicationItem{Data: p.Data, Type: objects.UpdatePublicationAdd})
+		case objects.UpdatePublicationUpdate:
+			if oldPublication, exist := mapOldPublication[p.Data.Name]; exist {
+				reverse.ChangePublicationItems = append(reverse.ChangePublicationItems, objects.UpdatePublicationItem{Data: oldPublication, Type: objects.UpdatePublicationUpdate})
+			}
+		}
+	}
+
+	return oldTable, reverse
+}
+
+func buildColumnsQuery(newTable objects.Table, updateItem objects.UpdateTableParam) (sqlArr []string, err error) {
+	mapNewColumn := make(map[string]objects.Column)
+	for _, c := range newTable.Columns {
+		mapNewColumn[c.Name] = getQueryColumn(c, newTable)
+	}
+
+	mapOldColumn := make(map[string]objects.Column)
+	for _, c := range updateItem.OldData.Columns {
+		mapOldColumn[c.Name] = getQueryColumn(c, updateItem.OldData)
+	}
+
+	mapPrimaryKey := make(map[string]bool)
+	for _, pk := range newTable.PrimaryKeys {
+		mapPrimaryKey[pk.Name] = true
+	}
+
+	for _, cu := range updateItem.ChangeColumnItems {
+		newColumn, oldColumn := mapNewColumn[cu.Name], mapOldColumn[cu.GetOldName()]
+
+		var isCreate, isUpdate, isDelete bool
+		for _, ut := range cu.UpdateItems {
+			switch ut {
+			case objects.UpdateColumnNew:
+				isCreate = true
+			case objects.UpdateColumnDelete:
+				isDelete = true
+			case objects.UpdateColumnName, objects.UpdateColumnDataType, objects.UpdateColumnUnique, objects.UpdateColumnNullable, objects.UpdateColumnDefaultValue, objects.UpdateColumnIdentity,
+				objects.UpdateColumnCheck, objects.UpdateColumnComment, objects.UpdateColumnGenerated:
+				isUpdate = true
+			}
+		}
+
+		if isCreate {
+			createSql, err := query.BuildCreateColumnQuery(newColumn, mapPrimaryKey[newColumn.Name])
+			if err != nil {
+				return sqlArr, err
+			}
+			sqlArr = append(sqlArr, createSql)
+		}
+
+		if isUpdate {
+			updateSql, err := query.BuildUpdateColumnQuery(oldColumn, newColumn, cu)
+			if err != nil {
+				return sqlArr, err
+			}
+			sqlArr = append(sqlArr, updateSql)
+		}
+
+		if isDelete {
+			sqlArr = append(sqlArr, query.BuildDeleteColumnQuery(oldColumn))
+		}
+	}
+
+	return
+}
+
+func buildRelationsQuery(table objects.Table, items []objects.UpdateRelationItem, forceCreate bool) (string, error) {
+	relationMap := make(map[string]objects.TablesRelationship)
+	for _, r := range table.Relationships {
+		r.ConstraintName = getConstraintName(r)
+		relationMap[r.ConstraintName] = r
+	}
+
+	var sqlArr []string
+	appendQuery := func(updateType objects.UpdateRelationType, r objects.TablesRelationship) error {
+		fkSql, err := query.BuildFkQuery(updateType, &r)
+		if err != nil {
+			return err
+		}
+		sqlArr = append(sqlArr, fkSql)
+
+		// index is created by raiden when foreign key is created
+		if updateType == objects.UpdateRelationCreate && r.Index == nil || updateType == objects.UpdateRelationDelete && r.Index != nil {
+			indexSql, err := query.BuildFKIndexQuery(updateType, &r)
+			if err != nil {
+				return err
+			}
+			sqlArr = append(sqlArr, indexSql)
+		}
+		return nil
+	}
+
+	if forceCreate {
+		for _, r := range table.Relationships {
+			if err := appendQuery(objects.UpdateRelationCreate, relationMap[getConstraintName(r)]); err != nil {
+				return "", err
+			}
+		}
+		return strings.Join(sqlArr, "\n"), nil
+	}
+
+	for _, i := range items {
+		switch i.Type {
+		case objects.UpdateRelationCreate:
+			if r, exist := relationMap[i.Data.ConstraintName]; exist {
+				if err := appendQuery(objects.UpdateRelationCreate, r); err != nil {
+					return "", err
+				}
+			}
+		case objects.UpdateRelationUpdate, objects.UpdateRelationActionOnDelete, objects.UpdateRelationActionOnUpdate, objects.UpdateRelationCreateIndex:
+			if r, exist := relationMap[i.Data.ConstraintName]; exist {
+				if err := appendQuery(objects.UpdateRelationDelete, objects.TablesRelationship{
+					ConstraintName: r.ConstraintName, SourceSchema: r.SourceSchema, SourceTableName: r.SourceTableName,
+				}); err != nil {
+					return "", err
+				}
+
+				if err := appendQuery(objects.UpdateRelationCreate, r); err != nil {
+					return "", err
+				}
+			}
+		case objects.UpdateRelationDelete:
+			if err := appendQuery(objects.UpdateRelationDelete, i.Data); err != nil {
+				return "", err
+			}
+		}
+	}
+
+	return strings.Join(sqlArr, "\n"), nil
+}
+
+func getConstraintName(r objects.TablesRelationship) string {
+	if r.ConstraintName != "" {
+		return r.ConstraintName
+	}
+	return fmt.Sprintf("%s_%s_%s_fkey", r.SourceSchema, r.SourceTableName, r.SourceColumnName)
+}
+
+func getQueryTable(table objects.Table) objects.Table {
+	if table.Schema == "" {
+		table.Schema = "public"
+	}
+	return table
+}
+
+func getQueryColumn(column objects.Column, table objects.Table) objects.Column {
+	if column.Schema == "" {
+		column.Schema = table.Schema
+	}
+
+	if column.Table == "" {
+		column.Table = table.Name
+	}
+	return column
+}
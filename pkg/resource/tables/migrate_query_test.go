@@ -0,0 +1,240 @@
+package tables_test
+
+import (
+	"testing"
+
+	"github.com/sev-2/raiden/pkg/resource/migrator"
+	"github.com/sev-2/raiden/pkg/resource/tables"
+	"github.com/sev-2/raiden/pkg/supabase/objects"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBuildMigrateQuery(t *testing.T) {
+	table := objects.Table{
+		Schema: "public",
+		Name:   "posts",
+		Columns: []objects.Column{
+			{Schema: "public", Table: "posts", Name: "id", DataType: "bigint"},
+			{Schema: "public", Table: "posts", Name: "title", DataType: "text", IsNullable: true},
+		},
+		PrimaryKeys: []objects.PrimaryKey{{Schema: "public", TableName: "posts", Name: "id"}},
+	}
+
+	up, down, err := tables.BuildMigrateQuery(tables.MigrateItem{Type: migrator.MigrateTypeCreate, NewData: table})
+	assert.NoError(t, err)
+	assert.Contains(t, up, "CREATE TABLE IF NOT EXISTS")
+	assert.Equal(t, "DROP TABLE public.posts CASCADE;", down)
+
+	up, down, err = tables.BuildMigrateQuery(tables.MigrateItem{Type: migrator.MigrateTypeDelete, OldData: table})
+	assert.NoError(t, err)
+	assert.Equal(t, "DROP TABLE public.posts CASCADE;", up)
+	assert.Contains(t, down, "CREATE TABLE IF NOT EXISTS")
+
+	newTable := table
+	newTable.Columns = append(newTable.Columns, objects.Column{Schema: "public", Table: "posts", Name: "body", DataType: "text", IsNullable: true})
+	up, down, err = tables.BuildMigrateQuery(tables.MigrateItem{
+		Type:    migrator.MigrateTypeUpdate,
+		NewData: newTable,
+		OldData: table,
+		MigrationItems: objects.UpdateTableParam{
+			OldData: table,
+			ChangeColumnItems: []objects.UpdateColumnItem{
+				{Name: "body", UpdateItems: []objects.UpdateColumnType{objects.UpdateColumnNew}},
+			},
+		},
+	})
+	assert.NoError(t, err)
+	assert.Contains(t, up, "ADD COLUMN")
+	assert.Contains(t, down, "DROP COLUMN")
+}
+
+func TestBuildMigrateQuery_CheckCommentAndGenerated(t *testing.T) {
+	table := objects.Table{
+		Schema:  "public",
+		Name:    "products",
+		Comment: "list of product",
+		Columns: []objects.Column{
+			{Schema: "public", Table: "products", Name: "id", DataType: "bigint"},
+			{Schema: "public", Table: "products", Name: "price", DataType: "numeric", Check: "price > 0", Comment: "price in rupiah"},
+			{Schema: "public", Table: "products", Name: "total", DataType: "numeric", IsNullable: true, IsGenerated: true, DefaultValue: "price * 2"},
+		},
+		PrimaryKeys: []objects.PrimaryKey{{Schema: "public", TableName: "products", Name: "id"}},
+	}
+
+	up, _, err := tables.BuildMigrateQuery(tables.MigrateItem{Type: migrator.MigrateTypeCreate, NewData: table})
+	assert.NoError(t, err)
+	assert.Contains(t, up, "CHECK (price > 0)")
+	assert.Contains(t, up, "GENERATED ALWAYS AS (price * 2) STORED")
+	assert.Contains(t, up, "COMMENT ON TABLE public.products IS 'list of product';")
+	assert.Contains(t, up, "COMMENT ON COLUMN public.products.price IS 'price in rupiah';")
+
+	newTable := table
+	newTable.Comment = nil
+	newTable.Columns = []objects.Column{
+		table.Columns[0],
+		{Schema: "public", Table: "products", Name: "price", DataType: "numeric", Check: "price >= 0"},
+		table.Columns[2],
+	}
+	up, _, err = tables.BuildMigrateQuery(tables.MigrateItem{
+		Type:    migrator.MigrateTypeUpdate,
+		NewData: newTable,
+		OldData: table,
+		MigrationItems: objects.UpdateTableParam{
+			OldData:     table,
+			ChangeItems: []objects.UpdateTableType{objects.UpdateTableComment},
+			ChangeColumnItems: []objects.UpdateColumnItem{
+				{Name: "price", UpdateItems: []objects.UpdateColumnType{objects.UpdateColumnCheck, objects.UpdateColumnComment}},
+			},
+		},
+	})
+	assert.NoError(t, err)
+	assert.Contains(t, up, "ADD CONSTRAINT products_price_check CHECK (price >= 0)")
+	assert.Contains(t, up, "COMMENT ON TABLE public.products IS NULL;")
+	assert.Contains(t, up, "COMMENT ON COLUMN public.products.price IS NULL;")
+
+	// generated column without expression is not silently skipped
+	newTable.Columns = []objects.Column{
+		table.Columns[0],
+		table.Columns[1],
+		{Schema: "public", Table: "products", Name: "total", DataType: "numeric", IsNullable: true, IsGenerated: true},
+	}
+	_, _, err = tables.BuildMigrateQuery(tables.MigrateItem{
+		Type:    migrator.MigrateTypeUpdate,
+		NewData: newTable,
+		OldData: table,
+		MigrationItems: objects.UpdateTableParam{
+			OldData: table,
+			ChangeColumnItems: []objects.UpdateColumnItem{
+				{Name: "total", UpdateItems: []objects.UpdateColumnType{objects.UpdateColumnGenerated}},
+			},
+		},
+	})
+	assert.ErrorContains(t, err, "does not have generation expression")
+}
+
+func TestBuildMigrateQuery_Rename(t *testing.T) {
+	oldTable := objects.Table{
+		Schema: "public",
+		Name:   "members",
+		Columns: []objects.Column{
+			{ID: "1.1", Schema: "public", Table: "members", Name: "id", DataType: "bigint"},
+			{ID: "1.2", Schema: "public", Table: "members", Name: "name", DataType: "text"},
+		},
+	}
+
+	newTable := objects.Table{
+		Schema: "public",
+		Name:   "member",
+		Columns: []objects.Column{
+			{ID: "1.1", Schema: "public", Table: "member", Name: "id", DataType: "bigint"},
+			{ID: "1.2", Schema: "public", Table: "member", Name: "full_name", DataType: "text"},
+		},
+	}
+
+	up, down, err := tables.BuildMigrateQuery(tables.MigrateItem{
+		Type:    migrator.MigrateTypeUpdate,
+		NewData: newTable,
+		OldData: oldTable,
+		MigrationItems: objects.UpdateTableParam{
+			OldData:     oldTable,
+			ChangeItems: []objects.UpdateTableType{objects.UpdateTableName},
+			ChangeColumnItems: []objects.UpdateColumnItem{
+				{Name: "full_name", OldName: "name", UpdateItems: []objects.UpdateColumnType{objects.UpdateColumnName}},
+			},
+		},
+	})
+	assert.NoError(t, err)
+	assert.Contains(t, up, "ALTER TABLE public.members RENAME TO member;")
+	assert.Contains(t, up, "ALTER TABLE public.member RENAME COLUMN name TO full_name;")
+	assert.NotContains(t, up, "DROP")
+	assert.Contains(t, down, "ALTER TABLE public.member RENAME TO members;")
+	assert.Contains(t, down, "ALTER TABLE public.members RENAME COLUMN full_name TO name;")
+}
+
+func TestBuildRelationMigrateQuery(t *testing.T) {
+	table := objects.Table{
+		Schema: "public",
+		Name:   "comments",
+		Relationships: []objects.TablesRelationship{
+			{
+				SourceSchema:      "public",
+				SourceTableName:   "comments",
+				SourceColumnName:  "post_id",
+				TargetTableSchema: "public",
+				TargetTableName:   "posts",
+				TargetColumnName:  "id",
+			},
+		},
+	}
+
+	up, down, err := tables.BuildRelationMigrateQuery(tables.MigrateItem{Type: migrator.MigrateTypeCreate, NewData: table})
+	assert.NoError(t, err)
+	assert.Contains(t, up, "FOREIGN KEY")
+	assert.Contains(t, down, "DROP CONSTRAINT")
+
+	up, down, err = tables.BuildRelationMigrateQuery(tables.MigrateItem{Type: migrator.MigrateTypeUpdate, NewData: table})
+	assert.NoError(t, err)
+	assert.Empty(t, up)
+	assert.Empty(t, down)
+
+	relation := table.Relationships[0]
+	relation.ConstraintName = "public_comments_post_id_fkey"
+	table.Relationships[0] = relation
+	up, down, err = tables.BuildRelationMigrateQuery(tables.MigrateItem{
+		Type:    migrator.MigrateTypeUpdate,
+		NewData: table,
+		MigrationItems: objects.UpdateTableParam{
+			OldData:             objects.Table{Schema: "public", Name: "comments"},
+			ChangeRelationItems: []objects.UpdateRelationItem{{Data: relation, Type: objects.UpdateRelationCreate}},
+		},
+	})
+	assert.NoError(t, err)
+	assert.Contains(t, up, "FOREIGN KEY")
+	assert.Contains(t, down, "DROP CONSTRAINT")
+
+	// relation change is not included in table update query
+	up, _, err = tables.BuildMigrateQuery(tables.MigrateItem{
+		Type:    migrator.MigrateTypeUpdate,
+		NewData: table,
+		MigrationItems: objects.UpdateTableParam{
+			OldData:             objects.Table{Schema: "public", Name: "comments"},
+			ChangeRelationItems: []objects.UpdateRelationItem{{Data: relation, Type: objects.UpdateRelationCreate}},
+		},
+	})
+	assert.NoError(t, err)
+	assert.NotContains(t, up, "FOREIGN KEY")
+}
+
+func TestReverseUpdateParam(t *testing.T) {
+	oldTable := objects.Table{
+		Name:         "posts",
+		Indexes:      []objects.Index{{Name: "posts_title_idx", Columns: []string{"title"}}},
+		Publications: []objects.TablePublication{{Name: "supabase_realtime"}},
+	}
+	newTable := objects.Table{
+		Name:         "posts",
+		Indexes:      []objects.Index{{Name: "posts_title_idx", Columns: []string{"title", "body"}}},
+		Publications: []objects.TablePublication{{Name: "supabase_realtime", Columns: []string{"id"}}},
+	}
+
+	reverseTable, reverseParam := tables.ReverseUpdateParam(newTable, objects.UpdateTableParam{
+		OldData: oldTable,
+		ChangeColumnItems: []objects.UpdateColumnItem{
+			{Name: "body", UpdateItems: []objects.UpdateColumnType{objects.UpdateColumnNew}},
+		},
+		ChangeIndexItems: []objects.UpdateIndexItem{
+			{Data: newTable.Indexes[0], Type: objects.UpdateIndexUpdate},
+			{Data: objects.Index{Name: "posts_body_idx"}, Type: objects.UpdateIndexCreate},
+		},
+		ChangePublicationItems: []objects.UpdatePublicationItem{
+			{Data: newTable.Publications[0], Type: objects.UpdatePublicationUpdate},
+		},
+	})
+
+	assert.Equal(t, oldTable, reverseTable)
+	assert.Equal(t, newTable, reverseParam.OldData)
+	assert.Equal(t, []objects.UpdateColumnType{objects.UpdateColumnDelete}, reverseParam.ChangeColumnItems[0].UpdateItems)
+	assert.Equal(t, oldTable.Indexes[0], reverseParam.ChangeIndexItems[0].Data)
+	assert.Equal(t, objects.UpdateIndexDelete, reverseParam.ChangeIndexItems[1].Type)
+	assert.Equal(t, oldTable.Publications[0], reverseParam.ChangePublicationItems[0].Data)
+}
@@ -0,0 +1,214 @@
+package tables
+
+import (
+	"fmt"
+
+	"github.com/sev-2/raiden/pkg/postgres"
+	"github.com/sev-2/raiden/pkg/resource/migrator"
+	"github.com/sev-2/raiden/pkg/supabase/objects"
+)
+
+// GetPlanChanges convert migrate item to plan change,
+// ignored item is not included. deleting table and column is marked
+// as destructive and changing column data type that can't be casted
+// safely is marked as lossy
+func GetPlanChanges(items []MigrateItem) (changes []migrator.PlanChange) {
+	for i := range items {
+		item := items[i]
+		if item.Type == migrator.MigrateTypeIgnore {
+			continue
+		}
+
+		data := item.NewData
+		if item.Type == migrator.MigrateTypeDelete {
+			data = item.OldData
+		}
+
+		change := migrator.NewPlanChange("table", fmt.Sprintf("%s.%s", data.Schema, data.Name), item)
+		if item.Type == migrator.MigrateTypeDelete {
+			change.SetRisk(migrator.RiskLevelDestructive)
+		}
+
+		if item.Type == migrator.MigrateTypeUpdate {
+			appendTablePlanDiffs(&change, item)
+		}
+		changes = append(changes, change)
+	}
+	return
+}
+
+func appendTablePlanDiffs(change *migrator.PlanChange, item MigrateItem) {
+	oldData, newData := item.OldData, item.NewData
+	for _, c := range item.MigrationItems.ChangeItems {
+		switch c {
+		case objects.UpdateTableSchema:
+			change.AddDiff(string(c), oldData.Schema, newData.Schema)
+		case objects.UpdateTableName:
+			change.AddDiff(string(c), oldData.Name, newData.Name)
+		case objects.UpdateTableRlsEnable:
+			change.AddDiff(string(c), oldData.RLSEnabled, newData.RLSEnabled)
+		case objects.UpdateTableRlsForced:
+			change.AddDiff(string(c), oldData.RLSForced, newData.RLSForced)
+		case objects.UpdateTablePrimaryKey:
+			change.AddDiff(string(c), oldData.PrimaryKeys, newData.PrimaryKeys)
+		case objects.UpdateTableReplicaIdentity:
+			change.AddDiff(string(c), oldData.ReplicaIdentity, newData.ReplicaIdentity)
+		case objects.UpdateTableComment:
+			change.AddDiff(string(c), oldData.Comment, newData.Comment)
+		}
+	}
+
+	mapOldColumn, mapNewColumn := make(map[string]objects.Column), make(map[string]objects.Column)
+	for _, c := range oldData.Columns {
+		mapOldColumn[c.Name] = c
+	}
+	for _, c := range newData.Columns {
+		mapNewColumn[c.Name] = c
+	}
+
+	for _, ci := range item.MigrationItems.ChangeColumnItems {
+		oldColumn, newColumn := mapOldColumn[ci.GetOldName()], mapNewColumn[ci.Name]
+		field := fmt.Sprintf("columns.%s", ci.Name)
+		for _, u := range ci.UpdateItems {
+			switch u {
+			case objects.UpdateColumnNew:
+				change.AddDiff(field, nil, newColumn.DataType)
+			case objects.UpdateColumnDelete:
+				change.SetRisk(migrator.RiskLevelDestructive)
+				change.AddDiff(field, oldColumn.DataType, nil)
+			case objects.UpdateColumnName:
+				change.AddDiff(field+"."+string(u), oldColumn.Name, newColumn.Name)
+			case objects.UpdateColumnDefaultValue:
+				change.AddDiff(field+"."+string(u), oldColumn.DefaultValue, newColumn.DefaultValue)
+			case objects.UpdateColumnDataType:
+				if !postgres.IsSafeCast(postgres.DataType(oldColumn.DataType), postgres.DataType(newColumn.DataType)) {
+					change.SetRisk(migrator.RiskLevelLossy)
+				}
+				change.AddDiff(field+"."+string(u), oldColumn.DataType, newColumn.DataType)
+			case objects.UpdateColumnUnique:
+				change.AddDiff(field+"."+string(u), oldColumn.IsUnique, newColumn.IsUnique)
+			case objects.UpdateColumnNullable:
+				change.AddDiff(field+"."+string(u), oldColumn.IsNullable, newColumn.IsNullable)
+			case objects.UpdateColumnIdentity:
+				change.AddDiff(field+"."+string(u), oldColumn.IsIdentity, newColumn.IsIdentity)
+			case objects.UpdateColumnCheck:
+				change.AddDiff(field+"."+string(u), oldColumn.Check, newColumn.Check)
+			case objects.UpdateColumnComment:
+				change.AddDiff(field+"."+string(u), oldColumn.Comment, newColumn.Comment)
+			case objects.UpdateColumnGenerated:
+				// column is recreated when changed to generated column, so existing value is dropped
+				if !oldColumn.IsGenerated {
+					change.SetRisk(migrator.RiskLevelDestructive)
+				}
+				change.AddDiff(field+"."+string(u), getGeneratedExpression(oldColumn), getGeneratedExpression(newColumn))
+			}
+		}
+	}
+
+	mapOldRelation := make(map[string]objects.TablesRelationship)
+	for _, r := range oldData.Relationships {
+		mapOldRelation[r.ConstraintName] = r
+	}
+
+	for _, ri := range item.MigrationItems.ChangeRelationItems {
+		field := fmt.Sprintf("relations.%s", ri.Data.ConstraintName)
+		switch ri.Type {
+		case objects.UpdateRelationCreate:
+			change.AddDiff(field, nil, getRelationTarget(ri.Data))
+		case objects.UpdateRelationDelete:
+			change.AddDiff(field, getRelationTarget(ri.Data), nil)
+		case objects.UpdateRelationUpdate:
+			change.AddDiff(field, getRelationTarget(mapOldRelation[ri.Data.ConstraintName]), getRelationTarget(ri.Data))
+		case objects.UpdateRelationActionOnUpdate, objects.UpdateRelationActionOnDelete:
+			var oldAction, newAction any
+			oldRelation, isExist := mapOldRelation[ri.Data.ConstraintName]
+			if ri.Type == objects.UpdateRelationActionOnUpdate {
+				if isExist && oldRelation.Action != nil {
+					oldAction = oldRelation.Action.UpdateAction
+				}
+				if ri.Data.Action != nil {
+					newAction = ri.Data.Action.UpdateAction
+				}
+			} else {
+				if isExist && oldRelation.Action != nil {
+					oldAction = oldRelation.Action.DeletionAction
+				}
+				if ri.Data.Action != nil {
+					newAction = ri.Data.Action.DeletionAction
+				}
+			}
+			change.AddDiff(field+"."+string(ri.Type), oldAction, newAction)
+		case objects.UpdateRelationCreateIndex:
+			change.AddDiff(field+"."+string(ri.Type), nil, ri.Data.SourceColumnName)
+		}
+	}
+
+	mapOldIndex := make(map[string]objects.Index)
+	for _, idx := range oldData.Indexes {
+		mapOldIndex[idx.Name] = idx
+	}
+
+	for _, ii := range item.MigrationItems.ChangeIndexItems {
+		field := fmt.Sprintf("indexes.%s", ii.Data.Name)
+		switch ii.Type {
+		case objects.UpdateIndexCreate:
+			change.AddDiff(field, nil, ii.Data.Definition)
+		case objects.UpdateIndexUpdate:
+			change.AddDiff(field, mapOldIndex[ii.Data.Name].Definition, ii.Data.Definition)
+		case objects.UpdateIndexDelete:
+			change.AddDiff(field, ii.Data.Definition, nil)
+		}
+	}
+
+	for _, pi := range item.MigrationItems.ChangePublicationItems {
+		field := fmt.Sprintf("publications.%s", pi.Data.Name)
+		switch pi.Type {
+		case objects.UpdatePublicationAdd:
+			change.AddDiff(field, nil, pi.Data)
+		case objects.UpdatePublicationUpdate:
+			var oldPublication any
+			for _, p := range oldData.Publications {
+				if p.Name == pi.Data.Name {
+					oldPublication = p
+				}
+			}
+			change.AddDiff(field, oldPublication, pi.Data)
+		case objects.UpdatePublicationDrop:
+			change.AddDiff(field, pi.Data, nil)
+		}
+	}
+}
+
+func getRelationTarget(r objects.TablesRelationship) string {
+	if r.ConstraintName == "" {
+		return ""
+	}
+	return fmt.Sprintf("%s.%s(%s) -> %s.%s(%s)", r.SourceSchema, r.SourceTableName, r.SourceColumnName, r.TargetTableSchema, r.TargetTableName, r.TargetColumnName)
+}
+
+// GetComparePlanChanges convert conflicted compare result to update plan change,
+// source resource is used as new data and target resource as old data
+func GetComparePlanChanges(diffResult []CompareDiffResult) []migrator.PlanChange {
+	var items []MigrateItem
+	for i := range diffResult {
+		d := diffResult[i]
+		if !d.IsConflict {
+			continue
+		}
+
+		items = append(items, MigrateItem{
+			Type:           migrator.MigrateTypeUpdate,
+			NewData:        d.SourceResource,
+			OldData:        d.TargetResource,
+			MigrationItems: d.DiffItems,
+		})
+	}
+	return GetPlanChanges(items)
+}
+
+func getGeneratedExpression(column objects.Column) any {
+	if !column.IsGenerated {
+		return nil
+	}
+	return getStringValue(column.DefaultValue)
+}
@@ -0,0 +1,107 @@
+package tables_test
+
+import (
+	"testing"
+
+	"github.com/sev-2/raiden/pkg/resource/migrator"
+	"github.com/sev-2/raiden/pkg/resource/tables"
+	"github.com/sev-2/raiden/pkg/supabase/objects"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGetPlanChanges(t *testing.T) {
+	oldTable := objects.Table{
+		Schema: "public",
+		Name:   "posts",
+		Columns: []objects.Column{
+			{Name: "id", DataType: "bigint"},
+			{Name: "title", DataType: "text"},
+			{Name: "views", DataType: "integer"},
+		},
+	}
+	newTable := objects.Table{
+		Schema:     "public",
+		Name:       "posts",
+		RLSEnabled: true,
+		Columns: []objects.Column{
+			{Name: "id", DataType: "bigint"},
+			{Name: "title", DataType: "varchar"},
+			{Name: "slug", DataType: "text"},
+		},
+	}
+
+	changes := tables.GetPlanChanges([]tables.MigrateItem{
+		{
+			Type:    migrator.MigrateTypeUpdate,
+			OldData: oldTable,
+			NewData: newTable,
+			MigrationItems: objects.UpdateTableParam{
+				ChangeItems: []objects.UpdateTableType{objects.UpdateTableRlsEnable},
+				ChangeColumnItems: []objects.UpdateColumnItem{
+					{Name: "title", UpdateItems: []objects.UpdateColumnType{objects.UpdateColumnDataType}},
+					{Name: "slug", UpdateItems: []objects.UpdateColumnType{objects.UpdateColumnNew}},
+					{Name: "views", UpdateItems: []objects.UpdateColumnType{objects.UpdateColumnDelete}},
+				},
+				ChangeIndexItems: []objects.UpdateIndexItem{
+					{Type: objects.UpdateIndexCreate, Data: objects.Index{Name: "posts_slug_idx", Definition: "CREATE INDEX posts_slug_idx ON public.posts USING btree (slug)"}},
+				},
+			},
+		},
+		{Type: migrator.MigrateTypeIgnore, NewData: objects.Table{Schema: "public", Name: "ignored"}},
+	})
+
+	assert.Len(t, changes, 1)
+	assert.Equal(t, "table", changes[0].Kind)
+	assert.Equal(t, "public.posts", changes[0].Name)
+	assert.Equal(t, migrator.RiskLevelDestructive, changes[0].Risk)
+	assert.True(t, changes[0].Destructive)
+	assert.Equal(t, []migrator.PlanDiff{
+		{Field: "rls_enable", Old: false, New: true},
+		{Field: "columns.title.data_type", Old: "text", New: "varchar"},
+		{Field: "columns.slug", Old: nil, New: "text"},
+		{Field: "columns.views", Old: "integer", New: nil},
+		{Field: "indexes.posts_slug_idx", Old: nil, New: "CREATE INDEX posts_slug_idx ON public.posts USING btree (slug)"},
+	}, changes[0].Diffs)
+}
+
+func TestGetPlanChanges_ColumnDataType(t *testing.T) {
+	newItem := func(oldType, newType string) tables.MigrateItem {
+		return tables.MigrateItem{
+			Type:    migrator.MigrateTypeUpdate,
+			OldData: objects.Table{Schema: "public", Name: "posts", Columns: []objects.Column{{Name: "views", DataType: oldType}}},
+			NewData: objects.Table{Schema: "public", Name: "posts", Columns: []objects.Column{{Name: "views", DataType: newType}}},
+			MigrationItems: objects.UpdateTableParam{
+				ChangeColumnItems: []objects.UpdateColumnItem{
+					{Name: "views", UpdateItems: []objects.UpdateColumnType{objects.UpdateColumnDataType}},
+				},
+			},
+		}
+	}
+
+	changes := tables.GetPlanChanges([]tables.MigrateItem{newItem("integer", "bigint"), newItem("bigint", "integer")})
+	assert.Len(t, changes, 2)
+	assert.Equal(t, migrator.RiskLevelSafe, changes[0].Risk)
+	assert.Equal(t, migrator.RiskLevelLossy, changes[1].Risk)
+	assert.False(t, changes[1].Destructive)
+
+	changes = tables.GetPlanChanges([]tables.MigrateItem{{Type: migrator.MigrateTypeDelete, OldData: objects.Table{Schema: "public", Name: "posts"}}})
+	assert.Equal(t, migrator.RiskLevelDestructive, changes[0].Risk)
+	assert.True(t, changes[0].Destructive)
+}
+
+func TestGetComparePlanChanges(t *testing.T) {
+	changes := tables.GetComparePlanChanges([]tables.CompareDiffResult{
+		{
+			SourceResource: objects.Table{Schema: "public", Name: "posts", RLSForced: true},
+			TargetResource: objects.Table{Schema: "public", Name: "posts"},
+			DiffItems:      objects.UpdateTableParam{ChangeItems: []objects.UpdateTableType{objects.UpdateTableRlsForced}},
+			IsConflict:     true,
+		},
+		{SourceResource: objects.Table{Schema: "public", Name: "same"}},
+	})
+
+	assert.Len(t, changes, 1)
+	assert.Equal(t, migrator.MigrateTypeUpdate, changes[0].Action)
+	assert.False(t, changes[0].Destructive)
+	assert.Equal(t, []migrator.PlanDiff{{Field: "rls_forced", Old: false, New: true}}, changes[0].Diffs)
+}
@@ -108,15 +108,22 @@ func GenerateDiffMessage(diffData CompareDiffResult, sRelation MapRelations, tRe
 
 	// start generate message
 	if len(diffData.DiffItems.ChangeItems) > 0 {
+		// table name is printed when table is renamed
+		var tTableName, sTableName string
+		if diffData.SourceResource.Name != diffData.TargetResource.Name {
+			tTableName = fmt.Sprintf(" tableName:\"%s\"", diffData.TargetResource.Name)
+			sTableName = fmt.Sprintf(" tableName:\"%s\"", diffData.SourceResource.Name)
+		}
+
 		tMetadata := fmt.Sprintf(
-			"%s %s Metadata string `json:\"-\" schema:\"%s\" rlsEnable:\"%t\" rlsForced:\"%t\"`",
-			symbol, fromIndent, diffData.TargetResource.Schema,
+			"%s %s Metadata string `json:\"-\" schema:\"%s\"%s rlsEnable:\"%t\" rlsForced:\"%t\"`",
+			symbol, fromIndent, diffData.TargetResource.Schema, tTableName,
 			diffData.TargetResource.RLSEnabled, diffData.TargetResource.RLSForced,
 		)
 
 		sMetadata := fmt.Sprintf(
-			"%s %s Metadata string `json:\"-\" schema:\"%s\" rlsEnable:\"%t\" rlsForced:\"%t\"`",
-			symbol, toIndent, diffData.SourceResource.Schema,
+			"%s %s Metadata string `json:\"-\" schema:\"%s\"%s rlsEnable:\"%t\" rlsForced:\"%t\"`",
+			symbol, toIndent, diffData.SourceResource.Schema, sTableName,
 			diffData.SourceResource.RLSEnabled, diffData.SourceResource.RLSForced,
 		)
 
@@ -145,7 +152,7 @@ func GenerateDiffMessage(diffData CompareDiffResult, sRelation MapRelations, tRe
 
 			for fi := range mapTColumns {
 				c := mapTColumns[fi]
-				if changeColumn.Name == c.Name {
+				if changeColumn.GetOldName() == c.Name {
 					foundTColumn = c
 					break
 				}
@@ -402,6 +409,18 @@ const DiffChangeUpdateTemplate = `  - Update Table {{ .Name }}
       {{.}}
       {{- end }}
   {{- end -}}
+  {{- if gt (len .ChangeIndexes) 0}}
+      Change Indexes
+      {{- range .ChangeIndexes}}
+      {{.}}
+      {{- end }}
+  {{- end -}}
+  {{- if gt (len .ChangePublications) 0}}
+      Change Publications
+      {{- range .ChangePublications}}
+      {{.}}
+      {{- end }}
+  {{- end -}}
   `
 
 func GenerateDiffChangeMessage(newTable []string, updateTable []string, deleteTable []string) (string, error) {
@@ -428,20 +447,22 @@ func GenerateDiffChangeMessage(newTable []string, updateTable []string, deleteTa
 func GenerateDiffChangeUpdateMessage(name string, item MigrateItem) (string, error) {
 	diffItems := item.MigrationItems
 
-	var changeMsgArr, changeColumnMsgArr, changeRelationArr []string
+	var changeMsgArr, changeColumnMsgArr, changeRelationArr, changeIndexArr, changePublicationArr []string
 	for i := range diffItems.ChangeItems {
 		c := diffItems.ChangeItems[i]
 		switch c {
 		case objects.UpdateTableSchema:
 			changeMsgArr = append(changeMsgArr, fmt.Sprintf("- %s : %s >>> %s", "schema", item.OldData.Schema, item.NewData.Schema))
 		case objects.UpdateTableName:
-			changeMsgArr = append(changeMsgArr, fmt.Sprintf("- %s : %s >>> %s", "name", item.OldData.Name, item.NewData.Name))
+			changeMsgArr = append(changeMsgArr, fmt.Sprintf("- %s : %s >>> %s", "rename table", item.OldData.Name, item.NewData.Name))
 		case objects.UpdateTableRlsEnable:
 			changeMsgArr = append(changeMsgArr, fmt.Sprintf("- %s : %t >>> %t", "rls enable", item.OldData.RLSEnabled, item.NewData.RLSEnabled))
 		case objects.UpdateTableRlsForced:
 			changeMsgArr = append(changeMsgArr, fmt.Sprintf("- %s : %t >>> %t", "rls forced", item.OldData.RLSForced, item.NewData.RLSForced))
 		case objects.UpdateTableReplicaIdentity:
 			changeMsgArr = append(changeMsgArr, fmt.Sprintf("- %s : %s >>> %s", "replica identity", item.OldData.ReplicaIdentity, item.NewData.ReplicaIdentity))
+		case objects.UpdateTableComment:
+			changeMsgArr = append(changeMsgArr, fmt.Sprintf("- %s : %q >>> %q", "comment", getStringValue(item.OldData.Comment), getStringValue(item.NewData.Comment)))
 		}
 	}
 
@@ -450,10 +471,10 @@ func GenerateDiffChangeUpdateMessage(name string, item MigrateItem) (string, err
 
 		var oldColumn, newColum objects.Column
 
-		// find old column detail
+		// find old column detail, renamed column is found by old name
 		for ii := range item.OldData.Columns {
 			oc := item.OldData.Columns[ii]
-			if oc.Name == c.Name {
+			if oc.Name == c.GetOldName() {
 				oldColumn = oc
 			}
 		}
@@ -475,7 +496,7 @@ func GenerateDiffChangeUpdateMessage(name string, item MigrateItem) (string, err
 			case objects.UpdateColumnDelete:
 				updateItemArr = append(updateItemArr, fmt.Sprintf("- %s : %s", "delete column", oldColumn.Name))
 			case objects.UpdateColumnName:
-				updateItemArr = append(updateItemArr, fmt.Sprintf("- %s : %s >>> %s", "name", oldColumn.Name, newColum.Name))
+				updateItemArr = append(updateItemArr, fmt.Sprintf("- %s : %s >>> %s", "rename column", oldColumn.Name, newColum.Name))
 			case objects.UpdateColumnDefaultValue:
 				var oldValue, newValue = "nil", "nil"
 				if oldColumn.DefaultValue != nil {
@@ -505,6 +526,20 @@ func GenerateDiffChangeUpdateMessage(name string, item MigrateItem) (string, err
 				updateItemArr = append(updateItemArr, fmt.Sprintf("- %s : %t >>> %t", "is nullable", oldColumn.IsNullable, newColum.IsNullable))
 			case objects.UpdateColumnIdentity:
 				updateItemArr = append(updateItemArr, fmt.Sprintf("- %s : %t >>> %t", "is identity", oldColumn.IsIdentity, newColum.IsIdentity))
+			case objects.UpdateColumnCheck:
+				updateItemArr = append(updateItemArr, fmt.Sprintf("- %s : %q >>> %q", "check", getStringValue(oldColumn.Check), getStringValue(newColum.Check)))
+			case objects.UpdateColumnComment:
+				updateItemArr = append(updateItemArr, fmt.Sprintf("- %s : %q >>> %q", "comment", getStringValue(oldColumn.Comment), getStringValue(newColum.Comment)))
+			case objects.UpdateColumnGenerated:
+				var oldValue, newValue = "nil", "nil"
+				if oldColumn.IsGenerated {
+					oldValue = getStringValue(oldColumn.DefaultValue)
+				}
+
+				if newColum.IsGenerated {
+					newValue = getStringValue(newColum.DefaultValue)
+				}
+				updateItemArr = append(updateItemArr, fmt.Sprintf("- %s : %v >>> %v", "generated", oldValue, newValue))
 			}
 		}
 
@@ -561,11 +596,39 @@ func GenerateDiffChangeUpdateMessage(name string, item MigrateItem) (string, err
 		}
 	}
 
+	for i := range diffItems.ChangeIndexItems {
+		c := diffItems.ChangeIndexItems[i]
+
+		switch c.Type {
+		case objects.UpdateIndexCreate:
+			changeIndexArr = append(changeIndexArr, fmt.Sprintf("- %s : %s", "create new index", c.Data.Name))
+		case objects.UpdateIndexUpdate:
+			changeIndexArr = append(changeIndexArr, fmt.Sprintf("- %s : %s", "update index", c.Data.Name))
+		case objects.UpdateIndexDelete:
+			changeIndexArr = append(changeIndexArr, fmt.Sprintf("- %s : %s", "delete index", c.Data.Name))
+		}
+	}
+
+	for i := range diffItems.ChangePublicationItems {
+		c := diffItems.ChangePublicationItems[i]
+
+		switch c.Type {
+		case objects.UpdatePublicationAdd:
+			changePublicationArr = append(changePublicationArr, fmt.Sprintf("- %s : %s", "add to publication", c.Data.Name))
+		case objects.UpdatePublicationUpdate:
+			changePublicationArr = append(changePublicationArr, fmt.Sprintf("- %s : %s", "update publication column and row filter", c.Data.Name))
+		case objects.UpdatePublicationDrop:
+			changePublicationArr = append(changePublicationArr, fmt.Sprintf("- %s : %s", "drop from publication", c.Data.Name))
+		}
+	}
+
 	param := map[string]any{
-		"Name":            name,
-		"ChangeItems":     changeMsgArr,
-		"ChangeColumns":   changeColumnMsgArr,
-		"ChangeRelations": changeRelationArr,
+		"Name":               name,
+		"ChangeItems":        changeMsgArr,
+		"ChangeColumns":      changeColumnMsgArr,
+		"ChangeRelations":    changeRelationArr,
+		"ChangeIndexes":      changeIndexArr,
+		"ChangePublications": changePublicationArr,
 	}
 
 	tmplInstance := template.New("generate diff change update")
@@ -417,7 +417,21 @@ func TestGenerateDiffChangeUpdateMessage(t *testing.T) {
 
 	diffMessage, err := tables.GenerateDiffChangeUpdateMessage("test_table", item)
 	assert.NoError(t, err)
-	assert.Contains(t, diffMessage, fmt.Sprintf("- %s : %s >>> %s", "name", item.OldData.Name, item.NewData.Name))
+	assert.Contains(t, diffMessage, fmt.Sprintf("- %s : %s >>> %s", "rename table", item.OldData.Name, item.NewData.Name))
+
+	item = tables.MigrateItem{
+		NewData: objects.Table{Name: "member", Columns: []objects.Column{{Name: "full_name"}}},
+		OldData: objects.Table{Name: "member", Columns: []objects.Column{{Name: "name"}}},
+		MigrationItems: objects.UpdateTableParam{
+			ChangeColumnItems: []objects.UpdateColumnItem{
+				{Name: "full_name", OldName: "name", UpdateItems: []objects.UpdateColumnType{objects.UpdateColumnName}},
+			},
+		},
+	}
+
+	diffMessage, err = tables.GenerateDiffChangeUpdateMessage("member", item)
+	assert.NoError(t, err)
+	assert.Contains(t, diffMessage, "- rename column : name >>> full_name")
 
 	item = tables.MigrateItem{
 		NewData: objects.Table{Schema: "private"},
@@ -485,4 +499,42 @@ func TestGenerateDiffChangeUpdateMessage(t *testing.T) {
 	assert.Contains(t, diffMessage, "is unique")
 	assert.Contains(t, diffMessage, "is nullable")
 	assert.Contains(t, diffMessage, "is identity")
+
+	item = tables.MigrateItem{
+		NewData: objects.Table{Name: "test_table"},
+		OldData: objects.Table{Name: "test_table"},
+		MigrationItems: objects.UpdateTableParam{
+			ChangeIndexItems: []objects.UpdateIndexItem{
+				{Data: objects.Index{Name: "idx_test_table_name"}, Type: objects.UpdateIndexCreate},
+				{Data: objects.Index{Name: "idx_test_table_email"}, Type: objects.UpdateIndexUpdate},
+				{Data: objects.Index{Name: "idx_test_table_age"}, Type: objects.UpdateIndexDelete},
+			},
+		},
+	}
+
+	diffMessage, err = tables.GenerateDiffChangeUpdateMessage("test_table", item)
+	assert.NoError(t, err)
+	assert.Contains(t, diffMessage, "Change Indexes")
+	assert.Contains(t, diffMessage, "- create new index : idx_test_table_name")
+	assert.Contains(t, diffMessage, "- update index : idx_test_table_email")
+	assert.Contains(t, diffMessage, "- delete index : idx_test_table_age")
+
+	item = tables.MigrateItem{
+		NewData: objects.Table{Name: "test_table"},
+		OldData: objects.Table{Name: "test_table"},
+		MigrationItems: objects.UpdateTableParam{
+			ChangePublicationItems: []objects.UpdatePublicationItem{
+				{Data: objects.TablePublication{Name: "supabase_realtime"}, Type: objects.UpdatePublicationAdd},
+				{Data: objects.TablePublication{Name: "audit"}, Type: objects.UpdatePublicationUpdate},
+				{Data: objects.TablePublication{Name: "legacy"}, Type: objects.UpdatePublicationDrop},
+			},
+		},
+	}
+
+	diffMessage, err = tables.GenerateDiffChangeUpdateMessage("test_table", item)
+	assert.NoError(t, err)
+	assert.Contains(t, diffMessage, "Change Publications")
+	assert.Contains(t, diffMessage, "- add to publication : supabase_realtime")
+	assert.Contains(t, diffMessage, "- update publication column and row filter : audit")
+	assert.Contains(t, diffMessage, "- drop from publication : legacy")
 }
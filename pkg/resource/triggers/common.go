@@ -0,0 +1,21 @@
+package triggers
+
+import (
+	"github.com/sev-2/raiden/pkg/state"
+	"github.com/sev-2/raiden/pkg/supabase/objects"
+)
+
+func GetNewCountData(supabaseData []objects.Trigger, localData state.ExtractTriggerResult) int {
+	var newCount int
+
+	mapData := localData.ToDeleteFlatMap()
+	for i := range supabaseData {
+		r := supabaseData[i]
+
+		if _, exist := mapData[state.GetTriggerKey(r)]; exist {
+			newCount++
+		}
+	}
+
+	return newCount
+}
@@ -0,0 +1,33 @@
+package triggers_test
+
+import (
+	"testing"
+
+	"github.com/sev-2/raiden/pkg/resource/triggers"
+	"github.com/sev-2/raiden/pkg/state"
+	"github.com/sev-2/raiden/pkg/supabase/objects"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGetNewCountData(t *testing.T) {
+	supabaseTriggers := []objects.Trigger{
+		{Name: "trigger1", Schema: "public", Table: "users"},
+		{Name: "trigger1", Schema: "public", Table: "profiles"},
+		{Name: "trigger2", Schema: "public", Table: "users"},
+	}
+
+	extractResult := state.ExtractTriggerResult{
+		Delete: []objects.Trigger{
+			{Name: "trigger1", Schema: "public", Table: "users"},
+			{Name: "trigger3", Schema: "public", Table: "users"},
+		},
+	}
+
+	count := triggers.GetNewCountData(supabaseTriggers, extractResult)
+	assert.Equal(t, 1, count)
+}
+
+func TestGetNewCountDataEmpty(t *testing.T) {
+	count := triggers.GetNewCountData([]objects.Trigger{}, state.ExtractTriggerResult{})
+	assert.Equal(t, 0, count)
+}
@@ -0,0 +1,197 @@
+package triggers
+
+import (
+	"strings"
+	"unicode"
+
+	"github.com/sev-2/raiden/pkg/state"
+	"github.com/sev-2/raiden/pkg/supabase/objects"
+)
+
+type CompareDiffResult struct {
+	Name           string
+	SourceResource objects.Trigger
+	TargetResource objects.Trigger
+	DiffItems      objects.UpdateTriggerParam
+	IsConflict     bool
+}
+
+func Compare(source []objects.Trigger, target []objects.Trigger) error {
+	diffResult, err := CompareList(source, target)
+	if err != nil {
+		return err
+	}
+	return PrintDiffResult(diffResult)
+}
+
+func CompareList(sourceTrigger, targetTrigger []objects.Trigger) (diffResult []CompareDiffResult, err error) {
+	mapTargetTriggers := make(map[string]objects.Trigger)
+	for i := range targetTrigger {
+		r := targetTrigger[i]
+		mapTargetTriggers[state.GetTriggerKey(r)] = r
+	}
+
+	for i := range sourceTrigger {
+		r := sourceTrigger[i]
+
+		tr, isExist := mapTargetTriggers[state.GetTriggerKey(r)]
+		if !isExist {
+			continue
+		}
+
+		diffResult = append(diffResult, CompareItem(r, tr))
+	}
+
+	return
+}
+
+func CompareItem(source, target objects.Trigger) (diffResult CompareDiffResult) {
+	var updateItem objects.UpdateTriggerParam
+
+	// assign diff result object
+	diffResult.Name = source.Name
+	diffResult.SourceResource = source
+	diffResult.TargetResource = target
+
+	if source.Name != target.Name {
+		updateItem.ChangeItems = append(updateItem.ChangeItems, objects.UpdateTriggerName)
+	}
+
+	if source.Schema != target.Schema {
+		updateItem.ChangeItems = append(updateItem.ChangeItems, objects.UpdateTriggerSchema)
+	}
+
+	if source.Table != target.Table {
+		updateItem.ChangeItems = append(updateItem.ChangeItems, objects.UpdateTriggerTable)
+	}
+
+	if !strings.EqualFold(source.Activation, target.Activation) {
+		updateItem.ChangeItems = append(updateItem.ChangeItems, objects.UpdateTriggerActivation)
+	}
+
+	if !strings.EqualFold(source.Orientation, target.Orientation) {
+		updateItem.ChangeItems = append(updateItem.ChangeItems, objects.UpdateTriggerOrientation)
+	}
+
+	if !isSameList(source.Events, target.Events) {
+		updateItem.ChangeItems = append(updateItem.ChangeItems, objects.UpdateTriggerEvents)
+	}
+
+	var sourceCondition, targetCondition string
+	if source.Condition != nil {
+		sourceCondition = *source.Condition
+	}
+
+	if target.Condition != nil {
+		targetCondition = *target.Condition
+	}
+
+	if normalizeCondition(sourceCondition) != normalizeCondition(targetCondition) {
+		updateItem.ChangeItems = append(updateItem.ChangeItems, objects.UpdateTriggerCondition)
+	}
+
+	if source.FunctionName != target.FunctionName || source.FunctionSchema != target.FunctionSchema {
+		updateItem.ChangeItems = append(updateItem.ChangeItems, objects.UpdateTriggerFunction)
+	}
+
+	if strings.Join(source.FunctionArgs, ",") != strings.Join(target.FunctionArgs, ",") {
+		updateItem.ChangeItems = append(updateItem.ChangeItems, objects.UpdateTriggerFunctionArgs)
+	}
+
+	if source.EnabledMode != "" && target.EnabledMode != "" && !strings.EqualFold(source.EnabledMode, target.EnabledMode) {
+		updateItem.ChangeItems = append(updateItem.ChangeItems, objects.UpdateTriggerEnabledMode)
+	}
+
+	updateItem.OldData = target
+	diffResult.IsConflict = len(updateItem.ChangeItems) > 0
+	diffResult.DiffItems = updateItem
+
+	return
+}
+
+// isSameList compare two list without considering the order and letter case
+func isSameList(source, target []string) bool {
+	if len(source) != len(target) {
+		return false
+	}
+
+	mapTarget := make(map[string]bool)
+	for _, t := range target {
+		mapTarget[strings.ToUpper(t)] = true
+	}
+
+	for _, s := range source {
+		if _, exist := mapTarget[strings.ToUpper(s)]; !exist {
+			return false
+		}
+	}
+
+	return true
+}
+
+// normalizeCondition remove wrapper parentheses, whitespace and letter case difference,
+// postgres always return condition wrapped with parentheses and lowercase identifier.
+// string literal and quoted identifier is kept as is because the letter case is significant
+func normalizeCondition(condition string) string {
+	c := strings.TrimSpace(condition)
+	for isWrappedByParentheses(c) {
+		c = strings.TrimSpace(c[1 : len(c)-1])
+	}
+
+	var (
+		sb      strings.Builder
+		quote   rune
+		isSpace bool
+	)
+	for _, ch := range c {
+		switch {
+		case quote != 0:
+			if ch == quote {
+				quote = 0
+			}
+		case ch == '\'' || ch == '"':
+			quote = ch
+		case unicode.IsSpace(ch):
+			isSpace = true
+			continue
+		default:
+			ch = unicode.ToLower(ch)
+		}
+
+		if isSpace {
+			sb.WriteRune(' ')
+			isSpace = false
+		}
+		sb.WriteRune(ch)
+	}
+	return sb.String()
+}
+
+// isWrappedByParentheses check if the first parenthesis is closed by the last character,
+// parenthesis inside string literal or quoted identifier is ignored
+func isWrappedByParentheses(s string) bool {
+	if !strings.HasPrefix(s, "(") || !strings.HasSuffix(s, ")") {
+		return false
+	}
+
+	depth := 0
+	var quote rune
+	for i, ch := range s {
+		switch {
+		case quote != 0:
+			if ch == quote {
+				quote = 0
+			}
+		case ch == '\'' || ch == '"':
+			quote = ch
+		case ch == '(':
+			depth++
+		case ch == ')':
+			depth--
+			if depth == 0 && i < len(s)-1 {
+				return false
+			}
+		}
+	}
+	return depth == 0
+}
@@ -0,0 +1,112 @@
+package triggers_test
+
+import (
+	"testing"
+
+	"github.com/sev-2/raiden/pkg/resource/triggers"
+	"github.com/sev-2/raiden/pkg/supabase/objects"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCompare(t *testing.T) {
+	condition := "(old.* IS DISTINCT FROM new.*)"
+	source := []objects.Trigger{
+		{
+			Name:           "set_updated_at",
+			Schema:         "public",
+			Table:          "users",
+			Activation:     "BEFORE",
+			Events:         []string{"UPDATE", "INSERT"},
+			Orientation:    "ROW",
+			Condition:      &condition,
+			FunctionName:   "handle_updated_at",
+			FunctionSchema: "public",
+			EnabledMode:    "ORIGIN",
+		},
+	}
+
+	targetCondition := "OLD.* IS DISTINCT FROM NEW.*"
+	target := []objects.Trigger{
+		{
+			Name:           "set_updated_at",
+			Schema:         "public",
+			Table:          "users",
+			Activation:     "before",
+			Events:         []string{"INSERT", "UPDATE"},
+			Orientation:    "ROW",
+			Condition:      &targetCondition,
+			FunctionName:   "handle_updated_at",
+			FunctionSchema: "public",
+			EnabledMode:    "ORIGIN",
+		},
+	}
+
+	err := triggers.Compare(source, target)
+	assert.NoError(t, err)
+}
+
+func TestCompareList(t *testing.T) {
+	source := []objects.Trigger{
+		{Name: "trigger1", Schema: "public", Table: "users", Activation: "BEFORE", Events: []string{"UPDATE"}},
+		{Name: "trigger1", Schema: "public", Table: "profiles", Activation: "AFTER", Events: []string{"INSERT"}},
+		{Name: "trigger2", Schema: "public", Table: "users"},
+	}
+
+	target := []objects.Trigger{
+		{Name: "trigger1", Schema: "public", Table: "users", Activation: "AFTER", Events: []string{"UPDATE"}},
+		{Name: "trigger1", Schema: "public", Table: "profiles", Activation: "AFTER", Events: []string{"INSERT"}},
+	}
+
+	diffResult, err := triggers.CompareList(source, target)
+	assert.NoError(t, err)
+	assert.Equal(t, 2, len(diffResult))
+	assert.True(t, diffResult[0].IsConflict)
+	assert.Equal(t, []objects.UpdateTriggerType{objects.UpdateTriggerActivation}, diffResult[0].DiffItems.ChangeItems)
+	assert.False(t, diffResult[1].IsConflict)
+}
+
+func TestCompareItem(t *testing.T) {
+	source := objects.Trigger{
+		Name:           "trigger1",
+		Schema:         "public",
+		Table:          "users",
+		Events:         []string{"INSERT"},
+		FunctionName:   "audit",
+		FunctionSchema: "public",
+		FunctionArgs:   []string{"users"},
+	}
+
+	target := objects.Trigger{
+		Name:           "trigger1",
+		Schema:         "public",
+		Table:          "users",
+		Events:         []string{"INSERT", "DELETE"},
+		FunctionName:   "audit_v2",
+		FunctionSchema: "public",
+	}
+
+	diffResult := triggers.CompareItem(source, target)
+	assert.True(t, diffResult.IsConflict)
+	assert.Contains(t, diffResult.DiffItems.ChangeItems, objects.UpdateTriggerEvents)
+	assert.Contains(t, diffResult.DiffItems.ChangeItems, objects.UpdateTriggerFunction)
+	assert.Contains(t, diffResult.DiffItems.ChangeItems, objects.UpdateTriggerFunctionArgs)
+	assert.Equal(t, "audit_v2", diffResult.DiffItems.OldData.FunctionName)
+}
+
+func TestCompareItem_ConditionLiteral(t *testing.T) {
+	sourceCondition, targetCondition := "NEW.status = 'Active'", "(new.status = 'active')"
+	source := objects.Trigger{Name: "trigger1", Schema: "public", Table: "users", Condition: &sourceCondition}
+	target := objects.Trigger{Name: "trigger1", Schema: "public", Table: "users", Condition: &targetCondition}
+
+	diffResult := triggers.CompareItem(source, target)
+	assert.True(t, diffResult.IsConflict)
+	assert.Equal(t, []objects.UpdateTriggerType{objects.UpdateTriggerCondition}, diffResult.DiffItems.ChangeItems)
+
+	targetCondition = "(NEW.status   =  'Active')"
+	diffResult = triggers.CompareItem(source, target)
+	assert.False(t, diffResult.IsConflict)
+
+	sourceCondition, targetCondition = "NEW.name = 'A  (b'", "(new.name = 'a  (b')"
+	diffResult = triggers.CompareItem(source, target)
+	assert.True(t, diffResult.IsConflict)
+}
@@ -0,0 +1,121 @@
+package triggers
+
+import (
+	"github.com/sev-2/raiden"
+	"github.com/sev-2/raiden/pkg/connector/pgmeta"
+	"github.com/sev-2/raiden/pkg/resource/migrator"
+	"github.com/sev-2/raiden/pkg/state"
+	"github.com/sev-2/raiden/pkg/supabase"
+	"github.com/sev-2/raiden/pkg/supabase/objects"
+)
+
+type MigrateItem = migrator.MigrateItem[objects.Trigger, objects.UpdateTriggerParam]
+type MigrateActionFunc = migrator.MigrateActionFunc[objects.Trigger, objects.UpdateTriggerParam]
+
+var ActionFunc = MigrateActionFunc{
+	CreateFunc: func(cfg *raiden.Config, param objects.Trigger) (response objects.Trigger, err error) {
+		if cfg.Mode == raiden.SvcMode {
+			return pgmeta.CreateTrigger(cfg, param)
+		}
+		return supabase.CreateTrigger(cfg, param)
+	},
+	UpdateFunc: func(cfg *raiden.Config, param objects.Trigger, items objects.UpdateTriggerParam) (err error) {
+		if cfg.Mode == raiden.SvcMode {
+			return pgmeta.UpdateTrigger(cfg, param, items)
+		}
+		return supabase.UpdateTrigger(cfg, param, items)
+	},
+	DeleteFunc: func(cfg *raiden.Config, param objects.Trigger) (err error) {
+		if cfg.Mode == raiden.SvcMode {
+			return pgmeta.DeleteTrigger(cfg, param)
+		}
+		return supabase.DeleteTrigger(cfg, param)
+	},
+}
+
+func BuildMigrateData(extractedLocalData state.ExtractTriggerResult, supabaseData []objects.Trigger) (migrateData []MigrateItem, err error) {
+	Logger.Info("start build migrate trigger data")
+	if rs, err := BuildMigrateItem(supabaseData, extractedLocalData.Existing); err != nil {
+		return migrateData, err
+	} else {
+		migrateData = append(migrateData, rs...)
+	}
+
+	// bind new trigger to migrated data
+	Logger.Debug("filter new trigger data")
+	mapSupabaseTrigger := make(map[string]objects.Trigger)
+	for i := range supabaseData {
+		st := supabaseData[i]
+		mapSupabaseTrigger[state.GetTriggerKey(st)] = st
+	}
+
+	if len(extractedLocalData.New) > 0 {
+		for i := range extractedLocalData.New {
+			t := extractedLocalData.New[i]
+			if st, exist := mapSupabaseTrigger[state.GetTriggerKey(t)]; exist {
+				// trigger already exist in database but not in state,
+				// recreate it with latest definition
+				t.ID = st.ID
+				migrateData = append(migrateData, MigrateItem{
+					Type:           migrator.MigrateTypeUpdate,
+					NewData:        t,
+					OldData:        st,
+					MigrationItems: objects.UpdateTriggerParam{OldData: st},
+				})
+				continue
+			}
+
+			migrateData = append(migrateData, MigrateItem{
+				Type:    migrator.MigrateTypeCreate,
+				NewData: t,
+			})
+		}
+	}
+
+	Logger.Debug("filter delete trigger data")
+	if len(extractedLocalData.Delete) > 0 {
+		for i := range extractedLocalData.Delete {
+			t := extractedLocalData.Delete[i]
+			if _, exist := mapSupabaseTrigger[state.GetTriggerKey(t)]; exist {
+				migrateData = append(migrateData, MigrateItem{
+					Type:    migrator.MigrateTypeDelete,
+					OldData: t,
+				})
+			}
+		}
+	}
+
+	Logger.Info("finish build migrate trigger data")
+	return
+}
+
+func BuildMigrateItem(supabaseData []objects.Trigger, localData []objects.Trigger) (migratedData []MigrateItem, err error) {
+	Logger.Info("compare supabase and local resource for existing trigger data")
+	result, e := CompareList(localData, supabaseData)
+	if e != nil {
+		err = e
+		return
+	}
+
+	for i := range result {
+		r := result[i]
+
+		migrateType := migrator.MigrateTypeIgnore
+		if r.IsConflict {
+			migrateType = migrator.MigrateTypeUpdate
+		}
+
+		migratedData = append(migratedData, MigrateItem{
+			Type:           migrateType,
+			NewData:        r.SourceResource,
+			OldData:        r.TargetResource,
+			MigrationItems: r.DiffItems,
+		})
+	}
+
+	return
+}
+
+func Migrate(config *raiden.Config, triggers []MigrateItem, stateChan chan any, actions MigrateActionFunc) []error {
+	return migrator.MigrateResource(config, triggers, stateChan, actions, migrator.DefaultMigrator)
+}
@@ -0,0 +1,25 @@
+package triggers
+
+import (
+	"github.com/sev-2/raiden/pkg/resource/migrator"
+	"github.com/sev-2/raiden/pkg/supabase/objects"
+	"github.com/sev-2/raiden/pkg/supabase/query"
+)
+
+// BuildMigrateQuery build up and down sql of trigger migrate item
+func BuildMigrateQuery(item MigrateItem) (up string, down string, err error) {
+	switch item.Type {
+	case migrator.MigrateTypeCreate:
+		up, down = query.BuildCreateTriggerQuery(&item.NewData), query.BuildDeleteTriggerQuery(&item.NewData)
+	case migrator.MigrateTypeUpdate:
+		oldData := item.MigrationItems.OldData
+		if oldData.Name == "" {
+			oldData = item.OldData
+		}
+		up = query.BuildUpdateTriggerQuery(item.NewData, objects.UpdateTriggerParam{OldData: oldData, ChangeItems: item.MigrationItems.ChangeItems})
+		down = query.BuildUpdateTriggerQuery(oldData, objects.UpdateTriggerParam{OldData: item.NewData, ChangeItems: item.MigrationItems.ChangeItems})
+	case migrator.MigrateTypeDelete:
+		up, down = query.BuildDeleteTriggerQuery(&item.OldData), query.BuildCreateTriggerQuery(&item.OldData)
+	}
+	return
+}
@@ -0,0 +1,44 @@
+package triggers_test
+
+import (
+	"testing"
+
+	"github.com/sev-2/raiden/pkg/resource/migrator"
+	"github.com/sev-2/raiden/pkg/resource/triggers"
+	"github.com/sev-2/raiden/pkg/supabase/objects"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBuildMigrateQuery(t *testing.T) {
+	newTrigger := objects.Trigger{Name: "on_insert", Schema: "public", Table: "posts", Activation: "AFTER", Events: []string{"INSERT", "UPDATE"}, Orientation: "ROW", FunctionSchema: "public", FunctionName: "notify"}
+	oldTrigger := objects.Trigger{Name: "on_insert", Schema: "public", Table: "posts", Activation: "AFTER", Events: []string{"INSERT"}, Orientation: "ROW", FunctionSchema: "public", FunctionName: "notify"}
+
+	up, down, err := triggers.BuildMigrateQuery(triggers.MigrateItem{Type: migrator.MigrateTypeCreate, NewData: newTrigger})
+	assert.NoError(t, err)
+	assert.Contains(t, up, "CREATE TRIGGER")
+	assert.Contains(t, down, "DROP TRIGGER")
+
+	up, down, err = triggers.BuildMigrateQuery(triggers.MigrateItem{
+		Type:           migrator.MigrateTypeUpdate,
+		NewData:        newTrigger,
+		OldData:        oldTrigger,
+		MigrationItems: objects.UpdateTriggerParam{OldData: oldTrigger},
+	})
+	assert.NoError(t, err)
+	assert.Contains(t, up, "INSERT OR UPDATE")
+	assert.NotContains(t, down, "INSERT OR UPDATE")
+
+	up, down, err = triggers.BuildMigrateQuery(triggers.MigrateItem{Type: migrator.MigrateTypeDelete, OldData: oldTrigger})
+	assert.NoError(t, err)
+	assert.Contains(t, up, "DROP TRIGGER")
+	assert.Contains(t, down, "CREATE TRIGGER")
+}
+
+func TestBuildMigrateQuery_QuotedIdentifierAndArgs(t *testing.T) {
+	trigger := objects.Trigger{Name: `on"insert`, Schema: "public", Table: "posts", Activation: "AFTER", Events: []string{"INSERT"}, Orientation: "ROW", FunctionSchema: "public", FunctionName: "notify", FunctionArgs: []string{"it's", "'); drop table posts; --"}, EnabledMode: "DISABLED"}
+
+	up, down, err := triggers.BuildMigrateQuery(triggers.MigrateItem{Type: migrator.MigrateTypeCreate, NewData: trigger})
+	assert.NoError(t, err)
+	assert.Equal(t, `CREATE TRIGGER "on""insert" AFTER INSERT ON "public"."posts" FOR EACH ROW EXECUTE FUNCTION "public"."notify"('it''s','''); drop table posts; --'); ALTER TABLE "public"."posts" DISABLE TRIGGER "on""insert";`, up)
+	assert.Equal(t, `DROP TRIGGER IF EXISTS "on""insert" ON "public"."posts";`, down)
+}
@@ -0,0 +1,85 @@
+package triggers_test
+
+import (
+	"testing"
+
+	"github.com/sev-2/raiden"
+	"github.com/sev-2/raiden/pkg/resource/migrator"
+	"github.com/sev-2/raiden/pkg/resource/triggers"
+	"github.com/sev-2/raiden/pkg/state"
+	"github.com/sev-2/raiden/pkg/supabase/objects"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBuildMigrateData(t *testing.T) {
+	extractedLocalData := state.ExtractTriggerResult{
+		New: []objects.Trigger{
+			{Name: "trigger_1", Table: "users"},
+			{Name: "trigger_5", Table: "users"},
+		},
+		Existing: []objects.Trigger{
+			{Name: "trigger_2", Table: "users", Activation: "BEFORE"},
+			{Name: "trigger_3", Table: "users"},
+		},
+		Delete: []objects.Trigger{
+			{Name: "trigger_4", Table: "users"},
+			{Name: "trigger_6", Table: "users"},
+		},
+	}
+
+	supabaseTriggers := []objects.Trigger{
+		{ID: 1, Name: "trigger_1", Schema: "public", Table: "users"},
+		{ID: 2, Name: "trigger_2", Schema: "public", Table: "users", Activation: "AFTER"},
+		{ID: 4, Name: "trigger_4", Schema: "public", Table: "users"},
+	}
+
+	migrateData, err := triggers.BuildMigrateData(extractedLocalData, supabaseTriggers)
+	assert.NoError(t, err)
+	assert.Equal(t, 4, len(migrateData))
+
+	assert.Equal(t, migrator.MigrateTypeUpdate, migrateData[0].Type)
+	assert.Equal(t, "trigger_2", migrateData[0].NewData.Name)
+
+	assert.Equal(t, migrator.MigrateTypeUpdate, migrateData[1].Type)
+	assert.Equal(t, 1, migrateData[1].NewData.ID)
+
+	assert.Equal(t, migrator.MigrateTypeCreate, migrateData[2].Type)
+	assert.Equal(t, "trigger_5", migrateData[2].NewData.Name)
+
+	assert.Equal(t, migrator.MigrateTypeDelete, migrateData[3].Type)
+	assert.Equal(t, "trigger_4", migrateData[3].OldData.Name)
+}
+
+func TestBuildMigrateItem(t *testing.T) {
+	localTriggers := []objects.Trigger{
+		{Name: "trigger_1", Table: "users", Activation: "BEFORE"},
+		{Name: "trigger_2", Table: "users"},
+	}
+
+	supabaseTriggers := []objects.Trigger{
+		{Name: "trigger_1", Table: "users", Activation: "BEFORE"},
+		{Name: "trigger_2", Table: "users", Activation: "AFTER"},
+	}
+
+	migrateData, err := triggers.BuildMigrateItem(supabaseTriggers, localTriggers)
+	assert.NoError(t, err)
+	assert.Equal(t, 2, len(migrateData))
+	assert.Equal(t, migrator.MigrateTypeIgnore, migrateData[0].Type)
+	assert.Equal(t, migrator.MigrateTypeUpdate, migrateData[1].Type)
+}
+
+func TestMigrate(t *testing.T) {
+	config := &raiden.Config{}
+	stateChan := make(chan any)
+	defer close(stateChan)
+
+	migrateItems := []triggers.MigrateItem{
+		{
+			Type:    migrator.MigrateTypeCreate,
+			NewData: objects.Trigger{Name: "trigger_1", Table: "users"},
+		},
+	}
+
+	errors := triggers.Migrate(config, migrateItems, stateChan, triggers.ActionFunc)
+	assert.Equal(t, 1, len(errors))
+}
@@ -0,0 +1,75 @@
+package triggers
+
+import (
+	"fmt"
+
+	"github.com/sev-2/raiden/pkg/resource/migrator"
+	"github.com/sev-2/raiden/pkg/supabase/objects"
+)
+
+// GetPlanChanges convert migrate item to plan change,
+// ignored item is not included
+func GetPlanChanges(items []MigrateItem) (changes []migrator.PlanChange) {
+	for i := range items {
+		item := items[i]
+		if item.Type == migrator.MigrateTypeIgnore {
+			continue
+		}
+
+		data := item.NewData
+		if item.Type == migrator.MigrateTypeDelete {
+			data = item.OldData
+		}
+
+		change := migrator.NewPlanChange("trigger", fmt.Sprintf("%s.%s.%s", data.Schema, data.Table, data.Name), item)
+		if item.Type == migrator.MigrateTypeUpdate {
+			oldData, newData := item.OldData, item.NewData
+			for _, c := range item.MigrationItems.ChangeItems {
+				switch c {
+				case objects.UpdateTriggerName:
+					change.AddDiff(string(c), oldData.Name, newData.Name)
+				case objects.UpdateTriggerSchema:
+					change.AddDiff(string(c), oldData.Schema, newData.Schema)
+				case objects.UpdateTriggerTable:
+					change.AddDiff(string(c), oldData.Table, newData.Table)
+				case objects.UpdateTriggerActivation:
+					change.AddDiff(string(c), oldData.Activation, newData.Activation)
+				case objects.UpdateTriggerEvents:
+					change.AddDiff(string(c), oldData.Events, newData.Events)
+				case objects.UpdateTriggerOrientation:
+					change.AddDiff(string(c), oldData.Orientation, newData.Orientation)
+				case objects.UpdateTriggerCondition:
+					change.AddDiff(string(c), oldData.Condition, newData.Condition)
+				case objects.UpdateTriggerFunction:
+					change.AddDiff(string(c), fmt.Sprintf("%s.%s", oldData.FunctionSchema, oldData.FunctionName), fmt.Sprintf("%s.%s", newData.FunctionSchema, newData.FunctionName))
+				case objects.UpdateTriggerFunctionArgs:
+					change.AddDiff(string(c), oldData.FunctionArgs, newData.FunctionArgs)
+				case objects.UpdateTriggerEnabledMode:
+					change.AddDiff(string(c), oldData.EnabledMode, newData.EnabledMode)
+				}
+			}
+		}
+		changes = append(changes, change)
+	}
+	return
+}
+
+// GetComparePlanChanges convert conflicted compare result to update plan change,
+// source resource is used as new data and target resource as old data
+func GetComparePlanChanges(diffResult []CompareDiffResult) []migrator.PlanChange {
+	var items []MigrateItem
+	for i := range diffResult {
+		d := diffResult[i]
+		if !d.IsConflict {
+			continue
+		}
+
+		items = append(items, MigrateItem{
+			Type:           migrator.MigrateTypeUpdate,
+			NewData:        d.SourceResource,
+			OldData:        d.TargetResource,
+			MigrationItems: d.DiffItems,
+		})
+	}
+	return GetPlanChanges(items)
+}
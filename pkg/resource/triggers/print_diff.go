@@ -0,0 +1,325 @@
+package triggers
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"strings"
+	"text/template"
+
+	"github.com/fatih/color"
+	"github.com/sev-2/raiden/pkg/resource/migrator"
+	"github.com/sev-2/raiden/pkg/supabase/objects"
+	"github.com/sev-2/raiden/pkg/utils"
+)
+
+// ----- print diff section -----
+type DiffType string
+
+const (
+	DiffTypeCreate DiffType = "create"
+	DiffTypeUpdate DiffType = "update"
+	DiffTypeDelete DiffType = "delete"
+)
+
+func PrintDiffResult(diffResult []CompareDiffResult) error {
+	if len(diffResult) == 0 {
+		return nil
+	}
+
+	isConflict := false
+	for i := range diffResult {
+		d := diffResult[i]
+		if d.IsConflict {
+			PrintDiff(d)
+			if !isConflict {
+				isConflict = true
+			}
+		}
+	}
+
+	if isConflict {
+		return errors.New("canceled import process, you have conflict in trigger. please fix it first")
+	}
+
+	return nil
+}
+
+func PrintDiff(diffData CompareDiffResult) {
+	if len(diffData.DiffItems.ChangeItems) == 0 {
+		return
+	}
+
+	fileName := utils.ToSnakeCase(fmt.Sprintf("%s_%s", diffData.TargetResource.Table, diffData.TargetResource.Name))
+	printScope := color.New(color.FgHiBlack).PrintfFunc()
+
+	changes := make([]string, 0)
+	for _, v := range diffData.DiffItems.ChangeItems {
+		value, changeValue := getChangeValue(v, diffData.TargetResource, diffData.SourceResource)
+		diffStr, err := GenerateDiffMessage(fileName, DiffTypeUpdate, v, value, changeValue)
+		if err != nil {
+			Logger.Error("print diff trigger error", "msg", err.Error())
+			continue
+		}
+		changes = append(changes, diffStr)
+	}
+
+	printScope("*** Found diff in %s/%s.go ***\n", "/internal/triggers", fileName)
+	fmt.Println(strings.Join(changes, ""))
+	printScope("*** End found diff ***\n")
+}
+
+func getChangeValue(updateType objects.UpdateTriggerType, oldData, newData objects.Trigger) (value string, changeValue string) {
+	switch updateType {
+	case objects.UpdateTriggerName:
+		return oldData.Name, newData.Name
+	case objects.UpdateTriggerSchema:
+		return oldData.Schema, newData.Schema
+	case objects.UpdateTriggerTable:
+		return oldData.Table, newData.Table
+	case objects.UpdateTriggerActivation:
+		return oldData.Activation, newData.Activation
+	case objects.UpdateTriggerOrientation:
+		return oldData.Orientation, newData.Orientation
+	case objects.UpdateTriggerEvents:
+		return strings.Join(oldData.Events, ","), strings.Join(newData.Events, ",")
+	case objects.UpdateTriggerCondition:
+		if oldData.Condition != nil {
+			value = *oldData.Condition
+		}
+		if newData.Condition != nil {
+			changeValue = *newData.Condition
+		}
+		return
+	case objects.UpdateTriggerFunction:
+		return fmt.Sprintf("%s.%s", oldData.FunctionSchema, oldData.FunctionName), fmt.Sprintf("%s.%s", newData.FunctionSchema, newData.FunctionName)
+	case objects.UpdateTriggerFunctionArgs:
+		return strings.Join(oldData.FunctionArgs, ","), strings.Join(newData.FunctionArgs, ",")
+	case objects.UpdateTriggerEnabledMode:
+		return oldData.EnabledMode, newData.EnabledMode
+	}
+	return
+}
+
+// ----- generate message section ------
+const DiffTemplate = ` 
+ {{- if or (eq .Type "create") (eq .Type "delete")}}
+ {{ .Symbol }} func (r *{{ .Name  | ToGoIdentifier }}) %s {
+ %s
+ {{ .Symbol }} }
+ {{- end}}
+ {{- if eq .Type "update"}}
+ func (r *{{ .Name | ToGoIdentifier }}) %s {
+   %s
+ }
+{{- end}}
+  `
+
+const FuncBodyTemplate = "{{ .Symbol }} return {{ .Value }}"
+const FuncBodyUpdateTemplate = "{{ .Symbol }} return {{ .Value }}  >>> {{ .ChangeValue }}"
+
+func buildDiffTemplate(funcDecl string, bodyTemplate string, updateBodyTemplate string) string {
+	if bodyTemplate == "" {
+		bodyTemplate = FuncBodyTemplate
+	}
+
+	if updateBodyTemplate == "" {
+		updateBodyTemplate = FuncBodyUpdateTemplate
+	}
+
+	return fmt.Sprintf(DiffTemplate, funcDecl, bodyTemplate, funcDecl, updateBodyTemplate)
+}
+
+func getDiffSymbol(diffType DiffType) string {
+	printAdd := color.New(color.FgHiGreen).SprintfFunc()
+	printRemove := color.New(color.FgHiRed).SprintfFunc()
+	printUpdate := color.New(color.FgHiYellow).SprintfFunc()
+
+	var symbol string
+	switch diffType {
+	case DiffTypeCreate:
+		symbol = printAdd("+")
+	case DiffTypeUpdate:
+		symbol = printUpdate("~")
+	case DiffTypeDelete:
+		symbol = printRemove("-")
+	}
+	return symbol
+}
+
+func GenerateDiffMessage(name string, diffType DiffType, updateType objects.UpdateTriggerType, value string, changeValue string) (string, error) {
+	param := map[string]any{
+		"Name":        name,
+		"Type":        diffType,
+		"Value":       value,
+		"ChangeValue": changeValue,
+		"Symbol":      getDiffSymbol(diffType),
+	}
+
+	tmplStr := ""
+	switch updateType {
+	case objects.UpdateTriggerName:
+		tmplStr = buildDiffTemplate("Name() string", "", "")
+	case objects.UpdateTriggerSchema:
+		tmplStr = buildDiffTemplate("Schema() string", "", "")
+	case objects.UpdateTriggerTable:
+		tmplStr = buildDiffTemplate("Table() string", "", "")
+	case objects.UpdateTriggerActivation:
+		tmplStr = buildDiffTemplate("Timing() raiden.TriggerTiming", "", "")
+	case objects.UpdateTriggerEvents:
+		tmplStr = buildDiffTemplate("Events() []raiden.TriggerEvent", "", "")
+	case objects.UpdateTriggerOrientation:
+		tmplStr = buildDiffTemplate("Orientation() raiden.TriggerOrientation", "", "")
+	case objects.UpdateTriggerCondition:
+		tmplStr = buildDiffTemplate("Condition() string", "", "")
+	case objects.UpdateTriggerFunction:
+		tmplStr = buildDiffTemplate("FunctionName() string", "", "")
+	case objects.UpdateTriggerFunctionArgs:
+		tmplStr = buildDiffTemplate("FunctionArgs() []string", "", "")
+	case objects.UpdateTriggerEnabledMode:
+		tmplStr = buildDiffTemplate("EnabledMode() raiden.TriggerEnabledMode", "", "")
+	default:
+		return "", errors.New("unsupported update type")
+	}
+
+	funcMaps := []template.FuncMap{
+		{"ToGoIdentifier": utils.SnakeCaseToPascalCase},
+	}
+
+	tmplInstance := template.New("generate diff")
+	for _, tm := range funcMaps {
+		tmplInstance.Funcs(tm)
+	}
+
+	tmpl, err := tmplInstance.Parse(tmplStr)
+	if err != nil {
+		return "", fmt.Errorf("error parsing : %v", err)
+	}
+
+	var buff bytes.Buffer
+	if err := tmpl.Execute(&buff, param); err != nil {
+		return "", err
+	}
+
+	return buff.String(), nil
+}
+
+// ----- diff change -----
+
+func GetDiffChangeMessage(items []MigrateItem) string {
+	newData := []string{}
+	deleteData := []string{}
+	updateData := []string{}
+
+	for i := range items {
+		item := items[i]
+
+		var name string
+		if item.NewData.Name != "" {
+			name = fmt.Sprintf("%s on %s", item.NewData.Name, item.NewData.Table)
+		} else if item.OldData.Name != "" {
+			name = fmt.Sprintf("%s on %s", item.OldData.Name, item.OldData.Table)
+		}
+
+		switch item.Type {
+		case migrator.MigrateTypeCreate:
+			newData = append(newData, fmt.Sprintf("- %s", name))
+		case migrator.MigrateTypeUpdate:
+			diffMessage, err := GenerateDiffChangeUpdateMessage(name, item)
+			if err != nil {
+				Logger.Error("print change trigger error", "msg", err.Error())
+				continue
+			}
+			updateData = append(updateData, diffMessage)
+		case migrator.MigrateTypeDelete:
+			deleteData = append(deleteData, fmt.Sprintf("- %s", name))
+		}
+	}
+
+	changeMsg, err := GenerateDiffChangeMessage(newData, updateData, deleteData)
+	if err != nil {
+		Logger.Error("print change trigger error", "msg", err.Error())
+		return ""
+	}
+	return changeMsg
+}
+
+const DiffChangeTemplate = `
+  {{- if gt (len .NewData) 0}}
+  New Trigger
+  {{- range .NewData}}
+  {{.}}
+  {{- end }}
+  {{- end -}}
+  {{- if gt (len .UpdateData) 0}}
+  Update Trigger
+  {{- range .UpdateData}}
+  {{.}}
+  {{- end }}
+  {{- end -}}
+  {{- if gt (len .DeleteData) 0}}
+  Delete Trigger
+  {{- range .DeleteData}}
+  {{.}}
+  {{- end }}
+  {{- end -}}
+  `
+
+func GenerateDiffChangeMessage(newData []string, updateData []string, deleteData []string) (string, error) {
+	param := map[string]any{
+		"NewData":    newData,
+		"UpdateData": updateData,
+		"DeleteData": deleteData,
+	}
+
+	tmplInstance := template.New("generate diff change trigger")
+	tmpl, err := tmplInstance.Parse(DiffChangeTemplate)
+	if err != nil {
+		return "", fmt.Errorf("error parsing : %v", err)
+	}
+
+	var buff bytes.Buffer
+	if err := tmpl.Execute(&buff, param); err != nil {
+		return "", err
+	}
+
+	return buff.String(), nil
+}
+
+const DiffChangeUpdateTemplate = `  - Update Trigger {{ .Name }}
+  {{- if gt (len .ChangeItems) 0}}
+      Change Configuration
+      {{- range .ChangeItems}}
+      {{.}}
+      {{- end }}
+  {{- end -}}
+  `
+
+func GenerateDiffChangeUpdateMessage(name string, item MigrateItem) (string, error) {
+	diffItems := item.MigrationItems
+
+	var changeMsgArr []string
+	for i := range diffItems.ChangeItems {
+		c := diffItems.ChangeItems[i]
+		oldValue, newValue := getChangeValue(c, item.OldData, item.NewData)
+		changeMsgArr = append(changeMsgArr, fmt.Sprintf("- %s : %v >>> %v", c, oldValue, newValue))
+	}
+
+	param := map[string]any{
+		"Name":        name,
+		"ChangeItems": changeMsgArr,
+	}
+
+	tmplInstance := template.New("generate diff change update")
+	tmpl, err := tmplInstance.Parse(DiffChangeUpdateTemplate)
+	if err != nil {
+		return "", fmt.Errorf("error parsing : %v", err)
+	}
+
+	var buff bytes.Buffer
+	if err := tmpl.Execute(&buff, param); err != nil {
+		return "", err
+	}
+
+	return buff.String(), nil
+}
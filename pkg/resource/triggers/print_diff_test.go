@@ -0,0 +1,63 @@
+package triggers_test
+
+import (
+	"testing"
+
+	"github.com/sev-2/raiden/pkg/resource/migrator"
+	"github.com/sev-2/raiden/pkg/resource/triggers"
+	"github.com/sev-2/raiden/pkg/supabase/objects"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPrintDiffResult(t *testing.T) {
+	diffResult := []triggers.CompareDiffResult{
+		{
+			IsConflict:     true,
+			SourceResource: objects.Trigger{Name: "trigger", Table: "users", Activation: "AFTER"},
+			TargetResource: objects.Trigger{Name: "trigger", Table: "users", Activation: "BEFORE"},
+			DiffItems: objects.UpdateTriggerParam{
+				ChangeItems: []objects.UpdateTriggerType{objects.UpdateTriggerActivation},
+			},
+		},
+	}
+
+	err := triggers.PrintDiffResult(diffResult)
+	assert.EqualError(t, err, "canceled import process, you have conflict in trigger. please fix it first")
+}
+
+func TestGetDiffChangeMessage(t *testing.T) {
+	items := []triggers.MigrateItem{
+		{
+			Type:    migrator.MigrateTypeCreate,
+			NewData: objects.Trigger{Name: "new_trigger", Table: "users"},
+		},
+		{
+			Type:    migrator.MigrateTypeUpdate,
+			NewData: objects.Trigger{Name: "update_trigger", Table: "users", Events: []string{"INSERT"}},
+			OldData: objects.Trigger{Name: "update_trigger", Table: "users", Events: []string{"UPDATE"}},
+			MigrationItems: objects.UpdateTriggerParam{
+				ChangeItems: []objects.UpdateTriggerType{objects.UpdateTriggerEvents},
+			},
+		},
+		{
+			Type:    migrator.MigrateTypeDelete,
+			OldData: objects.Trigger{Name: "delete_trigger", Table: "users"},
+		},
+	}
+
+	diffMessage := triggers.GetDiffChangeMessage(items)
+	assert.Contains(t, diffMessage, "New Trigger")
+	assert.Contains(t, diffMessage, "- new_trigger on users")
+	assert.Contains(t, diffMessage, "Update Trigger")
+	assert.Contains(t, diffMessage, "- events : UPDATE >>> INSERT")
+	assert.Contains(t, diffMessage, "Delete Trigger")
+}
+
+func TestGenerateDiffMessage(t *testing.T) {
+	diffMessage, err := triggers.GenerateDiffMessage("users_trigger", triggers.DiffTypeUpdate, objects.UpdateTriggerEvents, "INSERT", "UPDATE")
+	assert.NoError(t, err)
+	assert.Contains(t, diffMessage, "Events()")
+
+	_, err = triggers.GenerateDiffMessage("users_trigger", triggers.DiffTypeUpdate, objects.UpdateTriggerType("unknown"), "", "")
+	assert.Error(t, err)
+}
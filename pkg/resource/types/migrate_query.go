@@ -0,0 +1,28 @@
+package types
+
+import (
+	"github.com/sev-2/raiden/pkg/resource/migrator"
+	"github.com/sev-2/raiden/pkg/supabase/query"
+)
+
+// BuildMigrateQuery build up and down sql of type migrate item
+func BuildMigrateQuery(item MigrateItem) (up string, down string, err error) {
+	switch item.Type {
+	case migrator.MigrateTypeCreate:
+		if up, err = query.BuildTypeQuery(query.TypeActionCreate, &item.NewData); err != nil {
+			return
+		}
+		down, err = query.BuildTypeQuery(query.TypeActionDelete, &item.NewData)
+	case migrator.MigrateTypeUpdate:
+		if up, err = query.BuildTypeQuery(query.TypeActionUpdate, &item.NewData); err != nil {
+			return
+		}
+		down, err = query.BuildTypeQuery(query.TypeActionUpdate, &item.OldData)
+	case migrator.MigrateTypeDelete:
+		if up, err = query.BuildTypeQuery(query.TypeActionDelete, &item.OldData); err != nil {
+			return
+		}
+		down, err = query.BuildTypeQuery(query.TypeActionCreate, &item.OldData)
+	}
+	return
+}
@@ -0,0 +1,30 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/sev-2/raiden/pkg/resource/migrator"
+	"github.com/sev-2/raiden/pkg/resource/types"
+	"github.com/sev-2/raiden/pkg/supabase/objects"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBuildMigrateQuery(t *testing.T) {
+	newType := objects.Type{Schema: "public", Name: "status", Enums: []string{"active", "inactive", "banned"}}
+	oldType := objects.Type{Schema: "public", Name: "status", Enums: []string{"active", "inactive"}}
+
+	up, down, err := types.BuildMigrateQuery(types.MigrateItem{Type: migrator.MigrateTypeCreate, NewData: newType})
+	assert.NoError(t, err)
+	assert.Equal(t, "CREATE TYPE public.status AS ENUM ('active','inactive','banned');", up)
+	assert.Equal(t, "DROP TYPE IF EXISTS public.status CASCADE;", down)
+
+	up, down, err = types.BuildMigrateQuery(types.MigrateItem{Type: migrator.MigrateTypeUpdate, NewData: newType, OldData: oldType})
+	assert.NoError(t, err)
+	assert.Contains(t, up, "'banned'")
+	assert.NotContains(t, down, "'banned'")
+
+	up, down, err = types.BuildMigrateQuery(types.MigrateItem{Type: migrator.MigrateTypeDelete, OldData: oldType})
+	assert.NoError(t, err)
+	assert.Equal(t, "DROP TYPE IF EXISTS public.status CASCADE;", up)
+	assert.Equal(t, "CREATE TYPE public.status AS ENUM ('active','inactive');", down)
+}
@@ -0,0 +1,71 @@
+package types
+
+import (
+	"fmt"
+
+	"github.com/sev-2/raiden/pkg/resource/migrator"
+	"github.com/sev-2/raiden/pkg/supabase/objects"
+)
+
+// GetPlanChanges convert migrate item to plan change,
+// ignored item is not included
+func GetPlanChanges(items []MigrateItem) (changes []migrator.PlanChange) {
+	for i := range items {
+		item := items[i]
+		if item.Type == migrator.MigrateTypeIgnore {
+			continue
+		}
+
+		data := item.NewData
+		if item.Type == migrator.MigrateTypeDelete {
+			data = item.OldData
+		}
+
+		change := migrator.NewPlanChange("type", fmt.Sprintf("%s.%s", data.Schema, data.Name), item)
+		if item.Type == migrator.MigrateTypeDelete {
+			// type is dropped with cascade, column that use the type is dropped too
+			change.SetRisk(migrator.RiskLevelDestructive)
+		}
+		if item.Type == migrator.MigrateTypeUpdate {
+			oldData, newData := item.OldData, item.NewData
+			for _, c := range item.MigrationItems.ChangeItems {
+				switch c {
+				case objects.UpdateTypeName:
+					change.AddDiff(string(c), oldData.Name, newData.Name)
+				case objects.UpdateTypeSchema:
+					change.AddDiff(string(c), oldData.Schema, newData.Schema)
+				case objects.UpdateTypeFormat:
+					change.AddDiff(string(c), oldData.Format, newData.Format)
+				case objects.UpdateTypeEnums:
+					change.AddDiff(string(c), oldData.Enums, newData.Enums)
+				case objects.UpdateTypeAttributes:
+					change.AddDiff(string(c), oldData.Attributes, newData.Attributes)
+				case objects.UpdateTypeComment:
+					change.AddDiff(string(c), oldData.Comment, newData.Comment)
+				}
+			}
+		}
+		changes = append(changes, change)
+	}
+	return
+}
+
+// GetComparePlanChanges convert conflicted compare result to update plan change,
+// source resource is used as new data and target resource as old data
+func GetComparePlanChanges(diffResult []CompareDiffResult) []migrator.PlanChange {
+	var items []MigrateItem
+	for i := range diffResult {
+		d := diffResult[i]
+		if !d.IsConflict {
+			continue
+		}
+
+		items = append(items, MigrateItem{
+			Type:           migrator.MigrateTypeUpdate,
+			NewData:        d.SourceResource,
+			OldData:        d.TargetResource,
+			MigrationItems: d.DiffItems,
+		})
+	}
+	return GetPlanChanges(items)
+}
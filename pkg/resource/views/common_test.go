@@ -0,0 +1,33 @@
+package views_test
+
+import (
+	"testing"
+
+	"github.com/sev-2/raiden/pkg/resource/views"
+	"github.com/sev-2/raiden/pkg/state"
+	"github.com/sev-2/raiden/pkg/supabase/objects"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGetNewCountData(t *testing.T) {
+	supabaseViews := []objects.View{
+		{Name: "view1", Schema: "public"},
+		{Name: "view1", Schema: "private"},
+		{Name: "view2", Schema: "public"},
+	}
+
+	extractResult := state.ExtractViewResult{
+		Delete: []objects.View{
+			{Name: "view1", Schema: "public"},
+			{Name: "view3", Schema: "public"},
+		},
+	}
+
+	count := views.GetNewCountData(supabaseViews, extractResult)
+	assert.Equal(t, 1, count)
+}
+
+func TestGetNewCountDataEmpty(t *testing.T) {
+	count := views.GetNewCountData([]objects.View{}, state.ExtractViewResult{})
+	assert.Equal(t, 0, count)
+}
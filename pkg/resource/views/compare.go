@@ -0,0 +1,78 @@
+package views
+
+import (
+	"strings"
+
+	"github.com/sev-2/raiden/pkg/state"
+	"github.com/sev-2/raiden/pkg/supabase/objects"
+)
+
+type CompareDiffResult struct {
+	Name           string
+	SourceResource objects.View
+	TargetResource objects.View
+	DiffItems      objects.UpdateViewParam
+	IsConflict     bool
+}
+
+func Compare(source []objects.View, target []objects.View) error {
+	diffResult, err := CompareList(source, target)
+	if err != nil {
+		return err
+	}
+	return PrintDiffResult(diffResult)
+}
+
+func CompareList(sourceView, targetView []objects.View) (diffResult []CompareDiffResult, err error) {
+	mapTargetViews := make(map[string]objects.View)
+	for i := range targetView {
+		r := targetView[i]
+		mapTargetViews[state.GetViewKey(r)] = r
+	}
+
+	for i := range sourceView {
+		r := sourceView[i]
+
+		tr, isExist := mapTargetViews[state.GetViewKey(r)]
+		if !isExist {
+			continue
+		}
+
+		diffResult = append(diffResult, CompareItem(r, tr))
+	}
+
+	return
+}
+
+func CompareItem(source, target objects.View) (diffResult CompareDiffResult) {
+	var updateItem objects.UpdateViewParam
+
+	// assign diff result object
+	diffResult.Name = source.Name
+	diffResult.SourceResource = source
+	diffResult.TargetResource = target
+
+	if source.IsMaterialized != target.IsMaterialized {
+		updateItem.ChangeItems = append(updateItem.ChangeItems, objects.UpdateViewMaterialized)
+	}
+
+	if NormalizeDefinition(source.Definition) != NormalizeDefinition(target.Definition) {
+		updateItem.ChangeItems = append(updateItem.ChangeItems, objects.UpdateViewDefinition)
+	}
+
+	updateItem.OldData = target
+	diffResult.IsConflict = len(updateItem.ChangeItems) > 0
+	diffResult.DiffItems = updateItem
+
+	return
+}
+
+// NormalizeDefinition remove formatting difference between view definition
+// written in code and definition returned by pg_get_viewdef
+func NormalizeDefinition(definition string) string {
+	d := strings.TrimRight(strings.TrimSpace(definition), ";")
+	d = strings.Join(strings.Fields(strings.ReplaceAll(d, `"`, "")), " ")
+	d = strings.ReplaceAll(d, "( ", "(")
+	d = strings.ReplaceAll(d, " )", ")")
+	return strings.ToLower(d)
+}
@@ -0,0 +1,65 @@
+package views_test
+
+import (
+	"testing"
+
+	"github.com/sev-2/raiden"
+	"github.com/sev-2/raiden/pkg/resource/views"
+	"github.com/sev-2/raiden/pkg/supabase/objects"
+	"github.com/stretchr/testify/assert"
+)
+
+type MockView struct {
+	raiden.ViewBase
+}
+
+func (*MockView) Definition() string {
+	return "SELECT id FROM users"
+}
+
+type MockMaterializedView struct {
+	raiden.ViewBase
+}
+
+func (*MockMaterializedView) Definition() string {
+	return "SELECT count(*) FROM users"
+}
+
+func (*MockMaterializedView) Materialized() bool {
+	return true
+}
+
+func TestCompare(t *testing.T) {
+	source := []objects.View{
+		{Name: "view_1", Definition: "SELECT id FROM users"},
+	}
+
+	target := []objects.View{
+		{Name: "view_1", Definition: "SELECT id, name FROM users"},
+	}
+
+	err := views.Compare(source, target)
+	assert.Error(t, err)
+
+	err = views.Compare(source, source)
+	assert.NoError(t, err)
+}
+
+func TestCompareItem(t *testing.T) {
+	source := objects.View{Name: "view_1", Definition: "SELECT count(id) AS total FROM users"}
+	target := objects.View{Name: "view_1", Definition: ` SELECT count("users"."id") AS total
+   FROM users;`}
+
+	diffResult := views.CompareItem(source, target)
+	assert.True(t, diffResult.IsConflict)
+	assert.Equal(t, []objects.UpdateViewType{objects.UpdateViewDefinition}, diffResult.DiffItems.ChangeItems)
+	assert.Equal(t, target, diffResult.DiffItems.OldData)
+
+	target.Definition = " SELECT count( id ) AS total\n   FROM users;"
+	diffResult = views.CompareItem(source, target)
+	assert.False(t, diffResult.IsConflict)
+}
+
+func TestNormalizeDefinition(t *testing.T) {
+	assert.Equal(t, "select id from users", views.NormalizeDefinition(" SELECT \"id\"\n   FROM users;"))
+}
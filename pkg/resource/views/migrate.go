@@ -0,0 +1,219 @@
+package views
+
+import (
+	"fmt"
+	"regexp"
+	"sort"
+
+	"github.com/sev-2/raiden"
+	"github.com/sev-2/raiden/pkg/connector/pgmeta"
+	"github.com/sev-2/raiden/pkg/resource/migrator"
+	"github.com/sev-2/raiden/pkg/state"
+	"github.com/sev-2/raiden/pkg/supabase"
+	"github.com/sev-2/raiden/pkg/supabase/objects"
+)
+
+type MigrateItem = migrator.MigrateItem[objects.View, objects.UpdateViewParam]
+type MigrateActionFunc = migrator.MigrateActionFunc[objects.View, objects.UpdateViewParam]
+
+var ActionFunc = MigrateActionFunc{
+	CreateFunc: func(cfg *raiden.Config, param objects.View) (response objects.View, err error) {
+		if cfg.Mode == raiden.SvcMode {
+			return pgmeta.CreateView(cfg, param)
+		}
+		return supabase.CreateView(cfg, param)
+	},
+	UpdateFunc: func(cfg *raiden.Config, param objects.View, items objects.UpdateViewParam) (err error) {
+		if cfg.Mode == raiden.SvcMode {
+			return pgmeta.UpdateView(cfg, param, items)
+		}
+		return supabase.UpdateView(cfg, param, items)
+	},
+	DeleteFunc: func(cfg *raiden.Config, param objects.View) (err error) {
+		if cfg.Mode == raiden.SvcMode {
+			return pgmeta.DeleteView(cfg, param)
+		}
+		return supabase.DeleteView(cfg, param)
+	},
+}
+
+func BuildMigrateData(extractedLocalData state.ExtractViewResult, supabaseData []objects.View) (migrateData []MigrateItem, err error) {
+	Logger.Info("start build migrate view data")
+	if rs, err := BuildMigrateItem(supabaseData, extractedLocalData.Existing); err != nil {
+		return migrateData, err
+	} else {
+		migrateData = append(migrateData, rs...)
+	}
+
+	// bind new view to migrated data
+	Logger.Debug("filter new view data")
+	mapSupabaseView := make(map[string]objects.View)
+	for i := range supabaseData {
+		sv := supabaseData[i]
+		mapSupabaseView[state.GetViewKey(sv)] = sv
+	}
+
+	if len(extractedLocalData.New) > 0 {
+		for i := range extractedLocalData.New {
+			v := extractedLocalData.New[i]
+			if sv, exist := mapSupabaseView[state.GetViewKey(v)]; exist {
+				// view already exist in database but not in state,
+				// replace it with latest definition
+				v.ID = sv.ID
+				diffResult := CompareItem(v, sv)
+				migrateData = append(migrateData, MigrateItem{
+					Type:           migrator.MigrateTypeUpdate,
+					NewData:        v,
+					OldData:        sv,
+					MigrationItems: diffResult.DiffItems,
+				})
+				continue
+			}
+
+			migrateData = append(migrateData, MigrateItem{
+				Type:    migrator.MigrateTypeCreate,
+				NewData: v,
+			})
+		}
+	}
+
+	Logger.Debug("filter delete view data")
+	if len(extractedLocalData.Delete) > 0 {
+		for i := range extractedLocalData.Delete {
+			v := extractedLocalData.Delete[i]
+			if sv, exist := mapSupabaseView[state.GetViewKey(v)]; exist {
+				migrateData = append(migrateData, MigrateItem{
+					Type:    migrator.MigrateTypeDelete,
+					OldData: sv,
+				})
+			}
+		}
+	}
+
+	Logger.Info("finish build migrate view data")
+	return
+}
+
+func BuildMigrateItem(supabaseData []objects.View, localData []objects.View) (migratedData []MigrateItem, err error) {
+	Logger.Info("compare supabase and local resource for existing view data")
+	result, e := CompareList(localData, supabaseData)
+	if e != nil {
+		err = e
+		return
+	}
+
+	for i := range result {
+		r := result[i]
+
+		migrateType := migrator.MigrateTypeIgnore
+		if r.IsConflict {
+			migrateType = migrator.MigrateTypeUpdate
+		}
+
+		migratedData = append(migratedData, MigrateItem{
+			Type:           migrateType,
+			NewData:        r.SourceResource,
+			OldData:        r.TargetResource,
+			MigrationItems: r.DiffItems,
+		})
+	}
+
+	return
+}
+
+// Migrate run view migration one by one because view can depend on other view,
+// deleted view is dropped first from the most dependent view
+// and then created or replaced view is executed in dependency order
+func Migrate(config *raiden.Config, views []MigrateItem, stateChan chan any, actions MigrateActionFunc) (errors []error) {
+	var deleteItems, upsertItems []MigrateItem
+	for i := range views {
+		v := views[i]
+		switch v.Type {
+		case migrator.MigrateTypeDelete:
+			deleteItems = append(deleteItems, v)
+		case migrator.MigrateTypeCreate, migrator.MigrateTypeUpdate:
+			upsertItems = append(upsertItems, v)
+		}
+	}
+
+	sortedDeleteItems := SortByDependency(deleteItems)
+	for i, j := 0, len(sortedDeleteItems)-1; i < j; i, j = i+1, j-1 {
+		sortedDeleteItems[i], sortedDeleteItems[j] = sortedDeleteItems[j], sortedDeleteItems[i]
+	}
+
+	for _, item := range append(sortedDeleteItems, SortByDependency(upsertItems)...) {
+		param := migrator.MigrateFuncParam[objects.View, objects.UpdateViewParam]{
+			Config:      config,
+			Data:        item,
+			StateChan:   stateChan,
+			ActionFuncs: actions,
+		}
+
+		if err := migrator.DefaultMigrator(param); err != nil {
+			errors = append(errors, err)
+		}
+	}
+
+	return
+}
+
+// SortByDependency sort migrate item so view is placed after all view
+// that referenced in the definition, circular reference keep the original order
+func SortByDependency(items []MigrateItem) []MigrateItem {
+	sort.SliceStable(items, func(i, j int) bool {
+		return state.GetViewKey(getMigrateItemView(items[i])) < state.GetViewKey(getMigrateItemView(items[j]))
+	})
+
+	visited := make(map[int]bool)
+	visiting := make(map[int]bool)
+	sorted := make([]MigrateItem, 0, len(items))
+
+	var visit func(i int)
+	visit = func(i int) {
+		if visited[i] || visiting[i] {
+			return
+		}
+
+		visiting[i] = true
+		for j := range items {
+			if i != j && isDependOn(getMigrateItemView(items[i]), getMigrateItemView(items[j])) {
+				visit(j)
+			}
+		}
+		visiting[i] = false
+		visited[i] = true
+		sorted = append(sorted, items[i])
+	}
+
+	for i := range items {
+		visit(i)
+	}
+
+	return sorted
+}
+
+func getMigrateItemView(item MigrateItem) objects.View {
+	if item.Type == migrator.MigrateTypeDelete {
+		return item.OldData
+	}
+	return item.NewData
+}
+
+// isDependOn check if source view definition referencing target view
+func isDependOn(source, target objects.View) bool {
+	if target.Name == "" {
+		return false
+	}
+
+	schema := target.Schema
+	if schema == "" {
+		schema = raiden.DefaultViewSchema
+	}
+
+	pattern := fmt.Sprintf(`(?i)(^|[^\w."])("?%s"?\.)?"?%s"?($|[^\w"])`, regexp.QuoteMeta(schema), regexp.QuoteMeta(target.Name))
+	rg, err := regexp.Compile(pattern)
+	if err != nil {
+		return false
+	}
+	return rg.MatchString(source.Definition)
+}
@@ -0,0 +1,25 @@
+package views
+
+import (
+	"github.com/sev-2/raiden/pkg/resource/migrator"
+	"github.com/sev-2/raiden/pkg/supabase/objects"
+	"github.com/sev-2/raiden/pkg/supabase/query"
+)
+
+// BuildMigrateQuery build up and down sql of view migrate item
+func BuildMigrateQuery(item MigrateItem) (up string, down string, err error) {
+	switch item.Type {
+	case migrator.MigrateTypeCreate:
+		up, down = query.BuildCreateViewQuery(&item.NewData), query.BuildDeleteViewQuery(&item.NewData)
+	case migrator.MigrateTypeUpdate:
+		oldData := item.MigrationItems.OldData
+		if oldData.Name == "" {
+			oldData = item.OldData
+		}
+		up = query.BuildUpdateViewQuery(item.NewData, objects.UpdateViewParam{OldData: oldData, ChangeItems: item.MigrationItems.ChangeItems})
+		down = query.BuildUpdateViewQuery(oldData, objects.UpdateViewParam{OldData: item.NewData, ChangeItems: item.MigrationItems.ChangeItems})
+	case migrator.MigrateTypeDelete:
+		up, down = query.BuildDeleteViewQuery(&item.OldData), query.BuildCreateViewQuery(&item.OldData)
+	}
+	return
+}
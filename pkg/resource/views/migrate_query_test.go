@@ -0,0 +1,47 @@
+package views_test
+
+import (
+	"testing"
+
+	"github.com/sev-2/raiden/pkg/resource/migrator"
+	"github.com/sev-2/raiden/pkg/resource/views"
+	"github.com/sev-2/raiden/pkg/supabase/objects"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBuildMigrateQuery(t *testing.T) {
+	newView := objects.View{Schema: "public", Name: "active_users", Definition: "SELECT id, name FROM users WHERE is_active"}
+	oldView := objects.View{Schema: "public", Name: "active_users", Definition: "SELECT id FROM users WHERE is_active"}
+
+	up, down, err := views.BuildMigrateQuery(views.MigrateItem{Type: migrator.MigrateTypeCreate, NewData: newView})
+	assert.NoError(t, err)
+	assert.Equal(t, `CREATE OR REPLACE VIEW "public"."active_users" AS SELECT id, name FROM users WHERE is_active;`, up)
+	assert.Equal(t, `DROP VIEW IF EXISTS "public"."active_users";`, down)
+
+	up, down, err = views.BuildMigrateQuery(views.MigrateItem{
+		Type:    migrator.MigrateTypeUpdate,
+		NewData: newView,
+		OldData: oldView,
+		MigrationItems: objects.UpdateViewParam{
+			OldData:     oldView,
+			ChangeItems: []objects.UpdateViewType{objects.UpdateViewDefinition},
+		},
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, `CREATE OR REPLACE VIEW "public"."active_users" AS SELECT id, name FROM users WHERE is_active;`, up)
+	assert.Equal(t, `CREATE OR REPLACE VIEW "public"."active_users" AS SELECT id FROM users WHERE is_active;`, down)
+
+	up, down, err = views.BuildMigrateQuery(views.MigrateItem{Type: migrator.MigrateTypeDelete, OldData: oldView})
+	assert.NoError(t, err)
+	assert.Equal(t, `DROP VIEW IF EXISTS "public"."active_users";`, up)
+	assert.Contains(t, down, "CREATE OR REPLACE VIEW")
+}
+
+func TestBuildMigrateQuery_QuotedIdentifier(t *testing.T) {
+	view := objects.View{Schema: "my\"schema", Name: "active\"users", Definition: "SELECT 1", IsMaterialized: true}
+
+	up, down, err := views.BuildMigrateQuery(views.MigrateItem{Type: migrator.MigrateTypeCreate, NewData: view})
+	assert.NoError(t, err)
+	assert.Equal(t, `CREATE MATERIALIZED VIEW IF NOT EXISTS "my""schema"."active""users" AS SELECT 1;`, up)
+	assert.Equal(t, `DROP MATERIALIZED VIEW IF EXISTS "my""schema"."active""users";`, down)
+}
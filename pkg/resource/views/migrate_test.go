@@ -0,0 +1,119 @@
+package views_test
+
+import (
+	"testing"
+
+	"github.com/sev-2/raiden"
+	"github.com/sev-2/raiden/pkg/resource/migrator"
+	"github.com/sev-2/raiden/pkg/resource/views"
+	"github.com/sev-2/raiden/pkg/state"
+	"github.com/sev-2/raiden/pkg/supabase/objects"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBuildMigrateData(t *testing.T) {
+	extractedLocalData := state.ExtractViewResult{
+		New: []objects.View{
+			{Name: "view_1", Definition: "select 1"},
+			{Name: "view_5", Definition: "select 5"},
+		},
+		Existing: []objects.View{
+			{Name: "view_2", Schema: "public", Definition: "select 2"},
+			{Name: "view_3", Schema: "public", Definition: "select 3"},
+		},
+		Delete: []objects.View{
+			{Name: "view_4"},
+			{Name: "view_6"},
+		},
+	}
+
+	supabaseViews := []objects.View{
+		{ID: 1, Name: "view_1", Schema: "public", Definition: "select 1"},
+		{ID: 2, Name: "view_2", Schema: "public", Definition: "SELECT 22;"},
+		{ID: 4, Name: "view_4", Schema: "public"},
+	}
+
+	migrateData, err := views.BuildMigrateData(extractedLocalData, supabaseViews)
+	assert.NoError(t, err)
+	assert.Equal(t, 4, len(migrateData))
+
+	assert.Equal(t, migrator.MigrateTypeUpdate, migrateData[0].Type)
+	assert.Equal(t, "view_2", migrateData[0].NewData.Name)
+
+	assert.Equal(t, migrator.MigrateTypeUpdate, migrateData[1].Type)
+	assert.Equal(t, 1, migrateData[1].NewData.ID)
+
+	assert.Equal(t, migrator.MigrateTypeCreate, migrateData[2].Type)
+	assert.Equal(t, "view_5", migrateData[2].NewData.Name)
+
+	assert.Equal(t, migrator.MigrateTypeDelete, migrateData[3].Type)
+	assert.Equal(t, 4, migrateData[3].OldData.ID)
+}
+
+func TestBuildMigrateItem(t *testing.T) {
+	localViews := []objects.View{
+		{Name: "view_1", Definition: "SELECT id FROM users"},
+		{Name: "view_2", Definition: "SELECT id FROM users", IsMaterialized: true},
+	}
+
+	supabaseViews := []objects.View{
+		{Name: "view_1", Definition: " SELECT id\n   FROM users;"},
+		{Name: "view_2", Definition: " SELECT id\n   FROM users;"},
+	}
+
+	migrateData, err := views.BuildMigrateItem(supabaseViews, localViews)
+	assert.NoError(t, err)
+	assert.Equal(t, 2, len(migrateData))
+	assert.Equal(t, migrator.MigrateTypeIgnore, migrateData[0].Type)
+	assert.Equal(t, migrator.MigrateTypeUpdate, migrateData[1].Type)
+	assert.Equal(t, []objects.UpdateViewType{objects.UpdateViewMaterialized}, migrateData[1].MigrationItems.ChangeItems)
+}
+
+func TestSortByDependency(t *testing.T) {
+	items := []views.MigrateItem{
+		{
+			Type:    migrator.MigrateTypeCreate,
+			NewData: objects.View{Name: "top_users", Definition: "SELECT * FROM public.active_users ORDER BY score DESC"},
+		},
+		{
+			Type:    migrator.MigrateTypeUpdate,
+			NewData: objects.View{Name: "active_users", Definition: `SELECT * FROM "public"."scored_users" WHERE is_active`},
+		},
+		{
+			Type:    migrator.MigrateTypeCreate,
+			NewData: objects.View{Name: "scored_users", Definition: "SELECT id, score, is_active FROM users"},
+		},
+	}
+
+	sorted := views.SortByDependency(items)
+	assert.Equal(t, 3, len(sorted))
+	assert.Equal(t, "scored_users", sorted[0].NewData.Name)
+	assert.Equal(t, "active_users", sorted[1].NewData.Name)
+	assert.Equal(t, "top_users", sorted[2].NewData.Name)
+}
+
+func TestMigrate(t *testing.T) {
+	config := &raiden.Config{}
+	stateChan := make(chan any)
+	defer close(stateChan)
+
+	migrateItems := []views.MigrateItem{
+		{
+			Type:    migrator.MigrateTypeCreate,
+			NewData: objects.View{Name: "view_1", Definition: "select 1"},
+		},
+	}
+
+	errors := views.Migrate(config, migrateItems, stateChan, views.ActionFunc)
+	assert.Equal(t, 1, len(errors))
+}
+
+func TestRefresh(t *testing.T) {
+	config := &raiden.Config{}
+
+	err := views.Refresh(config, &MockView{}, false)
+	assert.EqualError(t, err, "refresh view mock_view : view is not materialized")
+
+	err = views.Refresh(config, &MockMaterializedView{}, false)
+	assert.Error(t, err)
+}
@@ -0,0 +1,288 @@
+package views
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"strings"
+	"text/template"
+
+	"github.com/fatih/color"
+	"github.com/sev-2/raiden/pkg/resource/migrator"
+	"github.com/sev-2/raiden/pkg/state"
+	"github.com/sev-2/raiden/pkg/supabase/objects"
+	"github.com/sev-2/raiden/pkg/utils"
+)
+
+// ----- print diff section -----
+type DiffType string
+
+const (
+	DiffTypeCreate DiffType = "create"
+	DiffTypeUpdate DiffType = "update"
+	DiffTypeDelete DiffType = "delete"
+)
+
+func PrintDiffResult(diffResult []CompareDiffResult) error {
+	if len(diffResult) == 0 {
+		return nil
+	}
+
+	isConflict := false
+	for i := range diffResult {
+		d := diffResult[i]
+		if d.IsConflict {
+			PrintDiff(d)
+			if !isConflict {
+				isConflict = true
+			}
+		}
+	}
+
+	if isConflict {
+		return errors.New("canceled import process, you have conflict in view. please fix it first")
+	}
+
+	return nil
+}
+
+func PrintDiff(diffData CompareDiffResult) {
+	if len(diffData.DiffItems.ChangeItems) == 0 {
+		return
+	}
+
+	fileName := utils.ToSnakeCase(diffData.TargetResource.Name)
+	printScope := color.New(color.FgHiBlack).PrintfFunc()
+
+	changes := make([]string, 0)
+	for _, v := range diffData.DiffItems.ChangeItems {
+		value, changeValue := getChangeValue(v, diffData.TargetResource, diffData.SourceResource)
+		diffStr, err := GenerateDiffMessage(fileName, DiffTypeUpdate, v, value, changeValue)
+		if err != nil {
+			Logger.Error("print diff view error", "msg", err.Error())
+			continue
+		}
+		changes = append(changes, diffStr)
+	}
+
+	printScope("*** Found diff in %s/%s.go ***\n", "/internal/views", fileName)
+	fmt.Println(strings.Join(changes, ""))
+	printScope("*** End found diff ***\n")
+}
+
+func getChangeValue(updateType objects.UpdateViewType, oldData, newData objects.View) (value string, changeValue string) {
+	switch updateType {
+	case objects.UpdateViewDefinition:
+		return oldData.Definition, newData.Definition
+	case objects.UpdateViewMaterialized:
+		return fmt.Sprintf("%t", oldData.IsMaterialized), fmt.Sprintf("%t", newData.IsMaterialized)
+	}
+	return
+}
+
+// ----- generate message section ------
+const DiffTemplate = ` 
+ {{- if or (eq .Type "create") (eq .Type "delete")}}
+ {{ .Symbol }} func (r *{{ .Name  | ToGoIdentifier }}) %s {
+ %s
+ {{ .Symbol }} }
+ {{- end}}
+ {{- if eq .Type "update"}}
+ func (r *{{ .Name | ToGoIdentifier }}) %s {
+   %s
+ }
+{{- end}}
+  `
+
+const FuncBodyTemplate = "{{ .Symbol }} return {{ .Value }}"
+const FuncBodyUpdateTemplate = "{{ .Symbol }} return {{ .Value }}  >>> {{ .ChangeValue }}"
+
+func buildDiffTemplate(funcDecl string, bodyTemplate string, updateBodyTemplate string) string {
+	if bodyTemplate == "" {
+		bodyTemplate = FuncBodyTemplate
+	}
+
+	if updateBodyTemplate == "" {
+		updateBodyTemplate = FuncBodyUpdateTemplate
+	}
+
+	return fmt.Sprintf(DiffTemplate, funcDecl, bodyTemplate, funcDecl, updateBodyTemplate)
+}
+
+func getDiffSymbol(diffType DiffType) string {
+	printAdd := color.New(color.FgHiGreen).SprintfFunc()
+	printRemove := color.New(color.FgHiRed).SprintfFunc()
+	printUpdate := color.New(color.FgHiYellow).SprintfFunc()
+
+	var symbol string
+	switch diffType {
+	case DiffTypeCreate:
+		symbol = printAdd("+")
+	case DiffTypeUpdate:
+		symbol = printUpdate("~")
+	case DiffTypeDelete:
+		symbol = printRemove("-")
+	}
+	return symbol
+}
+
+func GenerateDiffMessage(name string, diffType DiffType, updateType objects.UpdateViewType, value string, changeValue string) (string, error) {
+	param := map[string]any{
+		"Name":        name,
+		"Type":        diffType,
+		"Value":       value,
+		"ChangeValue": changeValue,
+		"Symbol":      getDiffSymbol(diffType),
+	}
+
+	tmplStr := ""
+	switch updateType {
+	case objects.UpdateViewDefinition:
+		tmplStr = buildDiffTemplate("Definition() string", "", "")
+	case objects.UpdateViewMaterialized:
+		tmplStr = buildDiffTemplate("Materialized() bool", "", "")
+	default:
+		return "", errors.New("unsupported update type")
+	}
+
+	funcMaps := []template.FuncMap{
+		{"ToGoIdentifier": utils.SnakeCaseToPascalCase},
+	}
+
+	tmplInstance := template.New("generate diff")
+	for _, tm := range funcMaps {
+		tmplInstance.Funcs(tm)
+	}
+
+	tmpl, err := tmplInstance.Parse(tmplStr)
+	if err != nil {
+		return "", fmt.Errorf("error parsing : %v", err)
+	}
+
+	var buff bytes.Buffer
+	if err := tmpl.Execute(&buff, param); err != nil {
+		return "", err
+	}
+
+	return buff.String(), nil
+}
+
+// ----- diff change -----
+
+func GetDiffChangeMessage(items []MigrateItem) string {
+	newData := []string{}
+	deleteData := []string{}
+	updateData := []string{}
+
+	for i := range items {
+		item := items[i]
+
+		var name string
+		if item.NewData.Name != "" {
+			name = state.GetViewKey(item.NewData)
+		} else if item.OldData.Name != "" {
+			name = state.GetViewKey(item.OldData)
+		}
+
+		switch item.Type {
+		case migrator.MigrateTypeCreate:
+			newData = append(newData, fmt.Sprintf("- %s", name))
+		case migrator.MigrateTypeUpdate:
+			diffMessage, err := GenerateDiffChangeUpdateMessage(name, item)
+			if err != nil {
+				Logger.Error("print change view error", "msg", err.Error())
+				continue
+			}
+			updateData = append(updateData, diffMessage)
+		case migrator.MigrateTypeDelete:
+			deleteData = append(deleteData, fmt.Sprintf("- %s", name))
+		}
+	}
+
+	changeMsg, err := GenerateDiffChangeMessage(newData, updateData, deleteData)
+	if err != nil {
+		Logger.Error("print change view error", "msg", err.Error())
+		return ""
+	}
+	return changeMsg
+}
+
+const DiffChangeTemplate = `
+  {{- if gt (len .NewData) 0}}
+  New View
+  {{- range .NewData}}
+  {{.}}
+  {{- end }}
+  {{- end -}}
+  {{- if gt (len .UpdateData) 0}}
+  Update View
+  {{- range .UpdateData}}
+  {{.}}
+  {{- end }}
+  {{- end -}}
+  {{- if gt (len .DeleteData) 0}}
+  Delete View
+  {{- range .DeleteData}}
+  {{.}}
+  {{- end }}
+  {{- end -}}
+  `
+
+func GenerateDiffChangeMessage(newData []string, updateData []string, deleteData []string) (string, error) {
+	param := map[string]any{
+		"NewData":    newData,
+		"UpdateData": updateData,
+		"DeleteData": deleteData,
+	}
+
+	tmplInstance := template.New("generate diff change view")
+	tmpl, err := tmplInstance.Parse(DiffChangeTemplate)
+	if err != nil {
+		return "", fmt.Errorf("error parsing : %v", err)
+	}
+
+	var buff bytes.Buffer
+	if err := tmpl.Execute(&buff, param); err != nil {
+		return "", err
+	}
+
+	return buff.String(), nil
+}
+
+const DiffChangeUpdateTemplate = `  - Update View {{ .Name }}
+  {{- if gt (len .ChangeItems) 0}}
+      Change Configuration
+      {{- range .ChangeItems}}
+      {{.}}
+      {{- end }}
+  {{- end -}}
+  `
+
+func GenerateDiffChangeUpdateMessage(name string, item MigrateItem) (string, error) {
+	diffItems := item.MigrationItems
+
+	var changeMsgArr []string
+	for i := range diffItems.ChangeItems {
+		c := diffItems.ChangeItems[i]
+		oldValue, newValue := getChangeValue(c, item.OldData, item.NewData)
+		changeMsgArr = append(changeMsgArr, fmt.Sprintf("- %s : %v >>> %v", c, oldValue, newValue))
+	}
+
+	param := map[string]any{
+		"Name":        name,
+		"ChangeItems": changeMsgArr,
+	}
+
+	tmplInstance := template.New("generate diff change update")
+	tmpl, err := tmplInstance.Parse(DiffChangeUpdateTemplate)
+	if err != nil {
+		return "", fmt.Errorf("error parsing : %v", err)
+	}
+
+	var buff bytes.Buffer
+	if err := tmpl.Execute(&buff, param); err != nil {
+		return "", err
+	}
+
+	return buff.String(), nil
+}
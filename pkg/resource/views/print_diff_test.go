@@ -0,0 +1,64 @@
+package views_test
+
+import (
+	"testing"
+
+	"github.com/sev-2/raiden/pkg/resource/migrator"
+	"github.com/sev-2/raiden/pkg/resource/views"
+	"github.com/sev-2/raiden/pkg/supabase/objects"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPrintDiffResult(t *testing.T) {
+	diffResult := []views.CompareDiffResult{
+		{
+			IsConflict:     true,
+			SourceResource: objects.View{Name: "view", Definition: "select 1"},
+			TargetResource: objects.View{Name: "view", Definition: "select 2"},
+			DiffItems: objects.UpdateViewParam{
+				ChangeItems: []objects.UpdateViewType{objects.UpdateViewDefinition},
+			},
+		},
+	}
+
+	err := views.PrintDiffResult(diffResult)
+	assert.EqualError(t, err, "canceled import process, you have conflict in view. please fix it first")
+}
+
+func TestGetDiffChangeMessage(t *testing.T) {
+	items := []views.MigrateItem{
+		{
+			Type:    migrator.MigrateTypeCreate,
+			NewData: objects.View{Name: "new_view"},
+		},
+		{
+			Type:    migrator.MigrateTypeUpdate,
+			NewData: objects.View{Name: "update_view", IsMaterialized: true},
+			OldData: objects.View{Name: "update_view"},
+			MigrationItems: objects.UpdateViewParam{
+				ChangeItems: []objects.UpdateViewType{objects.UpdateViewMaterialized},
+			},
+		},
+		{
+			Type:    migrator.MigrateTypeDelete,
+			OldData: objects.View{Name: "delete_view", Schema: "private"},
+		},
+	}
+
+	diffMessage := views.GetDiffChangeMessage(items)
+	assert.Contains(t, diffMessage, "New View")
+	assert.Contains(t, diffMessage, "- public.new_view")
+	assert.Contains(t, diffMessage, "Update View")
+	assert.Contains(t, diffMessage, "- materialized : false >>> true")
+	assert.Contains(t, diffMessage, "Delete View")
+	assert.Contains(t, diffMessage, "- private.delete_view")
+}
+
+func TestGenerateDiffMessage(t *testing.T) {
+	diffMessage, err := views.GenerateDiffMessage("active_users", views.DiffTypeUpdate, objects.UpdateViewDefinition, "select 1", "select 2")
+	assert.NoError(t, err)
+	assert.Contains(t, diffMessage, "Definition()")
+
+	_, err = views.GenerateDiffMessage("active_users", views.DiffTypeUpdate, objects.UpdateViewType("unknown"), "", "")
+	assert.Error(t, err)
+}
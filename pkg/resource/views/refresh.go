@@ -0,0 +1,32 @@
+package views
+
+import (
+	"fmt"
+
+	"github.com/sev-2/raiden"
+	"github.com/sev-2/raiden/pkg/connector/pgmeta"
+	"github.com/sev-2/raiden/pkg/state"
+	"github.com/sev-2/raiden/pkg/supabase"
+	"github.com/sev-2/raiden/pkg/supabase/objects"
+)
+
+// Refresh execute REFRESH MATERIALIZED VIEW for declared view,
+// can be called from job task, example :
+//
+//	func (j *RefreshSummaryJob) Task(ctx raiden.JobContext) error {
+//		return views.Refresh(ctx.Config(), &models.UserSummary{}, false)
+//	}
+func Refresh(cfg *raiden.Config, view raiden.View, concurrently bool) error {
+	v := objects.View{}
+	state.BindToSupabaseView(&v, view)
+
+	if !v.IsMaterialized {
+		return fmt.Errorf("refresh view %s : view is not materialized", v.Name)
+	}
+
+	Logger.Debug("refresh materialized view", "name", v.Name, "concurrently", concurrently)
+	if cfg.Mode == raiden.SvcMode {
+		return pgmeta.RefreshMaterializedView(cfg, v, concurrently)
+	}
+	return supabase.RefreshMaterializedView(cfg, v, concurrently)
+}
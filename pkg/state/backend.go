@@ -0,0 +1,271 @@
+package state
+
+import (
+	"bytes"
+	"crypto/rand"
+	"encoding/hex"
+	"fmt"
+	"os"
+	"os/user"
+	"time"
+
+	"github.com/sev-2/raiden"
+	"github.com/sev-2/raiden/pkg/connector/pgmeta"
+	"github.com/sev-2/raiden/pkg/supabase"
+	"github.com/sev-2/raiden/pkg/supabase/objects"
+)
+
+const (
+	DefaultRemoteStateName    = "default"
+	DefaultStateLockTimeout   = 10 * time.Minute
+	DefaultStateLockRetry     = 5 * time.Second
+	DefaultStateLockExpiredIn = time.Hour
+)
+
+// Backend is storage of state, lock is used so only one apply
+// can change the state at the same time
+type Backend interface {
+	Load() (*State, error)
+	Save(state *State) error
+	Lock() error
+	Unlock() error
+}
+
+var currentBackend Backend = &FileBackend{}
+
+func SetBackend(backend Backend) {
+	if backend == nil {
+		backend = &FileBackend{}
+	}
+	currentBackend = backend
+}
+
+func GetBackend() Backend {
+	return currentBackend
+}
+
+// NewBackend create state backend based on STATE_BACKEND configuration
+func NewBackend(config *raiden.Config) (Backend, error) {
+	switch config.StateBackend {
+	case "", raiden.StateBackendFile:
+		return &FileBackend{}, nil
+	case raiden.StateBackendPostgres:
+		return NewPostgresBackend(config), nil
+	default:
+		return nil, fmt.Errorf("unsupported state backend '%s', available backend is %s and %s", config.StateBackend, raiden.StateBackendFile, raiden.StateBackendPostgres)
+	}
+}
+
+// ----- file backend -----
+
+// FileBackend store state in build folder of the project,
+// lock is not needed because state is only used from one machine
+type FileBackend struct{}
+
+func (b *FileBackend) Load() (*State, error) {
+	return loadFile()
+}
+
+func (b *FileBackend) Save(state *State) error {
+	return saveFile(state)
+}
+
+func (b *FileBackend) Lock() error {
+	return nil
+}
+
+func (b *FileBackend) Unlock() error {
+	return nil
+}
+
+// ----- memory backend -----
+
+// MemoryBackend keep state in memory, used when change must not be written
+// to project state, for example when promoting resource between environment
+type MemoryBackend struct {
+	State State
+}
+
+func (b *MemoryBackend) Load() (*State, error) {
+	s := b.State
+	return &s, nil
+}
+
+func (b *MemoryBackend) Save(state *State) error {
+	if state != nil {
+		b.State = *state
+	}
+	return nil
+}
+
+func (b *MemoryBackend) Lock() error {
+	return nil
+}
+
+func (b *MemoryBackend) Unlock() error {
+	return nil
+}
+
+// ----- postgres backend -----
+
+// PostgresBackend store state in raiden.state table of target database so state can be shared,
+// state is also written to local file because generated app read state from build folder
+type PostgresBackend struct {
+	Config        *raiden.Config
+	Name          string
+	LockTimeout   time.Duration
+	LockRetry     time.Duration
+	LockExpiredIn time.Duration
+
+	lockId string
+}
+
+func NewPostgresBackend(config *raiden.Config) *PostgresBackend {
+	return &PostgresBackend{
+		Config:        config,
+		Name:          DefaultRemoteStateName,
+		LockTimeout:   DefaultStateLockTimeout,
+		LockRetry:     DefaultStateLockRetry,
+		LockExpiredIn: DefaultStateLockExpiredIn,
+	}
+}
+
+// Load read state from database, local state file is used
+// when state is not stored in database yet
+func (b *PostgresBackend) Load() (*State, error) {
+	rs, err := b.getState()
+	if err != nil {
+		return nil, err
+	}
+
+	data := bytes.TrimSpace(rs.Data)
+	if len(data) == 0 || string(data) == "null" {
+		StateLogger.Info("remote state is empty, use local state file", "name", b.Name)
+		return loadFile()
+	}
+
+	s, err := UnmarshalState(data)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := saveFile(s); err != nil {
+		return nil, err
+	}
+	return s, nil
+}
+
+func (b *PostgresBackend) Save(state *State) error {
+	data, err := MarshalState(state)
+	if err != nil {
+		return err
+	}
+
+	updatedBy := getStateHolder()
+	if err := b.saveState(objects.RemoteState{Name: b.Name, Data: data, UpdatedBy: &updatedBy}); err != nil {
+		return err
+	}
+	return saveFile(state)
+}
+
+// Lock take state lock and wait until lock is released by other process,
+// lock that older than expired duration is taken over because the process is considered dead
+func (b *PostgresBackend) Lock() error {
+	if b.lockId == "" {
+		lockId, err := generateLockId()
+		if err != nil {
+			return err
+		}
+		b.lockId = lockId
+	}
+
+	holder := getStateHolder()
+	deadline := time.Now().Add(b.LockTimeout)
+	for {
+		rs, err := b.lockState(holder)
+		if err != nil {
+			return err
+		}
+
+		if rs.IsLockedBy(b.lockId) {
+			StateLogger.Debug("state is locked", "name", b.Name, "lock-id", b.lockId)
+			return nil
+		}
+
+		lockedBy, lockedAt := "unknown", "unknown"
+		if rs.LockedBy != nil {
+			lockedBy = *rs.LockedBy
+		}
+
+		if rs.LockedAt != nil {
+			lockedAt = rs.LockedAt.Local().Format(time.RFC3339)
+		}
+
+		if time.Now().Add(b.LockRetry).After(deadline) {
+			return fmt.Errorf("state is locked by %s since %s, try again after the process is finished", lockedBy, lockedAt)
+		}
+
+		StateLogger.Info("waiting state lock", "locked-by", lockedBy, "locked-at", lockedAt)
+		time.Sleep(b.LockRetry)
+	}
+}
+
+func (b *PostgresBackend) Unlock() error {
+	if b.lockId == "" {
+		return nil
+	}
+
+	if err := b.unlockState(); err != nil {
+		return err
+	}
+	b.lockId = ""
+	return nil
+}
+
+func (b *PostgresBackend) getState() (objects.RemoteState, error) {
+	if b.Config.Mode == raiden.SvcMode {
+		return pgmeta.GetState(b.Config, b.Name)
+	}
+	return supabase.GetState(b.Config, b.Name)
+}
+
+func (b *PostgresBackend) saveState(s objects.RemoteState) error {
+	if b.Config.Mode == raiden.SvcMode {
+		return pgmeta.SaveState(b.Config, s)
+	}
+	return supabase.SaveState(b.Config, s)
+}
+
+func (b *PostgresBackend) lockState(holder string) (objects.RemoteState, error) {
+	if b.Config.Mode == raiden.SvcMode {
+		return pgmeta.LockState(b.Config, b.Name, b.lockId, holder, b.LockExpiredIn)
+	}
+	return supabase.LockState(b.Config, b.Name, b.lockId, holder, b.LockExpiredIn)
+}
+
+func (b *PostgresBackend) unlockState() error {
+	if b.Config.Mode == raiden.SvcMode {
+		return pgmeta.UnlockState(b.Config, b.Name, b.lockId)
+	}
+	return supabase.UnlockState(b.Config, b.Name, b.lockId)
+}
+
+func generateLockId() (string, error) {
+	b := make([]byte, 16)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(b), nil
+}
+
+func getStateHolder() string {
+	username := os.Getenv("USER")
+	if u, err := user.Current(); err == nil && u.Username != "" {
+		username = u.Username
+	}
+
+	if hostname, err := os.Hostname(); err == nil && hostname != "" {
+		return fmt.Sprintf("%s@%s", username, hostname)
+	}
+	return username
+}
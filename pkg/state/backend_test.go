@@ -0,0 +1,101 @@
+package state_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/sev-2/raiden"
+	"github.com/sev-2/raiden/pkg/mock"
+	"github.com/sev-2/raiden/pkg/state"
+	"github.com/sev-2/raiden/pkg/supabase/objects"
+	"github.com/stretchr/testify/assert"
+)
+
+func loadBackendConfig() *raiden.Config {
+	return &raiden.Config{
+		DeploymentTarget:    raiden.DeploymentTargetCloud,
+		ProjectId:           "test-project-id",
+		SupabaseApiBasePath: "/v1",
+		SupabaseApiUrl:      "http://supabase.cloud.com",
+		Mode:                raiden.BffMode,
+		StateBackend:        raiden.StateBackendPostgres,
+	}
+}
+
+func TestNewBackend(t *testing.T) {
+	backend, err := state.NewBackend(&raiden.Config{})
+	assert.NoError(t, err)
+	assert.IsType(t, &state.FileBackend{}, backend)
+
+	backend, err = state.NewBackend(loadBackendConfig())
+	assert.NoError(t, err)
+	assert.IsType(t, &state.PostgresBackend{}, backend)
+
+	_, err = state.NewBackend(&raiden.Config{StateBackend: "s3"})
+	assert.Error(t, err)
+}
+
+func TestPostgresBackend_Load(t *testing.T) {
+	config := loadBackendConfig()
+	mock := &mock.MockSupabase{Cfg: config}
+	mock.Activate()
+	defer mock.Deactivate()
+
+	data, err := state.MarshalState(&state.State{
+		Tables: []state.TableState{{Table: objects.Table{ID: 1, Name: "remote_table"}}},
+	})
+	assert.NoError(t, err)
+
+	err = mock.MockGetStateWithExpectedResponse(200, objects.RemoteState{Name: state.DefaultRemoteStateName, Data: data})
+	assert.NoError(t, err)
+
+	state.SetBackend(state.NewPostgresBackend(config))
+	defer state.SetBackend(nil)
+
+	s, err := state.Load()
+	assert.NoError(t, err)
+	assert.Len(t, s.Tables, 1)
+	assert.Equal(t, "remote_table", s.Tables[0].Table.Name)
+
+	// remote state is cached in local state file
+	state.SetBackend(nil)
+	cached, err := state.Load()
+	assert.NoError(t, err)
+	assert.Equal(t, "remote_table", cached.Tables[0].Table.Name)
+	assert.NoError(t, state.Save(&state.State{}))
+}
+
+func TestPostgresBackend_Save(t *testing.T) {
+	config := loadBackendConfig()
+	mock := &mock.MockSupabase{Cfg: config}
+	mock.Activate()
+	defer mock.Deactivate()
+
+	err := mock.MockGetStateWithExpectedResponse(200, objects.RemoteState{Name: state.DefaultRemoteStateName})
+	assert.NoError(t, err)
+
+	backend := state.NewPostgresBackend(config)
+	assert.NoError(t, backend.Save(&state.State{}))
+}
+
+func TestPostgresBackend_LockByOtherProcess(t *testing.T) {
+	config := loadBackendConfig()
+	mock := &mock.MockSupabase{Cfg: config}
+	mock.Activate()
+	defer mock.Deactivate()
+
+	lockId, lockedBy, lockedAt := "other-lock-id", "other@machine", time.Now()
+	err := mock.MockGetStateWithExpectedResponse(200, objects.RemoteState{
+		Name: state.DefaultRemoteStateName, LockId: &lockId, LockedBy: &lockedBy, LockedAt: &lockedAt,
+	})
+	assert.NoError(t, err)
+
+	backend := state.NewPostgresBackend(config)
+	backend.LockTimeout = 0
+	err = backend.Lock()
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "state is locked by other@machine")
+
+	// unlock only release lock that owned by the backend
+	assert.NoError(t, backend.Unlock())
+}
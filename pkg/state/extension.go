@@ -0,0 +1,78 @@
+package state
+
+import (
+	"reflect"
+
+	"github.com/sev-2/raiden"
+	"github.com/sev-2/raiden/pkg/supabase/objects"
+	"github.com/sev-2/raiden/pkg/utils"
+)
+
+type ExtractExtensionResult struct {
+	Existing []objects.Extension
+	New      []objects.Extension
+	Delete   []objects.Extension
+}
+
+func ExtractExtension(extensionStates []ExtensionState, appExtensions []raiden.Extension) (result ExtractExtensionResult, err error) {
+	mapExtensionState := map[string]ExtensionState{}
+	for i := range extensionStates {
+		r := extensionStates[i]
+		mapExtensionState[r.Extension.Name] = r
+	}
+
+	for _, extension := range appExtensions {
+		e := objects.Extension{}
+		BindToSupabaseExtension(&e, extension)
+
+		state, isStateExist := mapExtensionState[e.Name]
+		if !isStateExist {
+			result.New = append(result.New, e)
+			continue
+		}
+
+		sr := BuildExtensionFromState(state, extension)
+		result.Existing = append(result.Existing, sr)
+		delete(mapExtensionState, e.Name)
+	}
+
+	for _, state := range mapExtensionState {
+		result.Delete = append(result.Delete, state.Extension)
+	}
+
+	return
+}
+
+func BindToSupabaseExtension(r *objects.Extension, extension raiden.Extension) {
+	name := extension.Name()
+	if name == "" {
+		rv := reflect.TypeOf(extension)
+		if rv.Kind() == reflect.Pointer {
+			rv = rv.Elem()
+		}
+		name = utils.ToSnakeCase(rv.Name())
+	}
+
+	r.Name = name
+	r.Schema = extension.Schema()
+	r.InstalledVersion = extension.Version()
+}
+
+func BuildExtensionFromState(es ExtensionState, e raiden.Extension) (r objects.Extension) {
+	r = es.Extension
+	BindToSupabaseExtension(&r, e)
+	return
+}
+
+func (er ExtractExtensionResult) ToDeleteFlatMap() map[string]*objects.Extension {
+	mapData := make(map[string]*objects.Extension)
+
+	if len(er.Delete) > 0 {
+		for i := range er.Delete {
+			r := er.Delete[i]
+			mapData[r.Name] = &r
+		}
+	}
+
+	return mapData
+}
@@ -0,0 +1,70 @@
+package state_test
+
+import (
+	"testing"
+
+	"github.com/sev-2/raiden"
+	"github.com/sev-2/raiden/pkg/state"
+	"github.com/sev-2/raiden/pkg/supabase/objects"
+	"github.com/stretchr/testify/assert"
+)
+
+type MockPgTrgm struct {
+	raiden.ExtensionBase
+}
+
+type MockUuidOssp struct {
+	raiden.ExtensionBase
+}
+
+func (*MockUuidOssp) Name() string {
+	return "uuid-ossp"
+}
+
+func (*MockUuidOssp) Version() string {
+	return "1.1"
+}
+
+func TestExtractExtension(t *testing.T) {
+	extensionStates := []state.ExtensionState{
+		{Extension: objects.Extension{Name: "uuid-ossp", Schema: "extensions", InstalledVersion: "1.0"}},
+		{Extension: objects.Extension{Name: "postgis", Schema: "extensions"}},
+	}
+
+	appExtensions := []raiden.Extension{
+		&MockUuidOssp{},
+		&MockPgTrgm{},
+	}
+
+	result, err := state.ExtractExtension(extensionStates, appExtensions)
+	assert.NoError(t, err)
+	assert.Len(t, result.Existing, 1)
+	assert.Len(t, result.New, 1)
+	assert.Len(t, result.Delete, 1)
+	assert.Equal(t, "1.1", result.Existing[0].InstalledVersion)
+	assert.Equal(t, "mock_pg_trgm", result.New[0].Name)
+	assert.Equal(t, "postgis", result.Delete[0].Name)
+}
+
+func TestBindToSupabaseExtension(t *testing.T) {
+	r := objects.Extension{}
+	state.BindToSupabaseExtension(&r, &MockUuidOssp{})
+
+	assert.Equal(t, "uuid-ossp", r.Name)
+	assert.Equal(t, "extensions", r.Schema)
+	assert.Equal(t, "1.1", r.InstalledVersion)
+}
+
+func TestExtractExtensionResult_ToDeleteFlatMap(t *testing.T) {
+	extractResult := state.ExtractExtensionResult{
+		Delete: []objects.Extension{
+			{Name: "pg_trgm"},
+			{Name: "vector"},
+		},
+	}
+
+	mapData := extractResult.ToDeleteFlatMap()
+	assert.Len(t, mapData, 2)
+	assert.Contains(t, mapData, "pg_trgm")
+	assert.Contains(t, mapData, "vector")
+}
@@ -0,0 +1,149 @@
+package state
+
+import (
+	"bytes"
+	"encoding/gob"
+	"encoding/json"
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+
+	"github.com/sev-2/raiden/pkg/supabase/objects"
+)
+
+// MarshalState encode state as indented json, every resource is sorted
+// so the same state always produce the same file and can be reviewed and merged
+func MarshalState(s *State) ([]byte, error) {
+	if s == nil {
+		s = &State{}
+	}
+
+	var buf bytes.Buffer
+	encoder := json.NewEncoder(&buf)
+	encoder.SetEscapeHTML(false)
+	encoder.SetIndent("", "  ")
+	if err := encoder.Encode(sortState(s)); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+func UnmarshalState(data []byte) (*State, error) {
+	s := &State{}
+	if len(bytes.TrimSpace(data)) == 0 {
+		return s, nil
+	}
+
+	if err := json.Unmarshal(data, s); err != nil {
+		return nil, fmt.Errorf("invalid state file : %s", err)
+	}
+	return s, nil
+}
+
+// decodeLegacyState decode gob encoded state from previous version
+func decodeLegacyState(r io.Reader) (*State, error) {
+	s := &State{}
+	gob.Register(map[string]interface{}{})
+	if err := gob.NewDecoder(r).Decode(s); err != nil {
+		return nil, fmt.Errorf("invalid legacy state file : %s", err)
+	}
+	return s, nil
+}
+
+// sortState return copy of state with stable resource order,
+// original state is not changed because local state access resource by index
+func sortState(s *State) *State {
+	sorted := &State{
+		Tables:     append([]TableState(nil), s.Tables...),
+		Roles:      append([]RoleState(nil), s.Roles...),
+		Rpc:        append([]RpcState(nil), s.Rpc...),
+		Storage:    append([]StorageState(nil), s.Storage...),
+		Types:      append([]TypeState(nil), s.Types...),
+		Triggers:   append([]TriggerState(nil), s.Triggers...),
+		Views:      append([]ViewState(nil), s.Views...),
+		Extensions: append([]ExtensionState(nil), s.Extensions...),
+		Seeds:      append([]SeedState(nil), s.Seeds...),
+	}
+
+	sort.SliceStable(sorted.Tables, func(i, j int) bool {
+		a, b := sorted.Tables[i].Table, sorted.Tables[j].Table
+		return compareKey(a.Schema, a.Name, a.ID) < compareKey(b.Schema, b.Name, b.ID)
+	})
+	for i := range sorted.Tables {
+		sorted.Tables[i].Policies = sortPolicies(sorted.Tables[i].Policies)
+	}
+
+	sort.SliceStable(sorted.Roles, func(i, j int) bool {
+		a, b := sorted.Roles[i].Role, sorted.Roles[j].Role
+		return compareKey(a.Name, a.ID) < compareKey(b.Name, b.ID)
+	})
+
+	sort.SliceStable(sorted.Rpc, func(i, j int) bool {
+		a, b := sorted.Rpc[i].Function, sorted.Rpc[j].Function
+		return compareKey(a.Schema, a.Name, a.ID) < compareKey(b.Schema, b.Name, b.ID)
+	})
+
+	sort.SliceStable(sorted.Storage, func(i, j int) bool {
+		a, b := sorted.Storage[i].Storage, sorted.Storage[j].Storage
+		return compareKey(a.Name, a.ID) < compareKey(b.Name, b.ID)
+	})
+	for i := range sorted.Storage {
+		sorted.Storage[i].Policies = sortPolicies(sorted.Storage[i].Policies)
+	}
+
+	sort.SliceStable(sorted.Types, func(i, j int) bool {
+		a, b := sorted.Types[i].Type, sorted.Types[j].Type
+		return compareKey(a.Schema, a.Name, a.ID) < compareKey(b.Schema, b.Name, b.ID)
+	})
+
+	sort.SliceStable(sorted.Triggers, func(i, j int) bool {
+		a, b := sorted.Triggers[i].Trigger, sorted.Triggers[j].Trigger
+		return compareKey(a.Schema, a.Table, a.Name, a.ID) < compareKey(b.Schema, b.Table, b.Name, b.ID)
+	})
+
+	sort.SliceStable(sorted.Views, func(i, j int) bool {
+		a, b := sorted.Views[i].View, sorted.Views[j].View
+		return compareKey(a.Schema, a.Name, a.ID) < compareKey(b.Schema, b.Name, b.ID)
+	})
+
+	sort.SliceStable(sorted.Extensions, func(i, j int) bool {
+		a, b := sorted.Extensions[i].Extension, sorted.Extensions[j].Extension
+		return compareKey(a.Schema, a.Name) < compareKey(b.Schema, b.Name)
+	})
+
+	sort.SliceStable(sorted.Seeds, func(i, j int) bool {
+		a, b := sorted.Seeds[i], sorted.Seeds[j]
+		return compareKey(a.Table, a.Key) < compareKey(b.Table, b.Key)
+	})
+
+	return sorted
+}
+
+func sortPolicies(policies []objects.Policy) []objects.Policy {
+	if len(policies) == 0 {
+		return policies
+	}
+
+	sorted := append([]objects.Policy(nil), policies...)
+	sort.SliceStable(sorted, func(i, j int) bool {
+		a, b := sorted[i], sorted[j]
+		return compareKey(a.Schema, a.Table, a.Name, a.ID) < compareKey(b.Schema, b.Table, b.Name, b.ID)
+	})
+	return sorted
+}
+
+// compareKey join value as sortable key, number is padded
+// so 10 is placed after 9
+func compareKey(values ...any) string {
+	keys := make([]string, 0, len(values))
+	for _, v := range values {
+		switch val := v.(type) {
+		case int:
+			keys = append(keys, fmt.Sprintf("%020d", val))
+		default:
+			keys = append(keys, fmt.Sprint(val))
+		}
+	}
+	return strings.Join(keys, "\x00")
+}
@@ -7,6 +7,7 @@ import (
 	"strings"
 
 	"github.com/sev-2/raiden"
+	"github.com/sev-2/raiden/pkg/postgres"
 	"github.com/sev-2/raiden/pkg/supabase/objects"
 )
 
@@ -20,29 +21,38 @@ func ExtractRpc(rpcState []RpcState, appRpc []raiden.Rpc) (result ExtractRpcResu
 	mapRpcState := map[string]RpcState{}
 	for i := range rpcState {
 		r := rpcState[i]
-		mapRpcState[r.Function.Name] = r
+		mapRpcState[GetRpcKey(r.Function)] = r
 	}
 
 	for _, r := range appRpc {
-		state, isStateExist := mapRpcState[r.GetName()]
+		fn := objects.Function{}
+		if err := BindRpcFunction(r, &fn); err != nil {
+			return result, err
+		}
+
+		// rpc is identified by argument type, so overloaded rpc
+		// is not replacing each other
+		key := GetRpcKey(fn)
+		state, isStateExist := mapRpcState[key]
 		if !isStateExist {
-			fn := objects.Function{}
-			if err := BindRpcFunction(r, &fn); err != nil {
-				return result, err
-			}
 			result.New = append(result.New, fn)
 			continue
 		}
 
-		fn := state.Function
-		if err := BindRpcFunction(r, &fn); err != nil {
-			return result, err
-		}
+		// rpc is already built, so copy bound value to state function
+		// instead of binding again that will append rpc param twice
+		stateFn := state.Function
+		stateFn.Name = fn.Name
+		stateFn.Schema = fn.Schema
+		stateFn.Language = fn.Language
+		stateFn.CompleteStatement = fn.CompleteStatement
+		stateFn.IdentityArgumentTypes = fn.IdentityArgumentTypes
+		fn = stateFn
 
 		if fn.CompleteStatement != "" {
 			result.Existing = append(result.Existing, fn)
 		}
-		delete(mapRpcState, r.GetName())
+		delete(mapRpcState, key)
 	}
 
 	for _, state := range mapRpcState {
@@ -52,6 +62,108 @@ func ExtractRpc(rpcState []RpcState, appRpc []raiden.Rpc) (result ExtractRpcResu
 	return
 }
 
+// GetRpcKey return rpc signature with schema, name and identity argument types,
+// so rpc with the same name in different schema or overloaded rpc is not mixed.
+// argument name and type alias is not part of the signature, for example
+// (in_id int4, in_name varchar) and (id integer, name character varying) is the same rpc
+func GetRpcKey(fn objects.Function) string {
+	schema := fn.Schema
+	if schema == "" {
+		schema = raiden.DefaultRpcSchema
+	}
+	return fmt.Sprintf("%s.%s(%s)", schema, fn.Name, strings.Join(getRpcArgumentTypes(fn.IdentityArgumentTypes), ", "))
+}
+
+// getRpcArgumentTypes return normalized type of identity arguments,
+// argument mode, name and default value is removed
+func getRpcArgumentTypes(identityArgs string) []string {
+	types := make([]string, 0)
+	for _, arg := range splitRpcArgument(identityArgs, ',') {
+		fields := splitRpcArgument(strings.ToLower(arg), ' ')
+		for i, f := range fields {
+			if f == "default" || strings.HasPrefix(f, "=") {
+				fields = fields[:i]
+				break
+			}
+		}
+
+		if len(fields) == 0 {
+			continue
+		}
+
+		switch fields[0] {
+		case "out":
+			// out argument is not part of function identity
+			continue
+		case "in", "inout", "variadic":
+			fields = fields[1:]
+		}
+
+		if len(fields) > 1 && !isRpcArgumentType(fields) {
+			fields = fields[1:]
+		}
+		types = append(types, normalizeRpcArgumentType(strings.Join(fields, " ")))
+	}
+	return types
+}
+
+// rpcTypeModifierRegex match type modifier and array dimension, for example (255) and [10]
+var rpcTypeModifierRegex = regexp.MustCompile(`\([^)]*\)|\[[^\]]*\]`)
+
+// isRpcArgumentType return true when fields is multi word type without argument name
+func isRpcArgumentType(fields []string) bool {
+	switch strings.Join(strings.Fields(rpcTypeModifierRegex.ReplaceAllString(strings.Join(fields, " "), "")), " ") {
+	case "character varying", "bit varying", "double precision",
+		"timestamp with time zone", "timestamp without time zone",
+		"time with time zone", "time without time zone":
+		return true
+	}
+	return false
+}
+
+// normalizeRpcArgumentType remove type modifier and replace type alias,
+// for example varchar(255)[] is normalized to character varying[]
+func normalizeRpcArgumentType(argType string) string {
+	arraySuffix := strings.Repeat("[]", strings.Count(argType, "["))
+	argType = strings.Join(strings.Fields(rpcTypeModifierRegex.ReplaceAllString(argType, "")), " ")
+	return string(postgres.NormalizeDataType(postgres.DataType(argType))) + arraySuffix
+}
+
+// splitRpcArgument split value by separator that is not
+// inside parentheses or double quote, empty item is removed
+func splitRpcArgument(value string, sep rune) []string {
+	var (
+		items   []string
+		current strings.Builder
+		depth   int
+		quoted  bool
+	)
+
+	flush := func() {
+		if item := strings.TrimSpace(current.String()); item != "" {
+			items = append(items, item)
+		}
+		current.Reset()
+	}
+
+	for _, r := range value {
+		switch {
+		case r == '"':
+			quoted = !quoted
+		case r == '(' && !quoted:
+			depth++
+		case r == ')' && !quoted:
+			depth--
+		case r == sep && depth == 0 && !quoted:
+			flush()
+			continue
+		}
+		current.WriteRune(r)
+	}
+	flush()
+	return items
+}
+
 func BindRpcFunction(rpc raiden.Rpc, fn *objects.Function) (err error) {
 	if err = raiden.BuildRpc(rpc); err != nil {
 		return
@@ -62,6 +174,12 @@ func BindRpcFunction(rpc raiden.Rpc, fn *objects.Function) (err error) {
 	fn.Language = rpc.GetLanguage()
 	fn.CompleteStatement = rpc.GetCompleteStmt()
 
+	identityArgs, err := raiden.RpcParams(rpc.GetParams()).ToIdentityQuery(rpc.UseParamPrefix())
+	if err != nil {
+		return err
+	}
+	fn.IdentityArgumentTypes = identityArgs
+
 	// validate definition query
 	cleanStatement := strings.ReplaceAll(fn.CompleteStatement, "::", "")
 	matches := regexp.MustCompile(`:\w+`).FindAllString(cleanStatement, -1)
@@ -84,7 +202,7 @@ func (er ExtractRpcResult) ToDeleteFlatMap() map[string]*objects.Function {
 	if len(er.Delete) > 0 {
 		for i := range er.Delete {
 			r := er.Delete[i]
-			mapData[r.Name] = &r
+			mapData[GetRpcKey(r)] = &r
 		}
 	}
 
@@ -102,10 +102,36 @@ func TestExtractRpcResult_ToDeleteFlatMap(t *testing.T) {
 
 	mapData := extractRpcResult.ToDeleteFlatMap()
 	assert.Len(t, mapData, 2)
-	assert.Contains(t, mapData, "rpc1")
-	assert.Contains(t, mapData, "rpc2")
-	assert.Equal(t, "rpc1", mapData["rpc1"].Name)
-	assert.Equal(t, "rpc2", mapData["rpc2"].Name)
+	assert.Contains(t, mapData, "public.rpc1()")
+	assert.Contains(t, mapData, "public.rpc2()")
+	assert.Equal(t, "rpc1", mapData["public.rpc1()"].Name)
+	assert.Equal(t, "rpc2", mapData["public.rpc2()"].Name)
+}
+
+type ApiGetSubmissions struct {
+	GetSubmissions
+}
+
+func (r *ApiGetSubmissions) GetSchema() string {
+	return "api"
+}
+
+func TestExtractRpc_MultiSchema(t *testing.T) {
+	rpcStates := []state.RpcState{
+		{Function: objects.Function{Schema: "api", Name: "get_submissions", IdentityArgumentTypes: "scouter_name character varying, candidate_name text", CompleteStatement: "create or replace function api.get_submissions()"}},
+		{Function: objects.Function{Schema: "private", Name: "get_submissions", IdentityArgumentTypes: "scouter_name character varying, candidate_name text"}},
+	}
+
+	appRpcs := []raiden.Rpc{&GetSubmissions{}, &ApiGetSubmissions{}}
+
+	result, err := state.ExtractRpc(rpcStates, appRpcs)
+	assert.NoError(t, err)
+	assert.Len(t, result.New, 1)
+	assert.Equal(t, "public", result.New[0].Schema)
+	assert.Len(t, result.Existing, 1)
+	assert.Equal(t, "api", result.Existing[0].Schema)
+	assert.Len(t, result.Delete, 1)
+	assert.Equal(t, "private", result.Delete[0].Schema)
 }
 
 // Test declaration query with return trigger
@@ -155,3 +181,56 @@ func TestRpcFunction_ReturnTrigger(t *testing.T) {
 	assert.Equal(t, "public", fn.Schema)
 	assert.Equal(t, "create or replace function public.create_profile() returns trigger language plpgsql security definer set search_path = 'public' as $function$ begin insert into public.users (firstname,lastname, email) values ( new.raw_user_meta_data ->> 'name', new.raw_user_meta_data ->> 'name', new.raw_user_meta_data ->> 'email' ) ; return new ; end; $function$", fn.CompleteStatement)
 }
+
+type GetSubmissionsByScouterParams struct {
+	ScouterId int64 `json:"scouter_id" column:"name:scouter_id;type:integer"`
+}
+
+type GetSubmissionsByScouter struct {
+	raiden.RpcBase
+	Params *GetSubmissionsByScouterParams `json:"-"`
+	Return GetSubmissionsResult           `json:"-"`
+}
+
+func (r *GetSubmissionsByScouter) GetName() string {
+	return "get_submissions"
+}
+
+func (r *GetSubmissionsByScouter) GetReturnType() raiden.RpcReturnDataType {
+	return raiden.RpcReturnDataTypeTable
+}
+
+func (r *GetSubmissionsByScouter) GetRawDefinition() string {
+	return `BEGIN RETURN QUERY SELECT s.id, s.created_at, '' as sc_name, '' as c_name FROM submission s WHERE s.scouter_id = :scouter_id; END;`
+}
+
+func TestExtractRpc_Overload(t *testing.T) {
+	rpcStates := []state.RpcState{
+		{Function: objects.Function{Schema: "public", Name: "get_submissions", IdentityArgumentTypes: "scouter_name character varying, candidate_name text", CompleteStatement: "create or replace function public.get_submissions()"}},
+	}
+
+	appRpcs := []raiden.Rpc{&GetSubmissions{}, &GetSubmissionsByScouter{}}
+
+	result, err := state.ExtractRpc(rpcStates, appRpcs)
+	assert.NoError(t, err)
+	assert.Len(t, result.Existing, 1)
+	assert.Equal(t, "scouter_name character varying, candidate_name text", result.Existing[0].IdentityArgumentTypes)
+	assert.Len(t, result.New, 1)
+	assert.Equal(t, "in_scouter_id integer", result.New[0].IdentityArgumentTypes)
+	assert.Len(t, result.Delete, 0)
+	assert.NotEqual(t, state.GetRpcKey(result.Existing[0]), state.GetRpcKey(result.New[0]))
+}
+
+func TestGetRpcKey(t *testing.T) {
+	key := state.GetRpcKey(objects.Function{Schema: "public", Name: "get_submissions", IdentityArgumentTypes: "in_id int4, in_name varchar(255), in_tags text[]"})
+	assert.Equal(t, "public.get_submissions(integer, character varying, text[])", key)
+
+	// argument name, mode and type alias is not part of the key
+	assert.Equal(t, key, state.GetRpcKey(objects.Function{Schema: "public", Name: "get_submissions", IdentityArgumentTypes: "id integer, IN \"Name\" character varying, VARIADIC tags text[]"}))
+	assert.Equal(t, key, state.GetRpcKey(objects.Function{Schema: "public", Name: "get_submissions", IdentityArgumentTypes: "integer, character varying, text[]"}))
+
+	assert.Equal(t, "public.get_submissions(timestamp with time zone, numeric)", state.GetRpcKey(objects.Function{Name: "get_submissions", IdentityArgumentTypes: "created_at timestamptz, amount numeric(10,2)"}))
+	assert.Equal(t, "public.get_submissions(timestamp with time zone, double precision)", state.GetRpcKey(objects.Function{Name: "get_submissions", IdentityArgumentTypes: "timestamp(3) with time zone, double precision"}))
+	assert.Equal(t, "public.get_submissions()", state.GetRpcKey(objects.Function{Name: "get_submissions"}))
+	assert.NotEqual(t, key, state.GetRpcKey(objects.Function{Schema: "private", Name: "get_submissions", IdentityArgumentTypes: "id integer, name character varying, tags text[]"}))
+}
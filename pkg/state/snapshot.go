@@ -0,0 +1,213 @@
+package state
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/sev-2/raiden/pkg/supabase/objects"
+)
+
+// MarshalSnapshot encode state as json so state can be stored
+// in migration history and restored for rollback
+func MarshalSnapshot(s *State) ([]byte, error) {
+	if s == nil {
+		s = &State{}
+	}
+	return json.Marshal(s)
+}
+
+func UnmarshalSnapshot(data []byte) (*State, error) {
+	s := &State{}
+	if len(data) == 0 {
+		return s, nil
+	}
+
+	if err := json.Unmarshal(data, s); err != nil {
+		return nil, fmt.Errorf("invalid state snapshot : %s", err)
+	}
+	return s, nil
+}
+
+// Checksum return sha256 of encoded state
+func Checksum(s *State) (string, error) {
+	data, err := MarshalSnapshot(s)
+	if err != nil {
+		return "", err
+	}
+
+	sum := sha256.Sum256(data)
+	return hex.EncodeToString(sum[:]), nil
+}
+
+// ExtractSnapshot compare current state with snapshot state and return resource
+// that need to apply for restore the snapshot, all snapshot resource is marked
+// as existing and resource that only exist in current state is marked as delete
+func ExtractSnapshot(current *State, snapshot *State) (
+	extractedTable ExtractTableResult, extractedRole ExtractRoleResult,
+	extractedRpc ExtractRpcResult, extractedStorage ExtractStorageResult,
+	extractedType ExtractTypeResult, extractedTrigger ExtractTriggerResult,
+	extractedView ExtractViewResult, extractedExtension ExtractExtensionResult,
+) {
+	if current == nil {
+		current = &State{}
+	}
+
+	if snapshot == nil {
+		snapshot = &State{}
+	}
+
+	// table and table policy
+	mapCurrentTable := make(map[string]TableState)
+	for _, t := range current.Tables {
+		mapCurrentTable[getSnapshotKey(t.Table.Schema, t.Table.Name)] = t
+	}
+
+	mapSnapshotTable := make(map[string]bool)
+	for _, t := range snapshot.Tables {
+		key := getSnapshotKey(t.Table.Schema, t.Table.Name)
+		mapSnapshotTable[key] = true
+
+		item := ExtractTableItem{Table: t.Table}
+		item.ExtractedPolicies.Existing = t.Policies
+		if ct, exist := mapCurrentTable[key]; exist {
+			item.ExtractedPolicies.Delete = getDeletedPolicies(ct.Policies, t.Policies)
+		}
+		extractedTable.Existing = append(extractedTable.Existing, item)
+	}
+
+	for _, t := range current.Tables {
+		if !mapSnapshotTable[getSnapshotKey(t.Table.Schema, t.Table.Name)] {
+			extractedTable.Delete = append(extractedTable.Delete, ExtractTableItem{Table: t.Table})
+		}
+	}
+
+	// role
+	mapSnapshotRole := make(map[string]bool)
+	for _, r := range snapshot.Roles {
+		if r.IsNative {
+			continue
+		}
+		mapSnapshotRole[r.Role.Name] = true
+		extractedRole.Existing = append(extractedRole.Existing, r.Role)
+	}
+
+	for _, r := range current.Roles {
+		if !r.IsNative && !mapSnapshotRole[r.Role.Name] {
+			extractedRole.Delete = append(extractedRole.Delete, r.Role)
+		}
+	}
+
+	// rpc
+	mapSnapshotRpc := make(map[string]bool)
+	for _, r := range snapshot.Rpc {
+		mapSnapshotRpc[GetRpcKey(r.Function)] = true
+		extractedRpc.Existing = append(extractedRpc.Existing, r.Function)
+	}
+
+	for _, r := range current.Rpc {
+		if !mapSnapshotRpc[GetRpcKey(r.Function)] {
+			extractedRpc.Delete = append(extractedRpc.Delete, r.Function)
+		}
+	}
+
+	// storage and storage policy
+	mapCurrentStorage := make(map[string]StorageState)
+	for _, s := range current.Storage {
+		mapCurrentStorage[s.Storage.Name] = s
+	}
+
+	mapSnapshotStorage := make(map[string]bool)
+	for _, s := range snapshot.Storage {
+		mapSnapshotStorage[s.Storage.Name] = true
+
+		item := ExtractStorageItem{Storage: s.Storage}
+		item.ExtractedPolicies.Existing = s.Policies
+		if cs, exist := mapCurrentStorage[s.Storage.Name]; exist {
+			item.ExtractedPolicies.Delete = getDeletedPolicies(cs.Policies, s.Policies)
+		}
+		extractedStorage.Existing = append(extractedStorage.Existing, item)
+	}
+
+	for _, s := range current.Storage {
+		if !mapSnapshotStorage[s.Storage.Name] {
+			item := ExtractStorageItem{Storage: s.Storage}
+			item.ExtractedPolicies.Existing = s.Policies
+			extractedStorage.Delete = append(extractedStorage.Delete, item)
+		}
+	}
+
+	// type
+	mapSnapshotType := make(map[string]bool)
+	for _, t := range snapshot.Types {
+		mapSnapshotType[getSnapshotKey(t.Type.Schema, t.Type.Name)] = true
+		extractedType.Existing = append(extractedType.Existing, t.Type)
+	}
+
+	for _, t := range current.Types {
+		if !mapSnapshotType[getSnapshotKey(t.Type.Schema, t.Type.Name)] {
+			extractedType.Delete = append(extractedType.Delete, t.Type)
+		}
+	}
+
+	// trigger
+	mapSnapshotTrigger := make(map[string]bool)
+	for _, t := range snapshot.Triggers {
+		mapSnapshotTrigger[getSnapshotKey(t.Trigger.Schema, t.Trigger.Table, t.Trigger.Name)] = true
+		extractedTrigger.Existing = append(extractedTrigger.Existing, t.Trigger)
+	}
+
+	for _, t := range current.Triggers {
+		if !mapSnapshotTrigger[getSnapshotKey(t.Trigger.Schema, t.Trigger.Table, t.Trigger.Name)] {
+			extractedTrigger.Delete = append(extractedTrigger.Delete, t.Trigger)
+		}
+	}
+
+	// view
+	mapSnapshotView := make(map[string]bool)
+	for _, v := range snapshot.Views {
+		mapSnapshotView[getSnapshotKey(v.View.Schema, v.View.Name)] = true
+		extractedView.Existing = append(extractedView.Existing, v.View)
+	}
+
+	for _, v := range current.Views {
+		if !mapSnapshotView[getSnapshotKey(v.View.Schema, v.View.Name)] {
+			extractedView.Delete = append(extractedView.Delete, v.View)
+		}
+	}
+
+	// extension
+	mapSnapshotExtension := make(map[string]bool)
+	for _, e := range snapshot.Extensions {
+		mapSnapshotExtension[e.Extension.Name] = true
+		extractedExtension.Existing = append(extractedExtension.Existing, e.Extension)
+	}
+
+	for _, e := range current.Extensions {
+		if !mapSnapshotExtension[e.Extension.Name] {
+			extractedExtension.Delete = append(extractedExtension.Delete, e.Extension)
+		}
+	}
+
+	return
+}
+
+func getDeletedPolicies(currentPolicies []objects.Policy, snapshotPolicies []objects.Policy) (deleted []objects.Policy) {
+	mapSnapshotPolicy := make(map[string]bool)
+	for _, p := range snapshotPolicies {
+		mapSnapshotPolicy[p.Name] = true
+	}
+
+	for _, p := range currentPolicies {
+		if !mapSnapshotPolicy[p.Name] {
+			deleted = append(deleted, p)
+		}
+	}
+	return
+}
+
+func getSnapshotKey(names ...string) string {
+	return strings.Join(names, ".")
+}
@@ -0,0 +1,92 @@
+package state_test
+
+import (
+	"testing"
+
+	"github.com/sev-2/raiden/pkg/state"
+	"github.com/sev-2/raiden/pkg/supabase/objects"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSnapshot_MarshalAndChecksum(t *testing.T) {
+	s := &state.State{
+		Tables: []state.TableState{
+			{Table: objects.Table{ID: 1, Schema: "public", Name: "posts"}, ModelStruct: "Posts"},
+		},
+	}
+
+	data, err := state.MarshalSnapshot(s)
+	assert.NoError(t, err)
+
+	restored, err := state.UnmarshalSnapshot(data)
+	assert.NoError(t, err)
+	assert.Equal(t, "posts", restored.Tables[0].Table.Name)
+	assert.Equal(t, "Posts", restored.Tables[0].ModelStruct)
+
+	checksum, err := state.Checksum(s)
+	assert.NoError(t, err)
+	assert.Len(t, checksum, 64)
+
+	sameChecksum, err := state.Checksum(restored)
+	assert.NoError(t, err)
+	assert.Equal(t, checksum, sameChecksum)
+
+	_, err = state.UnmarshalSnapshot([]byte("invalid"))
+	assert.Error(t, err)
+}
+
+func TestExtractSnapshot(t *testing.T) {
+	readPolicy := objects.Policy{Name: "read posts", Table: "posts"}
+	writePolicy := objects.Policy{Name: "write posts", Table: "posts"}
+
+	snapshot := &state.State{
+		Tables: []state.TableState{
+			{Table: objects.Table{ID: 1, Schema: "public", Name: "posts"}, Policies: []objects.Policy{readPolicy}},
+			{Table: objects.Table{ID: 2, Schema: "public", Name: "deleted_table"}},
+		},
+		Roles: []state.RoleState{
+			{Role: objects.Role{Name: "editor"}},
+			{Role: objects.Role{Name: "anon"}, IsNative: true},
+		},
+		Rpc:        []state.RpcState{{Function: objects.Function{Schema: "public", Name: "get_posts"}}},
+		Extensions: []state.ExtensionState{{Extension: objects.Extension{Name: "pg_trgm"}}},
+	}
+
+	current := &state.State{
+		Tables: []state.TableState{
+			{Table: objects.Table{ID: 1, Schema: "public", Name: "posts"}, Policies: []objects.Policy{readPolicy, writePolicy}},
+			{Table: objects.Table{ID: 3, Schema: "public", Name: "new_table"}},
+		},
+		Roles: []state.RoleState{
+			{Role: objects.Role{Name: "editor"}},
+			{Role: objects.Role{Name: "viewer"}},
+		},
+		Views:      []state.ViewState{{View: objects.View{Schema: "public", Name: "active_posts"}}},
+		Extensions: []state.ExtensionState{{Extension: objects.Extension{Name: "pg_trgm"}}},
+	}
+
+	tables, roles, rpc, storages, types, triggers, views, extensions := state.ExtractSnapshot(current, snapshot)
+
+	assert.Equal(t, 2, len(tables.Existing))
+	assert.Equal(t, []objects.Policy{readPolicy}, tables.Existing[0].ExtractedPolicies.Existing)
+	assert.Equal(t, []objects.Policy{writePolicy}, tables.Existing[0].ExtractedPolicies.Delete)
+	assert.Equal(t, 1, len(tables.Delete))
+	assert.Equal(t, "new_table", tables.Delete[0].Table.Name)
+
+	assert.Equal(t, 1, len(roles.Existing))
+	assert.Equal(t, "editor", roles.Existing[0].Name)
+	assert.Equal(t, 1, len(roles.Delete))
+	assert.Equal(t, "viewer", roles.Delete[0].Name)
+
+	assert.Equal(t, 1, len(rpc.Existing))
+	assert.Empty(t, rpc.Delete)
+	assert.Empty(t, storages.Existing)
+	assert.Empty(t, types.Existing)
+	assert.Empty(t, triggers.Existing)
+
+	assert.Empty(t, views.Existing)
+	assert.Equal(t, 1, len(views.Delete))
+
+	assert.Equal(t, 1, len(extensions.Existing))
+	assert.Empty(t, extensions.Delete)
+}
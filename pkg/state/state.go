@@ -1,7 +1,6 @@
 package state
 
 import (
-	"encoding/gob"
 	"os"
 	"path/filepath"
 	"strings"
@@ -19,11 +18,15 @@ var StateLogger = logger.HcLog().Named("raiden.state")
 
 type (
 	State struct {
-		Tables  []TableState
-		Roles   []RoleState
-		Rpc     []RpcState
-		Storage []StorageState
-		Types   []TypeState
+		Tables     []TableState
+		Roles      []RoleState
+		Rpc        []RpcState
+		Storage    []StorageState
+		Types      []TypeState
+		Triggers   []TriggerState
+		Views      []ViewState
+		Extensions []ExtensionState
+		Seeds      []SeedState
 	}
 
 	TableState struct {
@@ -100,11 +103,45 @@ type (
 		TypeStruct string
 		LastUpdate time.Time
 	}
+
+	TriggerState struct {
+		Trigger       objects.Trigger
+		TriggerPath   string
+		TriggerStruct string
+		LastUpdate    time.Time
+	}
+
+	ViewState struct {
+		View       objects.View
+		ViewPath   string
+		ViewStruct string
+		LastUpdate time.Time
+	}
+
+	ExtensionState struct {
+		Extension       objects.Extension
+		ExtensionPath   string
+		ExtensionStruct string
+		LastUpdate      time.Time
+	}
+
+	// SeedState is checksum of seed row that already upserted,
+	// row is identified by table and primary key value
+	SeedState struct {
+		Table      string
+		Key        string
+		Checksum   string
+		LastUpdate time.Time
+	}
 )
 
 var (
 	StateFileDir  = "build"
-	StateFileName = "state"
+	StateFileName = "state.json"
+
+	// LegacyStateFileName is gob encoded state file from previous version,
+	// the file is converted to json state when loaded
+	LegacyStateFileName = "state"
 )
 
 func (s *LocalState) AddTable(table TableState) {
@@ -353,6 +390,171 @@ func (s *LocalState) DeleteType(typeId int) {
 	s.NeedUpdate = true
 }
 
+func (s *LocalState) AddTrigger(t TriggerState) {
+	s.Mutex.Lock()
+	defer s.Mutex.Unlock()
+	s.State.Triggers = append(s.State.Triggers, t)
+	s.NeedUpdate = true
+}
+
+func (s *LocalState) FindTrigger(triggerId int) (index int, tState TriggerState, found bool) {
+	s.Mutex.Lock()
+	defer s.Mutex.Unlock()
+
+	found = false
+
+	for i := range s.State.Triggers {
+		r := s.State.Triggers[i]
+
+		if r.Trigger.ID == triggerId {
+			found = true
+			tState = r
+			index = i
+			return
+		}
+	}
+	return
+}
+
+func (s *LocalState) UpdateTrigger(index int, state TriggerState) {
+	s.Mutex.Lock()
+	defer s.Mutex.Unlock()
+
+	s.State.Triggers[index] = state
+	s.NeedUpdate = true
+}
+
+func (s *LocalState) DeleteTrigger(triggerId int) {
+	s.Mutex.Lock()
+	defer s.Mutex.Unlock()
+
+	index := -1
+	for i := range s.State.Triggers {
+		r := s.State.Triggers[i]
+
+		if r.Trigger.ID == triggerId {
+			index = i
+			break
+		}
+	}
+
+	if index == -1 {
+		return
+	}
+	s.State.Triggers = append(s.State.Triggers[:index], s.State.Triggers[index+1:]...)
+	s.NeedUpdate = true
+}
+
+func (s *LocalState) AddView(v ViewState) {
+	s.Mutex.Lock()
+	defer s.Mutex.Unlock()
+	s.State.Views = append(s.State.Views, v)
+	s.NeedUpdate = true
+}
+
+func (s *LocalState) FindView(viewId int) (index int, vState ViewState, found bool) {
+	s.Mutex.Lock()
+	defer s.Mutex.Unlock()
+
+	found = false
+
+	for i := range s.State.Views {
+		r := s.State.Views[i]
+
+		if r.View.ID == viewId {
+			found = true
+			vState = r
+			index = i
+			return
+		}
+	}
+	return
+}
+
+func (s *LocalState) UpdateView(index int, state ViewState) {
+	s.Mutex.Lock()
+	defer s.Mutex.Unlock()
+
+	s.State.Views[index] = state
+	s.NeedUpdate = true
+}
+
+func (s *LocalState) DeleteView(viewId int) {
+	s.Mutex.Lock()
+	defer s.Mutex.Unlock()
+
+	index := -1
+	for i := range s.State.Views {
+		r := s.State.Views[i]
+
+		if r.View.ID == viewId {
+			index = i
+			break
+		}
+	}
+
+	if index == -1 {
+		return
+	}
+	s.State.Views = append(s.State.Views[:index], s.State.Views[index+1:]...)
+	s.NeedUpdate = true
+}
+
+func (s *LocalState) AddExtension(e ExtensionState) {
+	s.Mutex.Lock()
+	defer s.Mutex.Unlock()
+	s.State.Extensions = append(s.State.Extensions, e)
+	s.NeedUpdate = true
+}
+
+func (s *LocalState) FindExtension(name string) (index int, eState ExtensionState, found bool) {
+	s.Mutex.Lock()
+	defer s.Mutex.Unlock()
+
+	found = false
+
+	for i := range s.State.Extensions {
+		r := s.State.Extensions[i]
+
+		if r.Extension.Name == name {
+			found = true
+			eState = r
+			index = i
+			return
+		}
+	}
+	return
+}
+
+func (s *LocalState) UpdateExtension(index int, state ExtensionState) {
+	s.Mutex.Lock()
+	defer s.Mutex.Unlock()
+
+	s.State.Extensions[index] = state
+	s.NeedUpdate = true
+}
+
+func (s *LocalState) DeleteExtension(name string) {
+	s.Mutex.Lock()
+	defer s.Mutex.Unlock()
+
+	index := -1
+	for i := range s.State.Extensions {
+		r := s.State.Extensions[i]
+
+		if r.Extension.Name == name {
+			index = i
+			break
+		}
+	}
+
+	if index == -1 {
+		return
+	}
+	s.State.Extensions = append(s.State.Extensions[:index], s.State.Extensions[index+1:]...)
+	s.NeedUpdate = true
+}
+
 func (s *LocalState) FindStorageByPermissionName(name string) (index int, storageState StorageState, found bool) {
 	// find storage name
 	splitName := strings.SplitN(name, supabase.RlsTypeStorage, 2)
@@ -424,7 +626,17 @@ func (s *LocalState) Persist() error {
 	return nil
 }
 
+// Save store state to current backend
 func Save(state *State) error {
+	return currentBackend.Save(state)
+}
+
+// Load read state from current backend
+func Load() (*State, error) {
+	return currentBackend.Load()
+}
+
+func saveFile(state *State) error {
 	StateLogger.Debug("save - start save state")
 	filePath, err := GetStateFilePath()
 	if err != nil {
@@ -439,17 +651,14 @@ func Save(state *State) error {
 		StateLogger.Debug("save - create temporary state", "path", tmpFilePath)
 	}
 
-	file, err := createOrLoadFile(filePath)
+	StateLogger.Debug("save -generate local state", "path", filePath)
+	data, err := MarshalState(state)
 	if err != nil {
+		RestoreFromTmp(tmpFilePath)
 		return err
 	}
 
-	defer file.Close()
-
-	StateLogger.Debug("save -generate local state", "path", filePath)
-	gob.Register(map[string]interface{}{})
-	encoder := gob.NewEncoder(file)
-	if err := encoder.Encode(state); err != nil {
+	if err := os.WriteFile(filePath, data, 0644); err != nil {
 		RestoreFromTmp(tmpFilePath)
 		return err
 	}
@@ -505,40 +714,55 @@ func RestoreFromTmp(tmpFile string) {
 	StateLogger.Debug("file is not exist", "path", tmpFile)
 }
 
-func Load() (*State, error) {
+func loadFile() (*State, error) {
 	filePath, err := GetStateFilePath()
 	if err != nil {
 		return nil, err
 	}
 
 	if !utils.IsFileExists(filePath) {
+		legacyFilePath := filepath.Join(filepath.Dir(filePath), LegacyStateFileName)
+		if utils.IsFileExists(legacyFilePath) {
+			return upgradeLegacyState(legacyFilePath)
+		}
+
 		initialState := &State{}
 		// save empty state
-		err := Save(initialState)
+		err := saveFile(initialState)
 		if err != nil {
 			return nil, err
 		}
 		return initialState, nil
 	}
 
-	file, err := os.Open(filePath)
+	data, err := os.ReadFile(filePath)
 	if err != nil {
 		return nil, err
 	}
-	defer file.Close()
+	return UnmarshalState(data)
+}
 
-	state := &State{}
-	gob.Register(map[string]interface{}{})
-	decoder := gob.NewDecoder(file)
-	if err := decoder.Decode(state); err != nil {
+// upgradeLegacyState convert gob state file to json state file,
+// legacy file is removed after json state is saved
+func upgradeLegacyState(legacyFilePath string) (*State, error) {
+	StateLogger.Info("upgrade legacy state file", "path", legacyFilePath)
+	file, err := os.Open(legacyFilePath)
+	if err != nil {
+		return nil, err
+	}
+
+	state, err := decodeLegacyState(file)
+	file.Close()
+	if err != nil {
 		return nil, err
 	}
-	return state, nil
-}
 
-func createOrLoadFile(filePath string) (file *os.File, err error) {
-	if utils.IsFileExists(filePath) {
-		return os.OpenFile(filePath, os.O_WRONLY|os.O_TRUNC|os.O_CREATE, 0644)
+	if err := saveFile(state); err != nil {
+		return nil, err
 	}
-	return os.Create(filePath)
+
+	if err := utils.DeleteFile(legacyFilePath); err != nil {
+		return nil, err
+	}
+	return state, nil
 }
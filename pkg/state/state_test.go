@@ -1,6 +1,7 @@
 package state_test
 
 import (
+	"encoding/gob"
 	"os"
 	"path/filepath"
 	"testing"
@@ -343,3 +344,67 @@ func TestLoad(t *testing.T) {
 	_, err := state.Load()
 	assert.NoError(t, err)
 }
+
+func TestMarshalState_Deterministic(t *testing.T) {
+	first := &state.State{
+		Tables: []state.TableState{
+			{Table: objects.Table{ID: 2, Name: "b_table", Schema: "public"}},
+			{Table: objects.Table{ID: 1, Name: "a_table", Schema: "public"}},
+		},
+		Roles: []state.RoleState{
+			{Role: objects.Role{ID: 2, Name: "b_role"}},
+			{Role: objects.Role{ID: 1, Name: "a_role"}},
+		},
+	}
+	second := &state.State{
+		Tables: []state.TableState{first.Tables[1], first.Tables[0]},
+		Roles:  []state.RoleState{first.Roles[1], first.Roles[0]},
+	}
+
+	firstData, err := state.MarshalState(first)
+	assert.NoError(t, err)
+
+	secondData, err := state.MarshalState(second)
+	assert.NoError(t, err)
+	assert.Equal(t, string(firstData), string(secondData))
+
+	// original order is not changed
+	assert.Equal(t, "b_table", first.Tables[0].Table.Name)
+
+	decoded, err := state.UnmarshalState(firstData)
+	assert.NoError(t, err)
+	assert.Equal(t, "a_table", decoded.Tables[0].Table.Name)
+	assert.Equal(t, "a_role", decoded.Roles[0].Role.Name)
+}
+
+func TestLoad_UpgradeLegacyState(t *testing.T) {
+	filePath, err := state.GetStateFilePath()
+	assert.NoError(t, err)
+	os.Remove(filePath)
+	defer func() {
+		assert.NoError(t, state.Save(&state.State{}))
+	}()
+
+	legacyFilePath := filepath.Join(filepath.Dir(filePath), state.LegacyStateFileName)
+	file, err := os.Create(legacyFilePath)
+	assert.NoError(t, err)
+
+	legacyState := &state.State{
+		Tables: []state.TableState{{Table: objects.Table{ID: 1, Name: "legacy_table"}}},
+	}
+	gob.Register(map[string]interface{}{})
+	assert.NoError(t, gob.NewEncoder(file).Encode(legacyState))
+	assert.NoError(t, file.Close())
+
+	loaded, err := state.Load()
+	assert.NoError(t, err)
+	assert.Len(t, loaded.Tables, 1)
+	assert.Equal(t, "legacy_table", loaded.Tables[0].Table.Name)
+
+	assert.NoFileExists(t, legacyFilePath)
+	assert.FileExists(t, filePath)
+
+	reloaded, err := state.Load()
+	assert.NoError(t, err)
+	assert.Equal(t, "legacy_table", reloaded.Tables[0].Table.Name)
+}
@@ -3,6 +3,7 @@ package state
 import (
 	"fmt"
 	"reflect"
+	"regexp"
 	"strconv"
 	"strings"
 
@@ -19,6 +20,7 @@ type ExtractTableItem struct {
 	Table             objects.Table
 	ValidationTags    ModelValidationTag
 	ExtractedPolicies ExtractedPolicies
+	AllowDestructive  bool
 }
 
 type ExtractTableItems []ExtractTableItem
@@ -41,6 +43,16 @@ func ExtractTable(tableStates []TableState, appTable []any, mapDataType map[stri
 		tableName := raiden.GetTableName(t)
 		ts, isExist := mapTableState[tableName]
 
+		// renamed table use state of old table name,
+		// so table is renamed instead of dropped and created
+		if !isExist {
+			if renamedFrom := getTableRenamedFrom(t); renamedFrom != "" {
+				if ts, isExist = mapTableState[renamedFrom]; isExist {
+					tableName = renamedFrom
+				}
+			}
+		}
+
 		if !isExist {
 			nt := buildTableFromModel(t, mapDataType)
 			result.New = append(result.New, nt)
@@ -80,11 +92,13 @@ func buildTableFromModel(model any, mapDataType map[string]objects.Type) (ei Ext
 
 	ei.Table.Name = raiden.GetTableName(model)
 	ei.ValidationTags = make(ModelValidationTag)
+	var indexColumns []string
 
 	// add metadata
 	metadataField, isExist := modelType.FieldByName("Metadata")
 	if isExist {
 		bindTableMetadata(&metadataField, &ei.Table)
+		ei.AllowDestructive = isAllowDestructive(&metadataField)
 	} else {
 		ei.Table.Schema = "public"
 		ei.Table.RLSEnabled = true
@@ -118,6 +132,10 @@ func buildTableFromModel(model any, mapDataType map[string]objects.Type) (ei Ext
 
 				ei.Table.Columns = append(ei.Table.Columns, c)
 
+				if ct.Index {
+					indexColumns = append(indexColumns, c.Name)
+				}
+
 				if ct.PrimaryKey {
 					ei.Table.PrimaryKeys = append(ei.Table.PrimaryKeys, objects.PrimaryKey{
 						Name:      c.Name,
@@ -187,6 +205,10 @@ func buildTableFromModel(model any, mapDataType map[string]objects.Type) (ei Ext
 		}
 	}
 
+	// add index
+	ei.Table.Indexes = buildTableIndexes(model, ei.Table, indexColumns)
+	ei.Table.Publications = buildTablePublications(model, ei.Table)
+
 	// add metadata
 	aclField, isExist := modelType.FieldByName("Acl")
 	if isExist {
@@ -237,15 +259,18 @@ func buildTableFromState(model any, mapDataType map[string]objects.Type, state T
 	var columns []objects.Column
 	var relations []objects.TablesRelationship
 	var primaryKeys []objects.PrimaryKey
+	var indexColumns []string
 
 	// update metadata
 	metadataField, isExist := modelType.FieldByName("Metadata")
 	if isExist {
 		bindTableMetadata(&metadataField, &ei.Table)
+		ei.AllowDestructive = isAllowDestructive(&metadataField)
 	} else {
 		ei.Table.Schema = "public"
 		ei.Table.RLSEnabled = true
 		ei.Table.RLSForced = false
+		ei.Table.Publications = nil
 	}
 
 	// Iterate over the fields of the struct
@@ -264,6 +289,13 @@ func buildTableFromState(model any, mapDataType map[string]objects.Type, state T
 				ct := raiden.UnmarshalColumnTag(columnTag)
 				if found, exist := mapColumn[ct.Name]; exist {
 					c = found
+				} else if found, exist := mapColumn[ct.RenamedFrom]; exist && ct.RenamedFrom != "" {
+					// renamed column keep id of old column for detecting rename
+					c = found
+					if pk, exist := mapPrimaryKey[ct.RenamedFrom]; exist {
+						pk.Name = ct.Name
+						mapPrimaryKey[ct.Name] = pk
+					}
 				}
 
 				c.Table = ei.Table.Name
@@ -298,6 +330,10 @@ func buildTableFromState(model any, mapDataType map[string]objects.Type, state T
 					ei.ValidationTags[c.Name] = vTag
 				}
 
+				if ct.Index {
+					indexColumns = append(indexColumns, c.Name)
+				}
+
 				columns = append(columns, c)
 			}
 
@@ -359,10 +395,115 @@ func buildTableFromState(model any, mapDataType map[string]objects.Type, state T
 	ei.Table.Columns = columns
 	ei.Table.Relationships = relations
 	ei.Table.PrimaryKeys = primaryKeys
+	ei.Table.Indexes = buildTableIndexes(model, ei.Table, indexColumns)
+	ei.Table.Publications = buildTablePublications(model, ei.Table)
 
 	return ei
 }
 
+// buildTableIndexes collect index declared in column tag
+// and in Indexes method when model implement raiden.IndexedModel
+func buildTableIndexes(model any, table objects.Table, indexColumns []string) (indexes []objects.Index) {
+	for _, c := range indexColumns {
+		indexes = append(indexes, objects.Index{
+			Schema:  table.Schema,
+			Table:   table.Name,
+			Name:    GetIndexName(table.Name, []string{c}),
+			Columns: []string{c},
+			Method:  string(raiden.IndexMethodBtree),
+		})
+	}
+
+	m, isIndexed := model.(raiden.IndexedModel)
+	if !isIndexed {
+		return
+	}
+
+	for _, idx := range m.Indexes() {
+		if len(idx.Columns) == 0 {
+			continue
+		}
+
+		name := idx.Name
+		if name == "" {
+			name = GetIndexName(table.Name, idx.Columns)
+		}
+
+		method := idx.Method
+		if method == "" {
+			method = raiden.IndexMethodBtree
+		}
+
+		indexes = append(indexes, objects.Index{
+			Schema:    table.Schema,
+			Table:     table.Name,
+			Name:      name,
+			Columns:   idx.Columns,
+			Method:    string(method),
+			IsUnique:  idx.Unique,
+			Predicate: idx.Where,
+		})
+	}
+
+	return
+}
+
+// buildTablePublications merge publication from realtime tag
+// with publication declared in Publications method
+func buildTablePublications(model any, table objects.Table) (publications []objects.TablePublication) {
+	m, isPublished := model.(raiden.PublishedModel)
+	if !isPublished {
+		return table.Publications
+	}
+
+	mapPublication := make(map[string]bool)
+	for _, p := range m.Publications() {
+		name := p.Name
+		if name == "" {
+			name = raiden.DefaultPublication
+		}
+
+		if mapPublication[name] {
+			continue
+		}
+		mapPublication[name] = true
+
+		publications = append(publications, objects.TablePublication{
+			Name:      name,
+			Columns:   p.Columns,
+			RowFilter: p.Where,
+		})
+	}
+
+	for _, p := range table.Publications {
+		if !mapPublication[p.Name] {
+			publications = append(publications, p)
+		}
+	}
+
+	return
+}
+
+var indexNameCleanRegex = regexp.MustCompile(`[^a-z0-9]+`)
+
+// GetIndexName return default index name, postgres truncate
+// identifier longer than 63 character so name is truncated too
+func GetIndexName(table string, columns []string) string {
+	var parts []string
+	for _, c := range columns {
+		part := strings.Trim(indexNameCleanRegex.ReplaceAllString(strings.ToLower(c), "_"), "_")
+		if part != "" {
+			parts = append(parts, part)
+		}
+	}
+
+	name := fmt.Sprintf("idx_%s_%s", table, strings.Join(parts, "_"))
+	if len(name) > 63 {
+		name = name[:63]
+	}
+	return name
+}
+
 func bindColumn(field *reflect.StructField, ct *raiden.ColumnTag, c *objects.Column) {
 	c.IsNullable = ct.Nullable
 	c.IsUnique = ct.Unique
@@ -385,6 +526,27 @@ func bindColumn(field *reflect.StructField, ct *raiden.ColumnTag, c *objects.Col
 	if ct.AutoIncrement {
 		c.IsIdentity = true
 		c.IdentityGeneration = "BY DEFAULT"
+		if ct.IdentityGeneration != "" {
+			c.IdentityGeneration = ct.IdentityGeneration
+		}
+	}
+
+	// generation expression is stored as default value, the same as column from database
+	c.IsGenerated = false
+	if ct.Generated != "" {
+		generated := ct.Generated
+		c.IsGenerated = true
+		c.DefaultValue = &generated
+	}
+
+	c.Check = nil
+	if ct.Check != "" {
+		c.Check = ct.Check
+	}
+
+	c.Comment = nil
+	if ct.Comment != "" {
+		c.Comment = ct.Comment
 	}
 
 	if len(c.Enums) == 0 {
@@ -414,6 +576,42 @@ func bindTableMetadata(field *reflect.StructField, table *objects.Table) {
 	} else {
 		table.RLSForced = false
 	}
+
+	table.Comment = nil
+	if comment := field.Tag.Get("comment"); len(comment) > 0 {
+		table.Comment = comment
+	}
+
+	table.Publications = nil
+	if realtime := field.Tag.Get("realtime"); len(realtime) > 0 {
+		if isRealtime, err := strconv.ParseBool(realtime); err == nil && isRealtime {
+			table.Publications = append(table.Publications, objects.TablePublication{Name: raiden.DefaultPublication})
+		}
+	}
+}
+
+// getTableRenamedFrom return old table name from renamedFrom tag in metadata
+func getTableRenamedFrom(model any) string {
+	modelType := reflect.TypeOf(model)
+	if modelType.Kind() == reflect.Ptr {
+		modelType = modelType.Elem()
+	}
+
+	if field, isExist := modelType.FieldByName("Metadata"); isExist {
+		return field.Tag.Get("renamedFrom")
+	}
+	return ""
+}
+
+// isAllowDestructive check allowDestructive tag in metadata,
+// model with this tag can drop column or change column data type when apply
+func isAllowDestructive(field *reflect.StructField) bool {
+	if allowDestructive := field.Tag.Get("allowDestructive"); len(allowDestructive) > 0 {
+		if isAllow, err := strconv.ParseBool(allowDestructive); err == nil {
+			return isAllow
+		}
+	}
+	return false
 }
 
 func getPolicies(field *reflect.StructField, ei *ExtractTableItem) (policies []objects.Policy) {
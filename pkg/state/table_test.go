@@ -4,6 +4,7 @@ import (
 	"testing"
 	"time"
 
+	"github.com/sev-2/raiden"
 	"github.com/sev-2/raiden/pkg/state"
 	"github.com/sev-2/raiden/pkg/supabase/objects"
 	"github.com/stretchr/testify/assert"
@@ -43,6 +44,56 @@ type Candidate struct {
 	Submission []*Submission `json:"submission,omitempty" join:"joinType:hasMany;primaryKey:id;foreignKey:candidate_id"  onUpdate:"cascade" onDelete:"cascade"`
 }
 
+type Recruiter struct {
+	Id        int64      `json:"id,omitempty" column:"name:id;type:bigint;primaryKey;autoIncrement;nullable:false"`
+	Email     string     `json:"email,omitempty" column:"name:email;type:text;nullable:false"`
+	Tags      []string   `json:"tags,omitempty" column:"name:tags;type:text[];nullable"`
+	CreatedAt *time.Time `json:"created_at,omitempty" column:"name:created_at;type:timestampz;nullable;default:now();index"`
+	DeletedAt *time.Time `json:"deleted_at,omitempty" column:"name:deleted_at;type:timestampz;nullable"`
+
+	// Table information
+	Metadata string `json:"-" schema:"public"`
+}
+
+func (s *Recruiter) Indexes() []raiden.Index {
+	return []raiden.Index{
+		{Name: "idx_recruiter_email", Columns: []string{"lower(email)"}, Unique: true, Where: "deleted_at IS NULL"},
+		{Columns: []string{"tags"}, Method: raiden.IndexMethodGin},
+	}
+}
+
+type ChatMessage struct {
+	Id       int64  `json:"id,omitempty" column:"name:id;type:bigint;primaryKey;autoIncrement;nullable:false"`
+	RoomId   int64  `json:"room_id,omitempty" column:"name:room_id;type:bigint;nullable:false"`
+	Body     string `json:"body,omitempty" column:"name:body;type:text;nullable:false"`
+	IsHidden bool   `json:"is_hidden,omitempty" column:"name:is_hidden;type:boolean;nullable:false;default:false"`
+
+	// Table information
+	Metadata string `json:"-" schema:"public" realtime:"true"`
+}
+
+func (m *ChatMessage) Publications() []raiden.Publication {
+	return []raiden.Publication{
+		{Name: "audit_publication", Columns: []string{"id", "body"}, Where: "is_hidden = false"},
+	}
+}
+
+type LegacyLog struct {
+	Id      int64  `json:"id,omitempty" column:"name:id;type:bigint;primaryKey;autoIncrement;nullable:false"`
+	Message string `json:"message,omitempty" column:"name:message;type:text;nullable:true"`
+
+	// Table information
+	Metadata string `json:"-" schema:"public" allowDestructive:"true"`
+}
+
+type Member struct {
+	Id       int64  `json:"id,omitempty" column:"name:id;type:bigint;primaryKey;autoIncrement;nullable:false"`
+	FullName string `json:"full_name,omitempty" column:"name:full_name;type:text;nullable:false;renamedFrom:name"`
+
+	// Table information
+	Metadata string `json:"-" schema:"public" tableName:"member" renamedFrom:"members"`
+}
+
 func TestExtractTable_NoRelation(t *testing.T) {
 	tableState := make([]state.TableState, 0)
 	appTable := []any{&Candidate{}}
@@ -110,6 +161,130 @@ func TestExtractTable_WithRelation(t *testing.T) {
 	assert.Equal(t, "created_at", rs.New[0].Table.Columns[5].Name)
 }
 
+func TestExtractTable_WithIndex(t *testing.T) {
+	rs, err := state.ExtractTable(nil, []any{&Recruiter{}}, nil)
+	assert.NoError(t, err)
+	assert.Equal(t, 1, len(rs.New))
+
+	indexes := rs.New[0].Table.Indexes
+	assert.Equal(t, 3, len(indexes))
+
+	assert.Equal(t, "idx_recruiter_created_at", indexes[0].Name)
+	assert.Equal(t, []string{"created_at"}, indexes[0].Columns)
+	assert.Equal(t, "btree", indexes[0].Method)
+
+	assert.Equal(t, "idx_recruiter_email", indexes[1].Name)
+	assert.True(t, indexes[1].IsUnique)
+	assert.Equal(t, "deleted_at IS NULL", indexes[1].Predicate)
+
+	assert.Equal(t, "idx_recruiter_tags", indexes[2].Name)
+	assert.Equal(t, "gin", indexes[2].Method)
+	assert.Equal(t, "public", indexes[2].Schema)
+	assert.Equal(t, "recruiter", indexes[2].Table)
+
+	// existing table replace index from state with declared index
+	tableState := []state.TableState{
+		{
+			Table: objects.Table{
+				ID:      1,
+				Name:    "recruiter",
+				Schema:  "public",
+				Indexes: []objects.Index{{Name: "idx_recruiter_old"}},
+			},
+		},
+	}
+	rs, err = state.ExtractTable(tableState, []any{&Recruiter{}}, nil)
+	assert.NoError(t, err)
+	assert.Equal(t, 1, len(rs.Existing))
+	assert.Equal(t, 3, len(rs.Existing[0].Table.Indexes))
+	assert.Equal(t, 1, rs.Existing[0].Table.ID)
+}
+
+func TestExtractTable_WithPublication(t *testing.T) {
+	rs, err := state.ExtractTable(nil, []any{&ChatMessage{}}, nil)
+	assert.NoError(t, err)
+	assert.Equal(t, 1, len(rs.New))
+
+	publications := rs.New[0].Table.Publications
+	assert.Equal(t, 2, len(publications))
+	assert.Equal(t, "audit_publication", publications[0].Name)
+	assert.Equal(t, []string{"id", "body"}, publications[0].Columns)
+	assert.Equal(t, "is_hidden = false", publications[0].RowFilter)
+	assert.Equal(t, raiden.DefaultPublication, publications[1].Name)
+	assert.Empty(t, publications[1].Columns)
+
+	// existing table replace publication from state with declared publication
+	tableState := []state.TableState{
+		{
+			Table: objects.Table{
+				ID:           1,
+				Name:         "chat_message",
+				Schema:       "public",
+				Publications: []objects.TablePublication{{Name: "old_publication"}},
+			},
+		},
+	}
+	rs, err = state.ExtractTable(tableState, []any{&ChatMessage{}}, nil)
+	assert.NoError(t, err)
+	assert.Equal(t, 1, len(rs.Existing))
+	assert.Equal(t, 2, len(rs.Existing[0].Table.Publications))
+	assert.Equal(t, "audit_publication", rs.Existing[0].Table.Publications[0].Name)
+	assert.Equal(t, raiden.DefaultPublication, rs.Existing[0].Table.Publications[1].Name)
+}
+
+func TestExtractTable_AllowDestructive(t *testing.T) {
+	rs, err := state.ExtractTable(nil, []any{&LegacyLog{}, &ChatMessage{}}, nil)
+	assert.NoError(t, err)
+	assert.Equal(t, 2, len(rs.New))
+	assert.True(t, rs.New[0].AllowDestructive)
+	assert.False(t, rs.New[1].AllowDestructive)
+
+	tableState := []state.TableState{
+		{Table: objects.Table{ID: 1, Name: "legacy_log", Schema: "public"}},
+	}
+	rs, err = state.ExtractTable(tableState, []any{&LegacyLog{}}, nil)
+	assert.NoError(t, err)
+	assert.Equal(t, 1, len(rs.Existing))
+	assert.True(t, rs.Existing[0].AllowDestructive)
+}
+
+func TestExtractTable_Renamed(t *testing.T) {
+	tableState := []state.TableState{
+		{
+			Table: objects.Table{
+				ID:     7,
+				Name:   "members",
+				Schema: "public",
+				Columns: []objects.Column{
+					{ID: "7.1", Name: "id", DataType: "bigint"},
+					{ID: "7.2", Name: "name", DataType: "text"},
+				},
+				PrimaryKeys: []objects.PrimaryKey{{Name: "id", Schema: "public", TableName: "members"}},
+			},
+		},
+	}
+
+	rs, err := state.ExtractTable(tableState, []any{&Member{}}, nil)
+	assert.NoError(t, err)
+	assert.Equal(t, 0, len(rs.New))
+	assert.Equal(t, 0, len(rs.Delete))
+	assert.Equal(t, 1, len(rs.Existing))
+
+	table := rs.Existing[0].Table
+	assert.Equal(t, 7, table.ID)
+	assert.Equal(t, "member", table.Name)
+	assert.Equal(t, 2, len(table.Columns))
+	assert.Equal(t, "7.2", table.Columns[1].ID)
+	assert.Equal(t, "full_name", table.Columns[1].Name)
+}
+
+func TestGetIndexName(t *testing.T) {
+	assert.Equal(t, "idx_users_last_name_first_name", state.GetIndexName("users", []string{"last_name", "first_name"}))
+	assert.Equal(t, "idx_users_lower_email", state.GetIndexName("users", []string{"lower(email)"}))
+	assert.Equal(t, "idx_users_created_at_desc", state.GetIndexName("users", []string{"created_at DESC"}))
+	assert.Equal(t, 63, len(state.GetIndexName("users", []string{"a_very_long_column_name_for_testing", "another_very_long_column_name"})))
+}
+
 func TestExtractTable(t *testing.T) {
 	relationAction := objects.TablesRelationshipAction{
 		UpdateAction:   "cascade",
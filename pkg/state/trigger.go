@@ -0,0 +1,111 @@
+package state
+
+import (
+	"fmt"
+	"reflect"
+
+	"github.com/sev-2/raiden"
+	"github.com/sev-2/raiden/pkg/supabase/objects"
+	"github.com/sev-2/raiden/pkg/utils"
+)
+
+type ExtractTriggerResult struct {
+	Existing []objects.Trigger
+	New      []objects.Trigger
+	Delete   []objects.Trigger
+}
+
+func ExtractTrigger(triggerStates []TriggerState, appTriggers []raiden.Trigger) (result ExtractTriggerResult, err error) {
+	mapTriggerState := map[string]TriggerState{}
+	for i := range triggerStates {
+		r := triggerStates[i]
+		mapTriggerState[GetTriggerKey(r.Trigger)] = r
+	}
+
+	for _, trigger := range appTriggers {
+		t := objects.Trigger{}
+		BindToSupabaseTrigger(&t, trigger)
+		if t.Table == "" {
+			return result, fmt.Errorf("trigger %s : table is required", t.Name)
+		}
+
+		key := GetTriggerKey(t)
+		state, isStateExist := mapTriggerState[key]
+		if !isStateExist {
+			result.New = append(result.New, t)
+			continue
+		}
+
+		sr := BuildTriggerFromState(state, trigger)
+		result.Existing = append(result.Existing, sr)
+		delete(mapTriggerState, key)
+	}
+
+	for _, state := range mapTriggerState {
+		result.Delete = append(result.Delete, state.Trigger)
+	}
+
+	return
+}
+
+// GetTriggerKey return unique identifier of trigger,
+// trigger name is only unique per table
+func GetTriggerKey(t objects.Trigger) string {
+	schema := t.Schema
+	if schema == "" {
+		schema = raiden.DefaultTriggerSchema
+	}
+	return fmt.Sprintf("%s.%s.%s", schema, t.Table, t.Name)
+}
+
+func BindToSupabaseTrigger(r *objects.Trigger, trigger raiden.Trigger) {
+	name := trigger.Name()
+	if name == "" {
+		rv := reflect.TypeOf(trigger)
+		if rv.Kind() == reflect.Pointer {
+			rv = rv.Elem()
+		}
+		name = utils.ToSnakeCase(rv.Name())
+	}
+
+	events := []string{}
+	for _, e := range trigger.Events() {
+		events = append(events, string(e))
+	}
+
+	var condition *string
+	if c := trigger.Condition(); c != "" {
+		condition = &c
+	}
+
+	r.Name = name
+	r.Schema = trigger.Schema()
+	r.Table = trigger.Table()
+	r.Activation = string(trigger.Timing())
+	r.Events = events
+	r.Orientation = string(trigger.Orientation())
+	r.Condition = condition
+	r.FunctionName = trigger.FunctionName()
+	r.FunctionSchema = trigger.FunctionSchema()
+	r.FunctionArgs = trigger.FunctionArgs()
+	r.EnabledMode = string(trigger.EnabledMode())
+}
+
+func BuildTriggerFromState(ts TriggerState, t raiden.Trigger) (r objects.Trigger) {
+	r = ts.Trigger
+	BindToSupabaseTrigger(&r, t)
+	return
+}
+
+func (er ExtractTriggerResult) ToDeleteFlatMap() map[string]*objects.Trigger {
+	mapData := make(map[string]*objects.Trigger)
+
+	if len(er.Delete) > 0 {
+		for i := range er.Delete {
+			r := er.Delete[i]
+			mapData[GetTriggerKey(r)] = &r
+		}
+	}
+
+	return mapData
+}
@@ -0,0 +1,110 @@
+package state_test
+
+import (
+	"testing"
+
+	"github.com/sev-2/raiden"
+	"github.com/sev-2/raiden/pkg/state"
+	"github.com/sev-2/raiden/pkg/supabase/objects"
+	"github.com/stretchr/testify/assert"
+)
+
+type MockTrigger struct {
+	raiden.TriggerBase
+}
+
+func (*MockTrigger) Name() string {
+	return "set_updated_at"
+}
+
+func (*MockTrigger) Table() string {
+	return "users"
+}
+
+func (*MockTrigger) Timing() raiden.TriggerTiming {
+	return raiden.TriggerTimingBefore
+}
+
+func (*MockTrigger) Events() []raiden.TriggerEvent {
+	return []raiden.TriggerEvent{raiden.TriggerEventUpdate}
+}
+
+func (*MockTrigger) Condition() string {
+	return "OLD.* IS DISTINCT FROM NEW.*"
+}
+
+func (*MockTrigger) FunctionName() string {
+	return "handle_updated_at"
+}
+
+type MockTriggerWithoutTable struct {
+	raiden.TriggerBase
+}
+
+func TestExtractTrigger(t *testing.T) {
+	triggerStates := []state.TriggerState{
+		{Trigger: objects.Trigger{ID: 1, Name: "set_updated_at", Schema: "public", Table: "users"}},
+		{Trigger: objects.Trigger{ID: 2, Name: "set_updated_at", Schema: "public", Table: "profiles"}},
+	}
+
+	appTriggers := []raiden.Trigger{
+		&MockTrigger{},
+	}
+
+	result, err := state.ExtractTrigger(triggerStates, appTriggers)
+	assert.NoError(t, err)
+	assert.Len(t, result.Existing, 1)
+	assert.Len(t, result.New, 0)
+	assert.Len(t, result.Delete, 1)
+	assert.Equal(t, 1, result.Existing[0].ID)
+	assert.Equal(t, "profiles", result.Delete[0].Table)
+}
+
+func TestExtractTrigger_NewAndMissingTable(t *testing.T) {
+	result, err := state.ExtractTrigger(nil, []raiden.Trigger{&MockTrigger{}})
+	assert.NoError(t, err)
+	assert.Len(t, result.New, 1)
+
+	_, err = state.ExtractTrigger(nil, []raiden.Trigger{&MockTriggerWithoutTable{}})
+	assert.Error(t, err)
+}
+
+func TestBindToSupabaseTrigger(t *testing.T) {
+	r := objects.Trigger{}
+	state.BindToSupabaseTrigger(&r, &MockTrigger{})
+
+	assert.Equal(t, "set_updated_at", r.Name)
+	assert.Equal(t, "public", r.Schema)
+	assert.Equal(t, "users", r.Table)
+	assert.Equal(t, "BEFORE", r.Activation)
+	assert.Equal(t, []string{"UPDATE"}, r.Events)
+	assert.Equal(t, "ROW", r.Orientation)
+	assert.Equal(t, "OLD.* IS DISTINCT FROM NEW.*", *r.Condition)
+	assert.Equal(t, "handle_updated_at", r.FunctionName)
+	assert.Equal(t, "public", r.FunctionSchema)
+	assert.Equal(t, "ORIGIN", r.EnabledMode)
+}
+
+func TestBuildTriggerFromState(t *testing.T) {
+	ts := state.TriggerState{
+		Trigger: objects.Trigger{ID: 10, Name: "set_updated_at", Schema: "public", Table: "users", Activation: "AFTER"},
+	}
+
+	r := state.BuildTriggerFromState(ts, &MockTrigger{})
+	assert.Equal(t, 10, r.ID)
+	assert.Equal(t, "BEFORE", r.Activation)
+}
+
+func TestExtractTriggerResult_ToDeleteFlatMap(t *testing.T) {
+	extractResult := state.ExtractTriggerResult{
+		Delete: []objects.Trigger{
+			{Name: "trigger1", Table: "users"},
+			{Name: "trigger1", Schema: "private", Table: "users"},
+		},
+	}
+
+	mapData := extractResult.ToDeleteFlatMap()
+	assert.Len(t, mapData, 2)
+	assert.Contains(t, mapData, "public.users.trigger1")
+	assert.Contains(t, mapData, "private.users.trigger1")
+}
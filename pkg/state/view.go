@@ -0,0 +1,94 @@
+package state
+
+import (
+	"fmt"
+	"reflect"
+
+	"github.com/sev-2/raiden"
+	"github.com/sev-2/raiden/pkg/supabase/objects"
+)
+
+type ExtractViewResult struct {
+	Existing []objects.View
+	New      []objects.View
+	Delete   []objects.View
+}
+
+func ExtractView(viewStates []ViewState, appViews []raiden.View) (result ExtractViewResult, err error) {
+	mapViewState := map[string]ViewState{}
+	for i := range viewStates {
+		r := viewStates[i]
+		mapViewState[GetViewKey(r.View)] = r
+	}
+
+	for _, view := range appViews {
+		v := objects.View{}
+		BindToSupabaseView(&v, view)
+		if v.Definition == "" {
+			return result, fmt.Errorf("view %s : definition is required", v.Name)
+		}
+
+		key := GetViewKey(v)
+		state, isStateExist := mapViewState[key]
+		if !isStateExist {
+			result.New = append(result.New, v)
+			continue
+		}
+
+		sr := BuildViewFromState(state, view)
+		result.Existing = append(result.Existing, sr)
+		delete(mapViewState, key)
+	}
+
+	for _, state := range mapViewState {
+		result.Delete = append(result.Delete, state.View)
+	}
+
+	return
+}
+
+func GetViewKey(v objects.View) string {
+	schema := v.Schema
+	if schema == "" {
+		schema = raiden.DefaultViewSchema
+	}
+	return fmt.Sprintf("%s.%s", schema, v.Name)
+}
+
+func BindToSupabaseView(r *objects.View, view raiden.View) {
+	r.Name = raiden.GetTableName(view)
+	r.Schema = raiden.DefaultViewSchema
+
+	rt := reflect.TypeOf(view)
+	if rt.Kind() == reflect.Pointer {
+		rt = rt.Elem()
+	}
+
+	if field, found := rt.FieldByName("Metadata"); found {
+		if schema := field.Tag.Get("schema"); len(schema) > 0 {
+			r.Schema = schema
+		}
+	}
+
+	r.Definition = view.Definition()
+	r.IsMaterialized = view.Materialized()
+}
+
+func BuildViewFromState(vs ViewState, v raiden.View) (r objects.View) {
+	r = vs.View
+	BindToSupabaseView(&r, v)
+	return
+}
+
+func (er ExtractViewResult) ToDeleteFlatMap() map[string]*objects.View {
+	mapData := make(map[string]*objects.View)
+
+	if len(er.Delete) > 0 {
+		for i := range er.Delete {
+			r := er.Delete[i]
+			mapData[GetViewKey(r)] = &r
+		}
+	}
+
+	return mapData
+}
@@ -0,0 +1,86 @@
+package state_test
+
+import (
+	"testing"
+
+	"github.com/sev-2/raiden"
+	"github.com/sev-2/raiden/pkg/state"
+	"github.com/sev-2/raiden/pkg/supabase/objects"
+	"github.com/stretchr/testify/assert"
+)
+
+type MockActiveUser struct {
+	raiden.ViewBase
+	Id int64 `json:"id,omitempty" column:"name:id;type:bigint"`
+
+	Metadata string `json:"-" schema:"private" tableName:"active_users"`
+}
+
+func (*MockActiveUser) Definition() string {
+	return "SELECT id FROM users WHERE is_active"
+}
+
+type MockUserSummary struct {
+	raiden.ViewBase
+}
+
+func (*MockUserSummary) Definition() string {
+	return "SELECT count(*) AS total FROM users"
+}
+
+func (*MockUserSummary) Materialized() bool {
+	return true
+}
+
+type MockViewWithoutDefinition struct {
+	raiden.ViewBase
+}
+
+func TestExtractView(t *testing.T) {
+	viewStates := []state.ViewState{
+		{View: objects.View{ID: 1, Name: "active_users", Schema: "private"}},
+		{View: objects.View{ID: 2, Name: "old_view", Schema: "public"}},
+	}
+
+	appViews := []raiden.View{
+		&MockActiveUser{},
+		&MockUserSummary{},
+	}
+
+	result, err := state.ExtractView(viewStates, appViews)
+	assert.NoError(t, err)
+	assert.Len(t, result.Existing, 1)
+	assert.Len(t, result.New, 1)
+	assert.Len(t, result.Delete, 1)
+	assert.Equal(t, 1, result.Existing[0].ID)
+	assert.Equal(t, "mock_user_summary", result.New[0].Name)
+	assert.True(t, result.New[0].IsMaterialized)
+	assert.Equal(t, "old_view", result.Delete[0].Name)
+
+	_, err = state.ExtractView(nil, []raiden.View{&MockViewWithoutDefinition{}})
+	assert.Error(t, err)
+}
+
+func TestBindToSupabaseView(t *testing.T) {
+	r := objects.View{}
+	state.BindToSupabaseView(&r, &MockActiveUser{})
+
+	assert.Equal(t, "active_users", r.Name)
+	assert.Equal(t, "private", r.Schema)
+	assert.Equal(t, "SELECT id FROM users WHERE is_active", r.Definition)
+	assert.False(t, r.IsMaterialized)
+}
+
+func TestExtractViewResult_ToDeleteFlatMap(t *testing.T) {
+	extractResult := state.ExtractViewResult{
+		Delete: []objects.View{
+			{Name: "view1"},
+			{Name: "view1", Schema: "private"},
+		},
+	}
+
+	mapData := extractResult.ToDeleteFlatMap()
+	assert.Len(t, mapData, 2)
+	assert.Contains(t, mapData, "public.view1")
+	assert.Contains(t, mapData, "private.view1")
+}
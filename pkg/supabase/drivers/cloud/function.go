@@ -9,9 +9,9 @@ import (
 	"github.com/sev-2/raiden/pkg/supabase/query/sql"
 )
 
-func GetFunctions(cfg *raiden.Config) ([]objects.Function, error) {
+func GetFunctions(cfg *raiden.Config, includedSchemas []string) ([]objects.Function, error) {
 	CloudLogger.Trace("start fetching function from supabase")
-	q := sql.GenerateFunctionsQuery([]string{"public"})
+	q := sql.GenerateFunctionsQuery(includedSchemas)
 	rs, err := ExecuteQuery[[]objects.Function](
 		cfg.SupabaseApiUrl, cfg.ProjectId, q,
 		DefaultAuthInterceptor(cfg.AccessToken), nil,
@@ -40,6 +40,23 @@ func GetFunctionByName(cfg *raiden.Config, schema, name string) (result objects.
 	return rs[0], nil
 }
 
+func GetFunctionBySignature(cfg *raiden.Config, schema, name, identityArgumentTypes string) (result objects.Function, err error) {
+	CloudLogger.Trace("start fetching single function by signature")
+	sql := sql.GenerateFunctionBySignatureQuery(schema, name, identityArgumentTypes) + " limit 1"
+	rs, err := ExecuteQuery[[]objects.Function](cfg.SupabaseApiUrl, cfg.ProjectId, sql, DefaultAuthInterceptor(cfg.AccessToken), nil)
+	if err != nil {
+		err = fmt.Errorf("get function error : %s", err)
+		return
+	}
+
+	if len(rs) == 0 {
+		err = fmt.Errorf("get function %s(%s) is not found", name, identityArgumentTypes)
+		return
+	}
+	CloudLogger.Trace("finish fetching single function by signature")
+	return rs[0], nil
+}
+
 func CreateFunction(cfg *raiden.Config, fn objects.Function) (objects.Function, error) {
 	CloudLogger.Trace("start create function", "function", fn.Name)
 	// Execute SQL Query
@@ -56,7 +73,7 @@ func CreateFunction(cfg *raiden.Config, fn objects.Function) (objects.Function,
 	}
 
 	CloudLogger.Trace("finish create function", "function", fn.Name)
-	return GetFunctionByName(cfg, fn.Schema, fn.Name)
+	return GetFunctionBySignature(cfg, fn.Schema, fn.Name, fn.IdentityArgumentTypes)
 }
 
 func DeleteFunction(cfg *raiden.Config, fn objects.Function) error {
@@ -5,6 +5,7 @@ import (
 
 	"github.com/sev-2/raiden"
 	"github.com/sev-2/raiden/pkg/supabase/objects"
+	"github.com/sev-2/raiden/pkg/supabase/query"
 	"github.com/sev-2/raiden/pkg/supabase/query/sql"
 )
 
@@ -17,3 +18,23 @@ func GetIndexes(cfg *raiden.Config, schema string) ([]objects.Index, error) {
 	CloudLogger.Trace("finish fetching index from supabase")
 	return rs, err
 }
+
+func updateIndexes(cfg *raiden.Config, items []objects.UpdateIndexItem) error {
+	CloudLogger.Trace("start update index")
+	var indexSql string
+	for i := range items {
+		item := items[i]
+		itemSql, err := query.BuildIndexQuery(item.Type, &item.Data)
+		if err != nil {
+			return err
+		}
+		indexSql += itemSql
+	}
+
+	_, err := ExecuteQuery[any](cfg.SupabaseApiUrl, cfg.ProjectId, indexSql, DefaultAuthInterceptor(cfg.AccessToken), nil)
+	if err != nil {
+		return fmt.Errorf("update index error : %s", err)
+	}
+	CloudLogger.Trace("finish update index")
+	return nil
+}
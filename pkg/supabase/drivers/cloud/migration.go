@@ -0,0 +1,93 @@
+package cloud
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/sev-2/raiden"
+	"github.com/sev-2/raiden/pkg/client/net"
+	"github.com/sev-2/raiden/pkg/supabase/objects"
+	"github.com/sev-2/raiden/pkg/supabase/query"
+	"github.com/sev-2/raiden/pkg/supabase/query/sql"
+)
+
+func GetMigrations(cfg *raiden.Config) ([]objects.Migration, error) {
+	CloudLogger.Trace("start fetching migrations from supabase")
+	if err := createMigrationTable(cfg); err != nil {
+		return []objects.Migration{}, err
+	}
+
+	rs, err := ExecuteQuery[[]objects.Migration](cfg.SupabaseApiUrl, cfg.ProjectId, sql.GetMigrationsQuery, DefaultAuthInterceptor(cfg.AccessToken), nil)
+	if err != nil {
+		err = fmt.Errorf("get migrations error : %s", err)
+		return []objects.Migration{}, err
+	}
+	CloudLogger.Trace("finish fetching migrations from supabase")
+	return rs, nil
+}
+
+func GetMigration(cfg *raiden.Config, id int) (result objects.Migration, err error) {
+	CloudLogger.Trace("start fetching single migration by id")
+	if err = createMigrationTable(cfg); err != nil {
+		return
+	}
+
+	rs, err := ExecuteQuery[[]objects.Migration](cfg.SupabaseApiUrl, cfg.ProjectId, sql.GenerateMigrationQuery(id), DefaultAuthInterceptor(cfg.AccessToken), nil)
+	if err != nil {
+		err = fmt.Errorf("get migration error : %s", err)
+		return
+	}
+
+	if len(rs) == 0 {
+		err = fmt.Errorf("get migration %d is not found", id)
+		return
+	}
+	CloudLogger.Trace("finish fetching single migration by id")
+	return rs[0], nil
+}
+
+func CreateMigration(cfg *raiden.Config, m objects.Migration) (objects.Migration, error) {
+	CloudLogger.Trace("start create migration history")
+	if err := createMigrationTable(cfg); err != nil {
+		return objects.Migration{}, err
+	}
+
+	q, err := query.BuildCreateMigrationQuery(&m)
+	if err != nil {
+		return objects.Migration{}, err
+	}
+
+	rs, err := ExecuteQuery[[]objects.Migration](cfg.SupabaseApiUrl, cfg.ProjectId, q, DefaultAuthInterceptor(cfg.AccessToken), nil)
+	if err != nil {
+		return objects.Migration{}, fmt.Errorf("create migration history error : %s", err)
+	}
+
+	if len(rs) == 0 {
+		return objects.Migration{}, fmt.Errorf("create migration history error : empty response")
+	}
+	CloudLogger.Trace("finish create migration history")
+	return rs[0], nil
+}
+
+func createMigrationTable(cfg *raiden.Config) error {
+	_, err := ExecuteQuery[any](cfg.SupabaseApiUrl, cfg.ProjectId, sql.CreateMigrationTableQuery, DefaultAuthInterceptor(cfg.AccessToken), nil)
+	if err != nil {
+		return fmt.Errorf("create migration table error : %s", err)
+	}
+	return nil
+}
+
+func RunMigrationScript(cfg *raiden.Config, script string) error {
+	CloudLogger.Trace("start run migration script")
+	_, err := ExecuteQuery[any](cfg.SupabaseApiUrl, cfg.ProjectId, script, DefaultAuthInterceptor(cfg.AccessToken), nil)
+	if err != nil {
+		// database error message is returned in response body
+		var reqErr net.ReqError
+		if errors.As(err, &reqErr) && len(reqErr.Body) > 0 {
+			return fmt.Errorf("run migration script error : %s : %s", err, string(reqErr.Body))
+		}
+		return fmt.Errorf("run migration script error : %s", err)
+	}
+	CloudLogger.Trace("finish run migration script")
+	return nil
+}
@@ -0,0 +1,41 @@
+package cloud
+
+import (
+	"fmt"
+
+	"github.com/sev-2/raiden"
+	"github.com/sev-2/raiden/pkg/supabase/objects"
+	"github.com/sev-2/raiden/pkg/supabase/query"
+	"github.com/sev-2/raiden/pkg/supabase/query/sql"
+)
+
+func GetPublications(cfg *raiden.Config) ([]objects.Publication, error) {
+	CloudLogger.Trace("start fetching publications from supabase")
+	rs, err := ExecuteQuery[[]objects.Publication](cfg.SupabaseApiUrl, cfg.ProjectId, sql.GetPublicationsQuery, DefaultAuthInterceptor(cfg.AccessToken), nil)
+	if err != nil {
+		err = fmt.Errorf("get publications error : %s", err)
+		return []objects.Publication{}, err
+	}
+	CloudLogger.Trace("finish fetching publications from supabase")
+	return rs, nil
+}
+
+func updateTablePublications(cfg *raiden.Config, table objects.Table, items []objects.UpdatePublicationItem) error {
+	CloudLogger.Trace("start update table publication")
+	var publicationSql string
+	for i := range items {
+		item := items[i]
+		itemSql, err := query.BuildTablePublicationQuery(item.Type, table.Schema, table.Name, &item.Data)
+		if err != nil {
+			return err
+		}
+		publicationSql += itemSql
+	}
+
+	_, err := ExecuteQuery[any](cfg.SupabaseApiUrl, cfg.ProjectId, publicationSql, DefaultAuthInterceptor(cfg.AccessToken), nil)
+	if err != nil {
+		return fmt.Errorf("update table publication error : %s", err)
+	}
+	CloudLogger.Trace("finish update table publication")
+	return nil
+}
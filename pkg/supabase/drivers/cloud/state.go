@@ -0,0 +1,87 @@
+package cloud
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/sev-2/raiden"
+	"github.com/sev-2/raiden/pkg/supabase/objects"
+	"github.com/sev-2/raiden/pkg/supabase/query"
+	"github.com/sev-2/raiden/pkg/supabase/query/sql"
+)
+
+// GetState return stored state with the given name,
+// empty state is returned when state is not stored yet
+func GetState(cfg *raiden.Config, name string) (result objects.RemoteState, err error) {
+	CloudLogger.Trace("start fetching state from supabase", "name", name)
+	if err = createStateTable(cfg); err != nil {
+		return
+	}
+
+	rs, err := ExecuteQuery[[]objects.RemoteState](cfg.SupabaseApiUrl, cfg.ProjectId, sql.GenerateStateQuery(name), DefaultAuthInterceptor(cfg.AccessToken), nil)
+	if err != nil {
+		err = fmt.Errorf("get state error : %s", err)
+		return
+	}
+
+	if len(rs) == 0 {
+		return objects.RemoteState{Name: name}, nil
+	}
+	CloudLogger.Trace("finish fetching state from supabase", "name", name)
+	return rs[0], nil
+}
+
+func SaveState(cfg *raiden.Config, s objects.RemoteState) error {
+	CloudLogger.Trace("start save state to supabase", "name", s.Name)
+	if err := createStateTable(cfg); err != nil {
+		return err
+	}
+
+	q, err := query.BuildSaveStateQuery(&s)
+	if err != nil {
+		return err
+	}
+
+	if _, err := ExecuteQuery[any](cfg.SupabaseApiUrl, cfg.ProjectId, q, DefaultAuthInterceptor(cfg.AccessToken), nil); err != nil {
+		return fmt.Errorf("save state error : %s", err)
+	}
+	CloudLogger.Trace("finish save state to supabase", "name", s.Name)
+	return nil
+}
+
+func LockState(cfg *raiden.Config, name, lockId, lockedBy string, ttl time.Duration) (result objects.RemoteState, err error) {
+	CloudLogger.Trace("start lock state in supabase", "name", name)
+	if err = createStateTable(cfg); err != nil {
+		return
+	}
+
+	rs, err := ExecuteQuery[[]objects.RemoteState](cfg.SupabaseApiUrl, cfg.ProjectId, query.BuildLockStateQuery(name, lockId, lockedBy, ttl), DefaultAuthInterceptor(cfg.AccessToken), nil)
+	if err != nil {
+		err = fmt.Errorf("lock state error : %s", err)
+		return
+	}
+
+	if len(rs) == 0 {
+		err = fmt.Errorf("lock state error : empty response")
+		return
+	}
+	CloudLogger.Trace("finish lock state in supabase", "name", name)
+	return rs[0], nil
+}
+
+func UnlockState(cfg *raiden.Config, name, lockId string) error {
+	CloudLogger.Trace("start unlock state in supabase", "name", name)
+	if _, err := ExecuteQuery[any](cfg.SupabaseApiUrl, cfg.ProjectId, query.BuildUnlockStateQuery(name, lockId), DefaultAuthInterceptor(cfg.AccessToken), nil); err != nil {
+		return fmt.Errorf("unlock state error : %s", err)
+	}
+	CloudLogger.Trace("finish unlock state in supabase", "name", name)
+	return nil
+}
+
+func createStateTable(cfg *raiden.Config) error {
+	_, err := ExecuteQuery[any](cfg.SupabaseApiUrl, cfg.ProjectId, sql.CreateStateTableQuery, DefaultAuthInterceptor(cfg.AccessToken), nil)
+	if err != nil {
+		return fmt.Errorf("create state table error : %s", err)
+	}
+	return nil
+}
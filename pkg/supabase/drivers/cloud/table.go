@@ -103,6 +103,21 @@ func UpdateTable(cfg *raiden.Config, newTable objects.Table, updateItem objects.
 			return errors.New(strings.Join(errMsg, ";"))
 		}
 	}
+
+	// index must be updated after column because index can use new column
+	if len(updateItem.ChangeIndexItems) > 0 {
+		if err := updateIndexes(cfg, updateItem.ChangeIndexItems); err != nil {
+			return err
+		}
+	}
+
+	// publication column list must be updated after column too
+	if len(updateItem.ChangePublicationItems) > 0 {
+		if err := updateTablePublications(cfg, newTable, updateItem.ChangePublicationItems); err != nil {
+			return err
+		}
+	}
+
 	CloudLogger.Trace("finish update table", "name", newTable.Name)
 	return nil
 }
@@ -162,7 +177,7 @@ func updateColumnFromTable(
 	for i := range updateColumns {
 		cu := updateColumns[i]
 		newColumn := mapNewColumn[cu.Name]
-		oldColumn := mapOldColumn[cu.Name]
+		oldColumn := mapOldColumn[cu.GetOldName()]
 
 		wg.Add(1)
 		go func(w *sync.WaitGroup, eChan chan error, c *raiden.Config, ui objects.UpdateColumnItem, nc objects.Column, oc objects.Column) {
@@ -175,7 +190,8 @@ func updateColumnFromTable(
 					isCreate = true
 				case objects.UpdateColumnDelete:
 					isDelete = true
-				case objects.UpdateColumnName, objects.UpdateColumnDataType, objects.UpdateColumnUnique, objects.UpdateColumnNullable, objects.UpdateColumnDefaultValue, objects.UpdateColumnIdentity:
+				case objects.UpdateColumnName, objects.UpdateColumnDataType, objects.UpdateColumnUnique, objects.UpdateColumnNullable, objects.UpdateColumnDefaultValue, objects.UpdateColumnIdentity,
+					objects.UpdateColumnCheck, objects.UpdateColumnComment, objects.UpdateColumnGenerated:
 					isUpdate = true
 				default:
 					continue
@@ -243,8 +259,11 @@ func CreateColumn(cfg *raiden.Config, column objects.Column, isPrimary bool) err
 func UpdateColumn(cfg *raiden.Config, oldColumn, newColumn objects.Column, updateItem objects.UpdateColumnItem) error {
 	CloudLogger.Trace("start update column", "table", oldColumn.Table, "name", newColumn.Name)
 
-	sql := query.BuildUpdateColumnQuery(oldColumn, newColumn, updateItem)
-	_, err := ExecuteQuery[any](cfg.SupabaseApiUrl, cfg.ProjectId, sql, DefaultAuthInterceptor(cfg.AccessToken), nil)
+	sql, err := query.BuildUpdateColumnQuery(oldColumn, newColumn, updateItem)
+	if err != nil {
+		return err
+	}
+	_, err = ExecuteQuery[any](cfg.SupabaseApiUrl, cfg.ProjectId, sql, DefaultAuthInterceptor(cfg.AccessToken), nil)
 	if err != nil {
 		return fmt.Errorf("update column %s.%s error : %s", newColumn.Table, newColumn.Name, err)
 	}
@@ -0,0 +1,80 @@
+package cloud
+
+import (
+	"fmt"
+
+	"github.com/sev-2/raiden"
+	"github.com/sev-2/raiden/pkg/supabase/objects"
+	"github.com/sev-2/raiden/pkg/supabase/query"
+	"github.com/sev-2/raiden/pkg/supabase/query/sql"
+)
+
+func GetTriggers(cfg *raiden.Config, includedSchemas []string) ([]objects.Trigger, error) {
+	CloudLogger.Trace("start fetching triggers from supabase")
+	q := sql.GenerateTriggersQuery(includedSchemas)
+	rs, err := ExecuteQuery[[]objects.Trigger](cfg.SupabaseApiUrl, cfg.ProjectId, q, DefaultAuthInterceptor(cfg.AccessToken), nil)
+	if err != nil {
+		err = fmt.Errorf("get triggers error : %s", err)
+	}
+	CloudLogger.Trace("finish fetching triggers from supabase")
+	return rs, err
+}
+
+func GetTriggerByName(cfg *raiden.Config, schema, table, name string) (result objects.Trigger, err error) {
+	CloudLogger.Trace("start fetching single trigger by name")
+	q := sql.GenerateTriggerQuery(schema, table, name) + " limit 1"
+	rs, err := ExecuteQuery[[]objects.Trigger](cfg.SupabaseApiUrl, cfg.ProjectId, q, DefaultAuthInterceptor(cfg.AccessToken), nil)
+	if err != nil {
+		err = fmt.Errorf("get trigger error : %s", err)
+		return
+	}
+
+	if len(rs) == 0 {
+		err = fmt.Errorf("get trigger %s on table %s is not found", name, table)
+		return
+	}
+	CloudLogger.Trace("finish fetching single trigger by name")
+	return rs[0], nil
+}
+
+func CreateTrigger(cfg *raiden.Config, t objects.Trigger) (objects.Trigger, error) {
+	CloudLogger.Trace("start create trigger", "name", t.Name, "table", t.Table)
+	sql, err := query.BuildTriggerQuery(query.TriggerActionCreate, &t)
+	if err != nil {
+		return objects.Trigger{}, err
+	}
+
+	_, err = ExecuteQuery[any](cfg.SupabaseApiUrl, cfg.ProjectId, sql, DefaultAuthInterceptor(cfg.AccessToken), nil)
+	if err != nil {
+		return objects.Trigger{}, fmt.Errorf("create new trigger %s error : %s", t.Name, err)
+	}
+
+	CloudLogger.Trace("finish create trigger", "name", t.Name, "table", t.Table)
+	return GetTriggerByName(cfg, t.Schema, t.Table, t.Name)
+}
+
+func UpdateTrigger(cfg *raiden.Config, t objects.Trigger, updateItem objects.UpdateTriggerParam) error {
+	CloudLogger.Trace("start update trigger", "name", t.Name, "table", t.Table)
+	sql := cleanupQueryParam(query.BuildUpdateTriggerQuery(t, updateItem))
+	_, err := ExecuteQuery[any](cfg.SupabaseApiUrl, cfg.ProjectId, sql, DefaultAuthInterceptor(cfg.AccessToken), nil)
+	if err != nil {
+		return fmt.Errorf("update trigger %s error : %s", t.Name, err)
+	}
+	CloudLogger.Trace("finish update trigger", "name", t.Name, "table", t.Table)
+	return nil
+}
+
+func DeleteTrigger(cfg *raiden.Config, t objects.Trigger) error {
+	CloudLogger.Trace("start delete trigger", "name", t.Name, "table", t.Table)
+	sql, err := query.BuildTriggerQuery(query.TriggerActionDelete, &t)
+	if err != nil {
+		return err
+	}
+
+	_, err = ExecuteQuery[any](cfg.SupabaseApiUrl, cfg.ProjectId, sql, DefaultAuthInterceptor(cfg.AccessToken), nil)
+	if err != nil {
+		return fmt.Errorf("delete trigger %s error : %s", t.Name, err)
+	}
+	CloudLogger.Trace("finish delete trigger", "name", t.Name, "table", t.Table)
+	return nil
+}
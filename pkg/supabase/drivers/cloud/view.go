@@ -0,0 +1,91 @@
+package cloud
+
+import (
+	"fmt"
+
+	"github.com/sev-2/raiden"
+	"github.com/sev-2/raiden/pkg/supabase/objects"
+	"github.com/sev-2/raiden/pkg/supabase/query"
+	"github.com/sev-2/raiden/pkg/supabase/query/sql"
+)
+
+func GetViews(cfg *raiden.Config, includedSchemas []string) ([]objects.View, error) {
+	CloudLogger.Trace("start fetching views from supabase")
+	q := sql.GenerateViewsQuery(includedSchemas)
+	rs, err := ExecuteQuery[[]objects.View](cfg.SupabaseApiUrl, cfg.ProjectId, q, DefaultAuthInterceptor(cfg.AccessToken), nil)
+	if err != nil {
+		err = fmt.Errorf("get views error : %s", err)
+	}
+	CloudLogger.Trace("finish fetching views from supabase")
+	return rs, err
+}
+
+func GetViewByName(cfg *raiden.Config, schema, name string) (result objects.View, err error) {
+	CloudLogger.Trace("start fetching single view by name")
+	q := sql.GenerateViewQuery(schema, name) + " limit 1"
+	rs, err := ExecuteQuery[[]objects.View](cfg.SupabaseApiUrl, cfg.ProjectId, q, DefaultAuthInterceptor(cfg.AccessToken), nil)
+	if err != nil {
+		err = fmt.Errorf("get view error : %s", err)
+		return
+	}
+
+	if len(rs) == 0 {
+		err = fmt.Errorf("get view %s is not found", name)
+		return
+	}
+	CloudLogger.Trace("finish fetching single view by name")
+	return rs[0], nil
+}
+
+func CreateView(cfg *raiden.Config, v objects.View) (objects.View, error) {
+	CloudLogger.Trace("start create view", "name", v.Name)
+	sql, err := query.BuildViewQuery(query.ViewActionCreate, &v)
+	if err != nil {
+		return objects.View{}, err
+	}
+
+	_, err = ExecuteQuery[any](cfg.SupabaseApiUrl, cfg.ProjectId, sql, DefaultAuthInterceptor(cfg.AccessToken), nil)
+	if err != nil {
+		return objects.View{}, fmt.Errorf("create new view %s error : %s", v.Name, err)
+	}
+
+	CloudLogger.Trace("finish create view", "name", v.Name)
+	return GetViewByName(cfg, v.Schema, v.Name)
+}
+
+func UpdateView(cfg *raiden.Config, v objects.View, updateItem objects.UpdateViewParam) error {
+	CloudLogger.Trace("start update view", "name", v.Name)
+	sql := query.BuildUpdateViewQuery(v, updateItem)
+	_, err := ExecuteQuery[any](cfg.SupabaseApiUrl, cfg.ProjectId, sql, DefaultAuthInterceptor(cfg.AccessToken), nil)
+	if err != nil {
+		return fmt.Errorf("update view %s error : %s", v.Name, err)
+	}
+	CloudLogger.Trace("finish update view", "name", v.Name)
+	return nil
+}
+
+func DeleteView(cfg *raiden.Config, v objects.View) error {
+	CloudLogger.Trace("start delete view", "name", v.Name)
+	sql, err := query.BuildViewQuery(query.ViewActionDelete, &v)
+	if err != nil {
+		return err
+	}
+
+	_, err = ExecuteQuery[any](cfg.SupabaseApiUrl, cfg.ProjectId, sql, DefaultAuthInterceptor(cfg.AccessToken), nil)
+	if err != nil {
+		return fmt.Errorf("delete view %s error : %s", v.Name, err)
+	}
+	CloudLogger.Trace("finish delete view", "name", v.Name)
+	return nil
+}
+
+func RefreshMaterializedView(cfg *raiden.Config, v objects.View, concurrently bool) error {
+	CloudLogger.Trace("start refresh materialized view", "name", v.Name)
+	sql := query.BuildRefreshMaterializedViewQuery(&v, concurrently)
+	_, err := ExecuteQuery[any](cfg.SupabaseApiUrl, cfg.ProjectId, sql, DefaultAuthInterceptor(cfg.AccessToken), nil)
+	if err != nil {
+		return fmt.Errorf("refresh materialized view %s error : %s", v.Name, err)
+	}
+	CloudLogger.Trace("finish refresh materialized view", "name", v.Name)
+	return nil
+}
@@ -5,6 +5,7 @@ import (
 
 	"github.com/sev-2/raiden"
 	"github.com/sev-2/raiden/pkg/supabase/objects"
+	"github.com/sev-2/raiden/pkg/supabase/query"
 	"github.com/sev-2/raiden/pkg/supabase/query/sql"
 )
 
@@ -18,3 +19,23 @@ func GetIndexes(cfg *raiden.Config, schema string) ([]objects.Index, error) {
 	MetaLogger.Trace("finish fetching policy by name from meta")
 	return rs, nil
 }
+
+func updateIndexes(cfg *raiden.Config, items []objects.UpdateIndexItem) error {
+	MetaLogger.Trace("start update index")
+	var indexSql string
+	for i := range items {
+		item := items[i]
+		itemSql, err := query.BuildIndexQuery(item.Type, &item.Data)
+		if err != nil {
+			return err
+		}
+		indexSql += itemSql
+	}
+
+	_, err := ExecuteQuery[any](getBaseUrl(cfg), indexSql, nil, DefaultInterceptor(cfg), nil)
+	if err != nil {
+		return fmt.Errorf("update index error : %s", err)
+	}
+	MetaLogger.Trace("finish update index")
+	return nil
+}
@@ -0,0 +1,93 @@
+package meta
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/sev-2/raiden"
+	"github.com/sev-2/raiden/pkg/client/net"
+	"github.com/sev-2/raiden/pkg/supabase/objects"
+	"github.com/sev-2/raiden/pkg/supabase/query"
+	"github.com/sev-2/raiden/pkg/supabase/query/sql"
+)
+
+func GetMigrations(cfg *raiden.Config) ([]objects.Migration, error) {
+	MetaLogger.Trace("start fetching migrations from meta")
+	if err := createMigrationTable(cfg); err != nil {
+		return []objects.Migration{}, err
+	}
+
+	rs, err := ExecuteQuery[[]objects.Migration](getBaseUrl(cfg), sql.GetMigrationsQuery, nil, DefaultInterceptor(cfg), nil)
+	if err != nil {
+		err = fmt.Errorf("get migrations error : %s", err)
+		return []objects.Migration{}, err
+	}
+	MetaLogger.Trace("finish fetching migrations from meta")
+	return rs, nil
+}
+
+func GetMigration(cfg *raiden.Config, id int) (result objects.Migration, err error) {
+	MetaLogger.Trace("start fetching single migration by id")
+	if err = createMigrationTable(cfg); err != nil {
+		return
+	}
+
+	rs, err := ExecuteQuery[[]objects.Migration](getBaseUrl(cfg), sql.GenerateMigrationQuery(id), nil, DefaultInterceptor(cfg), nil)
+	if err != nil {
+		err = fmt.Errorf("get migration error : %s", err)
+		return
+	}
+
+	if len(rs) == 0 {
+		err = fmt.Errorf("get migration %d is not found", id)
+		return
+	}
+	MetaLogger.Trace("finish fetching single migration by id")
+	return rs[0], nil
+}
+
+func CreateMigration(cfg *raiden.Config, m objects.Migration) (objects.Migration, error) {
+	MetaLogger.Trace("start create migration history")
+	if err := createMigrationTable(cfg); err != nil {
+		return objects.Migration{}, err
+	}
+
+	q, err := query.BuildCreateMigrationQuery(&m)
+	if err != nil {
+		return objects.Migration{}, err
+	}
+
+	rs, err := ExecuteQuery[[]objects.Migration](getBaseUrl(cfg), q, nil, DefaultInterceptor(cfg), nil)
+	if err != nil {
+		return objects.Migration{}, fmt.Errorf("create migration history error : %s", err)
+	}
+
+	if len(rs) == 0 {
+		return objects.Migration{}, fmt.Errorf("create migration history error : empty response")
+	}
+	MetaLogger.Trace("finish create migration history")
+	return rs[0], nil
+}
+
+func createMigrationTable(cfg *raiden.Config) error {
+	_, err := ExecuteQuery[any](getBaseUrl(cfg), sql.CreateMigrationTableQuery, nil, DefaultInterceptor(cfg), nil)
+	if err != nil {
+		return fmt.Errorf("create migration table error : %s", err)
+	}
+	return nil
+}
+
+func RunMigrationScript(cfg *raiden.Config, script string) error {
+	MetaLogger.Trace("start run migration script")
+	_, err := ExecuteQuery[any](getBaseUrl(cfg), script, nil, DefaultInterceptor(cfg), nil)
+	if err != nil {
+		// database error message is returned in response body
+		var reqErr net.ReqError
+		if errors.As(err, &reqErr) && len(reqErr.Body) > 0 {
+			return fmt.Errorf("run migration script error : %s : %s", err, string(reqErr.Body))
+		}
+		return fmt.Errorf("run migration script error : %s", err)
+	}
+	MetaLogger.Trace("finish run migration script")
+	return nil
+}
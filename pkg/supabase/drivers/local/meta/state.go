@@ -0,0 +1,87 @@
+package meta
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/sev-2/raiden"
+	"github.com/sev-2/raiden/pkg/supabase/objects"
+	"github.com/sev-2/raiden/pkg/supabase/query"
+	"github.com/sev-2/raiden/pkg/supabase/query/sql"
+)
+
+// GetState return stored state with the given name,
+// empty state is returned when state is not stored yet
+func GetState(cfg *raiden.Config, name string) (result objects.RemoteState, err error) {
+	MetaLogger.Trace("start fetching state from meta", "name", name)
+	if err = createStateTable(cfg); err != nil {
+		return
+	}
+
+	rs, err := ExecuteQuery[[]objects.RemoteState](getBaseUrl(cfg), sql.GenerateStateQuery(name), nil, DefaultInterceptor(cfg), nil)
+	if err != nil {
+		err = fmt.Errorf("get state error : %s", err)
+		return
+	}
+
+	if len(rs) == 0 {
+		return objects.RemoteState{Name: name}, nil
+	}
+	MetaLogger.Trace("finish fetching state from meta", "name", name)
+	return rs[0], nil
+}
+
+func SaveState(cfg *raiden.Config, s objects.RemoteState) error {
+	MetaLogger.Trace("start save state to meta", "name", s.Name)
+	if err := createStateTable(cfg); err != nil {
+		return err
+	}
+
+	q, err := query.BuildSaveStateQuery(&s)
+	if err != nil {
+		return err
+	}
+
+	if _, err := ExecuteQuery[any](getBaseUrl(cfg), q, nil, DefaultInterceptor(cfg), nil); err != nil {
+		return fmt.Errorf("save state error : %s", err)
+	}
+	MetaLogger.Trace("finish save state to meta", "name", s.Name)
+	return nil
+}
+
+func LockState(cfg *raiden.Config, name, lockId, lockedBy string, ttl time.Duration) (result objects.RemoteState, err error) {
+	MetaLogger.Trace("start lock state in meta", "name", name)
+	if err = createStateTable(cfg); err != nil {
+		return
+	}
+
+	rs, err := ExecuteQuery[[]objects.RemoteState](getBaseUrl(cfg), query.BuildLockStateQuery(name, lockId, lockedBy, ttl), nil, DefaultInterceptor(cfg), nil)
+	if err != nil {
+		err = fmt.Errorf("lock state error : %s", err)
+		return
+	}
+
+	if len(rs) == 0 {
+		err = fmt.Errorf("lock state error : empty response")
+		return
+	}
+	MetaLogger.Trace("finish lock state in meta", "name", name)
+	return rs[0], nil
+}
+
+func UnlockState(cfg *raiden.Config, name, lockId string) error {
+	MetaLogger.Trace("start unlock state in meta", "name", name)
+	if _, err := ExecuteQuery[any](getBaseUrl(cfg), query.BuildUnlockStateQuery(name, lockId), nil, DefaultInterceptor(cfg), nil); err != nil {
+		return fmt.Errorf("unlock state error : %s", err)
+	}
+	MetaLogger.Trace("finish unlock state in meta", "name", name)
+	return nil
+}
+
+func createStateTable(cfg *raiden.Config) error {
+	_, err := ExecuteQuery[any](getBaseUrl(cfg), sql.CreateStateTableQuery, nil, DefaultInterceptor(cfg), nil)
+	if err != nil {
+		return fmt.Errorf("create state table error : %s", err)
+	}
+	return nil
+}
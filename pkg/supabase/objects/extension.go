@@ -0,0 +1,21 @@
+package objects
+
+type Extension struct {
+	Name             string  `json:"name"`
+	Schema           string  `json:"schema"`
+	DefaultVersion   string  `json:"default_version"`
+	InstalledVersion string  `json:"installed_version"`
+	Comment          *string `json:"comment"`
+}
+
+type UpdateExtensionType string
+
+const (
+	UpdateExtensionSchema  UpdateExtensionType = "schema"
+	UpdateExtensionVersion UpdateExtensionType = "version"
+)
+
+type UpdateExtensionParam struct {
+	OldData     Extension
+	ChangeItems []UpdateExtensionType
+}
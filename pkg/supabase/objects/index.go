@@ -1,8 +1,13 @@
 package objects
 
 type Index struct {
-	Schema     string `json:"schema"`
-	Table      string `json:"table"`
-	Name       string `json:"name"`
-	Definition string `json:"definition"`
+	Schema       string   `json:"schema"`
+	Table        string   `json:"table"`
+	Name         string   `json:"name"`
+	Definition   string   `json:"definition"`
+	Columns      []string `json:"index_columns"`
+	Method       string   `json:"method"`
+	IsUnique     bool     `json:"is_unique"`
+	Predicate    string   `json:"predicate"`
+	IsConstraint bool     `json:"is_constraint"`
 }
@@ -0,0 +1,35 @@
+package objects
+
+import (
+	"encoding/json"
+	"time"
+)
+
+type MigrationAction string
+
+const (
+	MigrationActionApply    MigrationAction = "apply"
+	MigrationActionRollback MigrationAction = "rollback"
+	MigrationActionPromote  MigrationAction = "promote"
+)
+
+// Migration is history of apply run that stored in raiden.migrations table,
+// snapshot contain local state before the run and used for rollback
+type Migration struct {
+	ID         int             `json:"id"`
+	Action     MigrationAction `json:"action"`
+	Checksum   string          `json:"checksum"`
+	Items      []MigrationItem `json:"items"`
+	Snapshot   json.RawMessage `json:"snapshot,omitempty"`
+	DurationMs int64           `json:"duration_ms"`
+	AppliedBy  string          `json:"applied_by"`
+	AppliedAt  time.Time       `json:"applied_at"`
+	Errors     []string        `json:"errors"`
+	RollbackOf *int            `json:"rollback_of"`
+}
+
+type MigrationItem struct {
+	Type     string `json:"type"`
+	Resource string `json:"resource"`
+	Name     string `json:"name"`
+}
@@ -0,0 +1,28 @@
+package objects
+
+type Publication struct {
+	ID              int                `json:"id"`
+	Name            string             `json:"name"`
+	Owner           string             `json:"owner"`
+	PublishInsert   bool               `json:"publish_insert"`
+	PublishUpdate   bool               `json:"publish_update"`
+	PublishDelete   bool               `json:"publish_delete"`
+	PublishTruncate bool               `json:"publish_truncate"`
+	Tables          []PublicationTable `json:"tables"`
+}
+
+type PublicationTable struct {
+	ID        int      `json:"id"`
+	Name      string   `json:"name"`
+	Schema    string   `json:"schema"`
+	Columns   []string `json:"columns"`
+	RowFilter string   `json:"row_filter"`
+}
+
+// TablePublication is table membership in publication,
+// empty columns mean all table column is published
+type TablePublication struct {
+	Name      string   `json:"name"`
+	Columns   []string `json:"columns"`
+	RowFilter string   `json:"row_filter"`
+}
@@ -0,0 +1,22 @@
+package objects
+
+import (
+	"encoding/json"
+	"time"
+)
+
+// RemoteState is raiden state that stored in raiden.state table,
+// lock column is filled when state is used by running apply
+type RemoteState struct {
+	Name      string          `json:"name"`
+	Data      json.RawMessage `json:"data,omitempty"`
+	UpdatedBy *string         `json:"updated_by"`
+	UpdatedAt *time.Time      `json:"updated_at"`
+	LockId    *string         `json:"lock_id"`
+	LockedBy  *string         `json:"locked_by"`
+	LockedAt  *time.Time      `json:"locked_at"`
+}
+
+func (s RemoteState) IsLockedBy(lockId string) bool {
+	return s.LockId != nil && *s.LockId == lockId
+}
@@ -98,10 +98,8 @@ type Column struct {
 	IsUpdatable        bool     `json:"is_updatable"`
 	IsUnique           bool     `json:"is_unique"`
 	Enums              []string `json:"enums"`
-
-	// TODO : implement check and comment in models
-	Check   any `json:"check"`
-	Comment any `json:"comment"`
+	Check              any      `json:"check"`
+	Comment            any      `json:"comment"`
 }
 
 type PrimaryKey struct {
@@ -121,6 +119,8 @@ type Table struct {
 	Name             string               `json:"name"`
 	PrimaryKeys      []PrimaryKey         `json:"primary_keys"`
 	Relationships    []TablesRelationship `json:"relationships"`
+	Indexes          []Index              `json:"indexes"`
+	Publications     []TablePublication   `json:"publications"`
 	ReplicaIdentity  ReplicaIdentity      `json:"replica_identity"`
 	RLSEnabled       bool                 `json:"rls_enabled"`
 	RLSForced        bool                 `json:"rls_forced"`
@@ -132,6 +132,8 @@ type Table struct {
 type UpdateTableType string
 type UpdateColumnType string
 type UpdateRelationType string
+type UpdateIndexType string
+type UpdatePublicationType string
 
 const (
 	UpdateTableSchema          UpdateTableType = "schema"
@@ -140,6 +142,7 @@ const (
 	UpdateTableRlsForced       UpdateTableType = "rls_forced"
 	UpdateTablePrimaryKey      UpdateTableType = "primary_key"
 	UpdateTableReplicaIdentity UpdateTableType = "replica_identity"
+	UpdateTableComment         UpdateTableType = "comment"
 )
 
 const (
@@ -151,6 +154,9 @@ const (
 	UpdateColumnUnique       UpdateColumnType = "unique"
 	UpdateColumnNullable     UpdateColumnType = "nullable"
 	UpdateColumnIdentity     UpdateColumnType = "identity"
+	UpdateColumnCheck        UpdateColumnType = "check"
+	UpdateColumnComment      UpdateColumnType = "comment"
+	UpdateColumnGenerated    UpdateColumnType = "generated"
 )
 
 const (
@@ -162,20 +168,55 @@ const (
 	UpdateRelationCreateIndex    UpdateRelationType = "index"
 )
 
+const (
+	UpdateIndexCreate UpdateIndexType = "create"
+	UpdateIndexUpdate UpdateIndexType = "update"
+	UpdateIndexDelete UpdateIndexType = "delete"
+)
+
+const (
+	UpdatePublicationAdd    UpdatePublicationType = "add"
+	UpdatePublicationUpdate UpdatePublicationType = "update"
+	UpdatePublicationDrop   UpdatePublicationType = "drop"
+)
+
+// UpdateColumnItem is changed column, old name is
+// filled when column is renamed
 type UpdateColumnItem struct {
 	Name        string
+	OldName     string
 	UpdateItems []UpdateColumnType
 }
 
+// GetOldName return column name before changed
+func (i UpdateColumnItem) GetOldName() string {
+	if i.OldName != "" {
+		return i.OldName
+	}
+	return i.Name
+}
+
 type UpdateRelationItem struct {
 	Data TablesRelationship
 	Type UpdateRelationType
 }
 
+type UpdateIndexItem struct {
+	Data Index
+	Type UpdateIndexType
+}
+
+type UpdatePublicationItem struct {
+	Data TablePublication
+	Type UpdatePublicationType
+}
+
 type UpdateTableParam struct {
-	OldData             Table
-	ChangeRelationItems []UpdateRelationItem
-	ChangeColumnItems   []UpdateColumnItem
-	ChangeItems         []UpdateTableType
-	ForceCreateRelation bool
+	OldData                Table
+	ChangeRelationItems    []UpdateRelationItem
+	ChangeIndexItems       []UpdateIndexItem
+	ChangePublicationItems []UpdatePublicationItem
+	ChangeColumnItems      []UpdateColumnItem
+	ChangeItems            []UpdateTableType
+	ForceCreateRelation    bool
 }
@@ -0,0 +1,37 @@
+package objects
+
+type Trigger struct {
+	ID             int      `json:"id"`
+	TableID        int      `json:"table_id"`
+	EnabledMode    string   `json:"enabled_mode"`
+	FunctionArgs   []string `json:"function_args"`
+	Name           string   `json:"name"`
+	Table          string   `json:"table"`
+	Schema         string   `json:"schema"`
+	Condition      *string  `json:"condition"` // Use pointer to handle null values
+	Orientation    string   `json:"orientation"`
+	Activation     string   `json:"activation"`
+	Events         []string `json:"events"`
+	FunctionName   string   `json:"function_name"`
+	FunctionSchema string   `json:"function_schema"`
+}
+
+type UpdateTriggerType string
+
+const (
+	UpdateTriggerName         UpdateTriggerType = "name"
+	UpdateTriggerSchema       UpdateTriggerType = "schema"
+	UpdateTriggerTable        UpdateTriggerType = "table"
+	UpdateTriggerActivation   UpdateTriggerType = "activation"
+	UpdateTriggerEvents       UpdateTriggerType = "events"
+	UpdateTriggerOrientation  UpdateTriggerType = "orientation"
+	UpdateTriggerCondition    UpdateTriggerType = "condition"
+	UpdateTriggerFunction     UpdateTriggerType = "function"
+	UpdateTriggerFunctionArgs UpdateTriggerType = "function_args"
+	UpdateTriggerEnabledMode  UpdateTriggerType = "enabled_mode"
+)
+
+type UpdateTriggerParam struct {
+	OldData     Trigger
+	ChangeItems []UpdateTriggerType
+}
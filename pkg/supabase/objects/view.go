@@ -0,0 +1,25 @@
+package objects
+
+type View struct {
+	ID             int      `json:"id"`
+	Schema         string   `json:"schema"`
+	Name           string   `json:"name"`
+	Definition     string   `json:"definition"`
+	IsMaterialized bool     `json:"is_materialized"`
+	IsPopulated    bool     `json:"is_populated"`
+	IsUpdatable    bool     `json:"is_updatable"`
+	Comment        *string  `json:"comment"`
+	Columns        []Column `json:"columns"`
+}
+
+type UpdateViewType string
+
+const (
+	UpdateViewDefinition   UpdateViewType = "definition"
+	UpdateViewMaterialized UpdateViewType = "materialized"
+)
+
+type UpdateViewParam struct {
+	OldData     View
+	ChangeItems []UpdateViewType
+}
@@ -0,0 +1,80 @@
+package query
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/lib/pq"
+	"github.com/sev-2/raiden/pkg/supabase/objects"
+	"github.com/sev-2/raiden/pkg/supabase/query/sql"
+)
+
+type ExtensionAction string
+
+const (
+	ExtensionActionCreate ExtensionAction = "create"
+	ExtensionActionUpdate ExtensionAction = "update"
+	ExtensionActionDelete ExtensionAction = "delete"
+)
+
+func BuildCreateExtensionQuery(extension *objects.Extension) string {
+	if extension == nil {
+		return ""
+	}
+
+	createSql := fmt.Sprintf(`CREATE EXTENSION IF NOT EXISTS %s`, pq.QuoteIdentifier(extension.Name))
+	if extension.Schema != "" {
+		createSql = fmt.Sprintf(`%s WITH SCHEMA %s`, createSql, pq.QuoteIdentifier(extension.Schema))
+	}
+
+	if extension.InstalledVersion != "" {
+		createSql = fmt.Sprintf("%s VERSION %s", createSql, sql.Literal(extension.InstalledVersion))
+	}
+
+	return createSql + ";"
+}
+
+func BuildDeleteExtensionQuery(extension *objects.Extension) string {
+	if extension == nil {
+		return ""
+	}
+	return fmt.Sprintf(`DROP EXTENSION IF EXISTS %s;`, pq.QuoteIdentifier(extension.Name))
+}
+
+func BuildExtensionQuery(action ExtensionAction, extension *objects.Extension) (string, error) {
+	switch action {
+	case ExtensionActionCreate:
+		return BuildCreateExtensionQuery(extension), nil
+	case ExtensionActionDelete:
+		return BuildDeleteExtensionQuery(extension), nil
+	case ExtensionActionUpdate:
+		if extension == nil {
+			return "", nil
+		}
+		return BuildUpdateExtensionQuery(*extension, objects.UpdateExtensionParam{
+			ChangeItems: []objects.UpdateExtensionType{objects.UpdateExtensionSchema, objects.UpdateExtensionVersion},
+		}), nil
+	default:
+		return "", fmt.Errorf("generate extension sql with action '%s' is not available", action)
+	}
+}
+
+func BuildUpdateExtensionQuery(newExtension objects.Extension, updateItem objects.UpdateExtensionParam) string {
+	var alterSql []string
+	for _, item := range updateItem.ChangeItems {
+		switch item {
+		case objects.UpdateExtensionSchema:
+			if newExtension.Schema != "" {
+				alterSql = append(alterSql, fmt.Sprintf(`ALTER EXTENSION %s SET SCHEMA %s;`, pq.QuoteIdentifier(newExtension.Name), pq.QuoteIdentifier(newExtension.Schema)))
+			}
+		case objects.UpdateExtensionVersion:
+			if newExtension.InstalledVersion != "" {
+				alterSql = append(alterSql, fmt.Sprintf(`ALTER EXTENSION %s UPDATE TO %s;`, pq.QuoteIdentifier(newExtension.Name), sql.Literal(newExtension.InstalledVersion)))
+			} else {
+				alterSql = append(alterSql, fmt.Sprintf(`ALTER EXTENSION %s UPDATE;`, pq.QuoteIdentifier(newExtension.Name)))
+			}
+		}
+	}
+
+	return strings.Join(alterSql, " ")
+}
@@ -19,16 +19,22 @@ func BuildFunctionQuery(action FunctionAction, fn *objects.Function) (string, er
 	case FunctionActionCreate:
 		return fn.CompleteStatement + ";", nil
 	case FunctionActionDelete:
-		return fmt.Sprintf("DROP FUNCTION %s.%s;", fn.Schema, fn.Name), nil
+		return fmt.Sprintf("DROP FUNCTION %s;", buildFunctionSignature(fn)), nil
 	case FunctionActionUpdate:
 		return fmt.Sprintf(`
 			BEGIN; 
 				%s 
 				%s  
 			COMMIT;
-		`, fmt.Sprintf("DROP FUNCTION %s.%s;", fn.Schema, fn.Name), fn.CompleteStatement+";"), nil
+		`, fmt.Sprintf("DROP FUNCTION %s;", buildFunctionSignature(fn)), fn.CompleteStatement+";"), nil
 
 	default:
 		return "", fmt.Errorf("generate function sql with type '%s' is not available", action)
 	}
 }
+
+// buildFunctionSignature return function name with identity argument types,
+// so only the given overload of function is dropped
+func buildFunctionSignature(fn *objects.Function) string {
+	return fmt.Sprintf("%s.%s(%s)", fn.Schema, fn.Name, fn.IdentityArgumentTypes)
+}
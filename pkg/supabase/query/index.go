@@ -0,0 +1,82 @@
+package query
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/sev-2/raiden/pkg/supabase/objects"
+)
+
+// plain index element is column name with optional operator class,
+// sort order and nulls order (ex: "created_at desc", "name gin_trgm_ops")
+var plainIndexElementRegex = regexp.MustCompile(`^[A-Za-z_][A-Za-z0-9_]*(\s+[A-Za-z_][A-Za-z0-9_]*)*$`)
+
+func getIndexSchema(index *objects.Index) string {
+	if index.Schema != "" {
+		return index.Schema
+	}
+	return "public"
+}
+
+// BuildIndexElement return index element sql,
+// expression element is wrapped with parentheses
+func BuildIndexElement(element string) string {
+	element = strings.TrimSpace(element)
+	if plainIndexElementRegex.MatchString(element) || strings.HasPrefix(element, "(") {
+		return element
+	}
+	return fmt.Sprintf("(%s)", element)
+}
+
+func BuildCreateIndexQuery(index *objects.Index) string {
+	if index == nil {
+		return ""
+	}
+
+	var unique string
+	if index.IsUnique {
+		unique = " UNIQUE"
+	}
+
+	method := index.Method
+	if method == "" {
+		method = "btree"
+	}
+
+	var elements []string
+	for _, c := range index.Columns {
+		elements = append(elements, BuildIndexElement(c))
+	}
+
+	var predicate string
+	if index.Predicate != "" {
+		predicate = fmt.Sprintf(" WHERE %s", index.Predicate)
+	}
+
+	return fmt.Sprintf(
+		"CREATE%s INDEX IF NOT EXISTS %s ON %s.%s USING %s (%s)%s;",
+		unique, index.Name, getIndexSchema(index), index.Table, method, strings.Join(elements, ", "), predicate,
+	)
+}
+
+func BuildDeleteIndexQuery(index *objects.Index) string {
+	if index == nil {
+		return ""
+	}
+	return fmt.Sprintf("DROP INDEX IF EXISTS %s.%s;", getIndexSchema(index), index.Name)
+}
+
+func BuildIndexQuery(updateType objects.UpdateIndexType, index *objects.Index) (string, error) {
+	switch updateType {
+	case objects.UpdateIndexCreate:
+		return BuildCreateIndexQuery(index), nil
+	case objects.UpdateIndexUpdate:
+		// index definition can't be altered, drop and create again
+		return BuildDeleteIndexQuery(index) + BuildCreateIndexQuery(index), nil
+	case objects.UpdateIndexDelete:
+		return BuildDeleteIndexQuery(index), nil
+	default:
+		return "", fmt.Errorf("update index with type '%s' is not available", updateType)
+	}
+}
@@ -0,0 +1,65 @@
+package query
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/sev-2/raiden/pkg/supabase/objects"
+	"github.com/sev-2/raiden/pkg/supabase/query/sql"
+)
+
+func BuildCreateMigrationQuery(migration *objects.Migration) (string, error) {
+	if migration == nil {
+		return "", fmt.Errorf("migration data is required")
+	}
+
+	items := migration.Items
+	if items == nil {
+		items = []objects.MigrationItem{}
+	}
+	itemsByte, err := json.Marshal(items)
+	if err != nil {
+		return "", err
+	}
+
+	errs := migration.Errors
+	if errs == nil {
+		errs = []string{}
+	}
+	errorsByte, err := json.Marshal(errs)
+	if err != nil {
+		return "", err
+	}
+
+	action := migration.Action
+	if action == "" {
+		action = objects.MigrationActionApply
+	}
+
+	snapshot := "NULL"
+	if len(migration.Snapshot) > 0 {
+		snapshot = fmt.Sprintf("%s::jsonb", escapeLiteral(string(migration.Snapshot)))
+	}
+
+	appliedBy := "current_user"
+	if migration.AppliedBy != "" {
+		appliedBy = escapeLiteral(migration.AppliedBy)
+	}
+
+	rollbackOf := "NULL"
+	if migration.RollbackOf != nil {
+		rollbackOf = fmt.Sprintf("%d", *migration.RollbackOf)
+	}
+
+	return fmt.Sprintf(
+		"INSERT INTO %s.%s (action, checksum, items, snapshot, duration_ms, applied_by, errors, rollback_of) VALUES (%s, %s, %s::jsonb, %s, %d, %s, %s::jsonb, %s) RETURNING id, action, checksum, items, duration_ms, applied_by, applied_at, errors, rollback_of;",
+		sql.MigrationSchema, sql.MigrationTable,
+		escapeLiteral(string(action)), escapeLiteral(migration.Checksum), escapeLiteral(string(itemsByte)),
+		snapshot, migration.DurationMs, appliedBy, escapeLiteral(string(errorsByte)), rollbackOf,
+	), nil
+}
+
+func escapeLiteral(value string) string {
+	return sql.Literal(strings.ReplaceAll(value, "'", "''"))
+}
@@ -0,0 +1,51 @@
+package query
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/sev-2/raiden/pkg/supabase/objects"
+)
+
+func BuildAddTablePublicationQuery(schema, table string, publication *objects.TablePublication) string {
+	if publication == nil {
+		return ""
+	}
+
+	var columns string
+	if len(publication.Columns) > 0 {
+		var quotedColumns []string
+		for _, c := range publication.Columns {
+			quotedColumns = append(quotedColumns, fmt.Sprintf("%q", c))
+		}
+		columns = fmt.Sprintf(" (%s)", strings.Join(quotedColumns, ", "))
+	}
+
+	var rowFilter string
+	if publication.RowFilter != "" {
+		rowFilter = fmt.Sprintf(" WHERE (%s)", publication.RowFilter)
+	}
+
+	return fmt.Sprintf(`ALTER PUBLICATION %q ADD TABLE %q.%q%s%s;`, publication.Name, schema, table, columns, rowFilter)
+}
+
+func BuildDropTablePublicationQuery(schema, table string, publication *objects.TablePublication) string {
+	if publication == nil {
+		return ""
+	}
+	return fmt.Sprintf(`ALTER PUBLICATION %q DROP TABLE %q.%q;`, publication.Name, schema, table)
+}
+
+func BuildTablePublicationQuery(updateType objects.UpdatePublicationType, schema, table string, publication *objects.TablePublication) (string, error) {
+	switch updateType {
+	case objects.UpdatePublicationAdd:
+		return BuildAddTablePublicationQuery(schema, table, publication), nil
+	case objects.UpdatePublicationUpdate:
+		// column list and row filter is replaced by drop and add table again
+		return BuildDropTablePublicationQuery(schema, table, publication) + BuildAddTablePublicationQuery(schema, table, publication), nil
+	case objects.UpdatePublicationDrop:
+		return BuildDropTablePublicationQuery(schema, table, publication), nil
+	default:
+		return "", fmt.Errorf("update publication with type '%s' is not available", updateType)
+	}
+}
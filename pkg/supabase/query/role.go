@@ -157,7 +157,11 @@ func BuildUpdateRoleQuery(newRole objects.Role, updateRoleParam objects.UpdateRo
 				updateRoleClauses = append(updateRoleClauses, "NOLOGIN")
 			}
 		case objects.UpdateRoleValidUntil:
-			validUntilClause := fmt.Sprintf("VALID UNTIL '%s'", newRole.ValidUntil.Format(raiden.DefaultRoleValidUntilLayout))
+			// role without valid until never expire
+			validUntilClause := "VALID UNTIL 'infinity'"
+			if newRole.ValidUntil != nil {
+				validUntilClause = fmt.Sprintf("VALID UNTIL '%s'", newRole.ValidUntil.Format(raiden.DefaultRoleValidUntilLayout))
+			}
 			updateRoleClauses = append(updateRoleClauses, validUntilClause)
 		case objects.UpdateRoleConfig:
 			var configStrings []string
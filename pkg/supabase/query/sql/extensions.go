@@ -1,5 +1,7 @@
 package sql
 
+import "fmt"
+
 var GetExtensionsQuery = `
 SELECT
   e.name,
@@ -12,3 +14,13 @@ FROM
   LEFT JOIN pg_extension x ON e.name = x.extname
   LEFT JOIN pg_namespace n ON x.extnamespace = n.oid
 `
+
+// GenerateInstalledExtensionsQuery return enabled extension,
+// built in extension in pg_catalog (ex: plpgsql) is excluded
+func GenerateInstalledExtensionsQuery() string {
+	return fmt.Sprintf("select * from (%s) as extensions where extensions.installed_version is not null and extensions.schema <> 'pg_catalog'", GetExtensionsQuery)
+}
+
+func GenerateExtensionQuery(name string) string {
+	return fmt.Sprintf("%s and extensions.name = %s", GenerateInstalledExtensionsQuery(), Literal(name))
+}
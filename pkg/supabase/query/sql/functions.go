@@ -141,3 +141,10 @@ func GenerateFunctionByNameQuery(schema, name string) string {
 
 	return fmt.Sprintf(filteredSql, schemaFilter, nameFilter)
 }
+
+// GenerateFunctionBySignatureQuery filter function by identity argument types,
+// so the right function is returned when function is overloaded
+func GenerateFunctionBySignatureQuery(schema, name, identityArgumentTypes string) string {
+	filteredSql := GenerateFunctionByNameQuery(schema, name) + " and pg_get_function_identity_arguments(f.oid) = %s"
+	return fmt.Sprintf(filteredSql, fmt.Sprintf("'%s'", strings.ReplaceAll(identityArgumentTypes, "'", "''")))
+}
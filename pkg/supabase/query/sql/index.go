@@ -3,13 +3,31 @@ package sql
 import "fmt"
 
 var GetIndexesQuery = `
-SELECT 
-    schemaname AS "schema",
-    tablename AS "table",
-    indexname AS "name",
-    indexdef AS "definition"
-FROM 
-    pg_indexes
+SELECT
+    n.nspname AS "schema",
+    t.relname AS "table",
+    i.relname AS "name",
+    pg_get_indexdef(ix.indexrelid) AS "definition",
+    (
+        SELECT COALESCE(json_agg(
+            pg_get_indexdef(ix.indexrelid, k, true) || CASE WHEN ix.indoption[k - 1] & 1 = 1 THEN ' DESC' ELSE '' END
+            ORDER BY k
+        ), '[]')
+        FROM generate_series(1, ix.indnkeyatts) AS k
+    ) AS "index_columns",
+    am.amname AS "method",
+    ix.indisunique AS "is_unique",
+    COALESCE(pg_get_expr(ix.indpred, ix.indrelid), '') AS "predicate",
+    EXISTS (
+        SELECT 1 FROM pg_constraint con
+        WHERE con.conindid = ix.indexrelid AND con.conrelid = ix.indrelid
+    ) AS "is_constraint"
+FROM
+    pg_index ix
+    JOIN pg_class i ON i.oid = ix.indexrelid
+    JOIN pg_class t ON t.oid = ix.indrelid
+    JOIN pg_namespace n ON n.oid = t.relnamespace
+    JOIN pg_am am ON am.oid = i.relam
 `
 
 func GenerateGetIndexQuery(schema string) string {
@@ -17,7 +35,7 @@ func GenerateGetIndexQuery(schema string) string {
 		schema = "public"
 	}
 
-	filteredSql := GetIndexesQuery + " WHERE schemaname = %s"
+	filteredSql := GetIndexesQuery + " WHERE n.nspname = %s"
 	schemaFilter := fmt.Sprintf("'%s'", schema)
 
 	return fmt.Sprintf(filteredSql, schemaFilter)
@@ -0,0 +1,45 @@
+package sql
+
+import "fmt"
+
+var MigrationSchema = "raiden"
+var MigrationTable = "migrations"
+
+var CreateMigrationTableQuery = fmt.Sprintf(`
+CREATE SCHEMA IF NOT EXISTS %[1]s;
+CREATE TABLE IF NOT EXISTS %[1]s.%[2]s (
+  id bigserial PRIMARY KEY,
+  action text NOT NULL DEFAULT 'apply',
+  checksum text NOT NULL,
+  items jsonb NOT NULL DEFAULT '[]'::jsonb,
+  snapshot jsonb,
+  duration_ms bigint NOT NULL DEFAULT 0,
+  applied_by text NOT NULL DEFAULT current_user,
+  applied_at timestamptz NOT NULL DEFAULT now(),
+  errors jsonb NOT NULL DEFAULT '[]'::jsonb,
+  rollback_of bigint REFERENCES %[1]s.%[2]s (id) ON DELETE SET NULL
+);
+`, MigrationSchema, MigrationTable)
+
+// GetMigrationsQuery return migration history without snapshot
+// because snapshot can be large and only needed for rollback
+var GetMigrationsQuery = fmt.Sprintf(`
+SELECT
+  id,
+  action,
+  checksum,
+  items,
+  duration_ms,
+  applied_by,
+  applied_at,
+  errors,
+  rollback_of
+FROM
+  %s.%s
+ORDER BY
+  id DESC
+`, MigrationSchema, MigrationTable)
+
+func GenerateMigrationQuery(id int) string {
+	return fmt.Sprintf("SELECT * FROM %s.%s WHERE id = %d LIMIT 1", MigrationSchema, MigrationTable, id)
+}
@@ -25,7 +25,15 @@ FROM
             'name',
             c.relname,
             'schema',
-            nc.nspname
+            nc.nspname,
+            'columns',
+            (
+              SELECT COALESCE(json_agg(a.attname ORDER BY a.attnum), '[]')
+              FROM pg_attribute AS a
+              WHERE a.attrelid = c.oid AND a.attnum = ANY(pr.prattrs::int2[])
+            ),
+            'row_filter',
+            COALESCE(pg_get_expr(pr.prqual, pr.prrelid), '')
           )
         ),
         '{}'
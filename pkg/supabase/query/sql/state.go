@@ -0,0 +1,25 @@
+package sql
+
+import (
+	"fmt"
+	"strings"
+)
+
+var StateTable = "state"
+
+var CreateStateTableQuery = fmt.Sprintf(`
+CREATE SCHEMA IF NOT EXISTS %[1]s;
+CREATE TABLE IF NOT EXISTS %[1]s.%[2]s (
+  name text PRIMARY KEY,
+  data jsonb,
+  updated_by text,
+  updated_at timestamptz,
+  lock_id text,
+  locked_by text,
+  locked_at timestamptz
+);
+`, MigrationSchema, StateTable)
+
+func GenerateStateQuery(name string) string {
+	return fmt.Sprintf("SELECT * FROM %s.%s WHERE name = %s LIMIT 1", MigrationSchema, StateTable, Literal(strings.ReplaceAll(name, "'", "''")))
+}
@@ -1,5 +1,7 @@
 package sql
 
+import "fmt"
+
 var GetTriggersQuery = `
 SELECT
   pg_t.oid AS id,
@@ -51,3 +53,18 @@ GROUP BY
   pg_p.proname,
   pg_n.nspname
 `
+
+func GenerateTriggersQuery(includeSchemas []string) string {
+	if len(includeSchemas) == 0 {
+		includeSchemas = append(includeSchemas, "public")
+	}
+
+	return fmt.Sprintf("select * from (%s) as triggers where triggers.schema %s", GetTriggersQuery, filterByList(includeSchemas, nil, nil))
+}
+
+func GenerateTriggerQuery(schema, table, name string) string {
+	return fmt.Sprintf(
+		"%s and triggers.table = %s and triggers.name = %s",
+		GenerateTriggersQuery([]string{schema}), Literal(table), Literal(name),
+	)
+}
@@ -1,5 +1,7 @@
 package sql
 
+import "fmt"
+
 var GetViewsQuery = `
 SELECT
   c.oid :: int8 AS id,
@@ -14,3 +16,44 @@ FROM
 WHERE
   c.relkind = 'v'
 `
+
+// GetViewsWithDefinitionQuery return both of view and materialized view
+// with the select definition, used for import and apply view resource
+var GetViewsWithDefinitionQuery = `
+SELECT
+  c.oid :: int8 AS id,
+  n.nspname AS schema,
+  c.relname AS name,
+  pg_get_viewdef(c.oid, true) AS definition,
+  c.relkind = 'm' AS is_materialized,
+  c.relispopulated AS is_populated,
+  (pg_relation_is_updatable(c.oid, false) & 20) = 20 AS is_updatable,
+  obj_description(c.oid) AS comment
+FROM
+  pg_class c
+  JOIN pg_namespace n ON n.oid = c.relnamespace
+WHERE
+  c.relkind IN ('v', 'm')
+`
+
+const viewsQueryTemplate = `
+WITH views AS (%s)
+  , columns AS (%s)
+SELECT
+  *
+  , COALESCE((SELECT JSON_AGG(row_to_json(columns) ORDER BY columns.ordinal_position) FROM columns WHERE columns.table_id = views.id), '[]') as columns
+FROM views
+where views.schema %s
+`
+
+func GenerateViewsQuery(includeSchemas []string) string {
+	if len(includeSchemas) == 0 {
+		includeSchemas = append(includeSchemas, "public")
+	}
+
+	return fmt.Sprintf(viewsQueryTemplate, GetViewsWithDefinitionQuery, GetColumnsQuery, filterByList(includeSchemas, nil, nil))
+}
+
+func GenerateViewQuery(schema, name string) string {
+	return fmt.Sprintf("%s and views.name = %s", GenerateViewsQuery([]string{schema}), Literal(name))
+}
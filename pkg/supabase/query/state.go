@@ -0,0 +1,46 @@
+package query
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/sev-2/raiden/pkg/supabase/objects"
+	"github.com/sev-2/raiden/pkg/supabase/query/sql"
+)
+
+func BuildSaveStateQuery(state *objects.RemoteState) (string, error) {
+	if state == nil || state.Name == "" {
+		return "", fmt.Errorf("state name is required")
+	}
+
+	updatedBy := "current_user"
+	if state.UpdatedBy != nil && *state.UpdatedBy != "" {
+		updatedBy = escapeLiteral(*state.UpdatedBy)
+	}
+
+	return fmt.Sprintf(
+		"INSERT INTO %[1]s.%[2]s (name, data, updated_by, updated_at) VALUES (%[3]s, %[4]s::jsonb, %[5]s, now()) ON CONFLICT (name) DO UPDATE SET data = EXCLUDED.data, updated_by = EXCLUDED.updated_by, updated_at = EXCLUDED.updated_at RETURNING name, updated_by, updated_at, lock_id, locked_by, locked_at;",
+		sql.MigrationSchema, sql.StateTable, escapeLiteral(state.Name), escapeLiteral(string(state.Data)), updatedBy,
+	), nil
+}
+
+// BuildLockStateQuery return query that take the state lock when lock is free, owned by the same lock id
+// or expired. transaction advisory lock make check and update run one at a time,
+// the last select return current lock holder so caller can check the lock is taken or not
+func BuildLockStateQuery(name, lockId, lockedBy string, ttl time.Duration) string {
+	return fmt.Sprintf(`
+SELECT pg_advisory_xact_lock(hashtext(%[3]s));
+INSERT INTO %[1]s.%[2]s (name) VALUES (%[3]s) ON CONFLICT (name) DO NOTHING;
+UPDATE %[1]s.%[2]s SET lock_id = %[4]s, locked_by = %[5]s, locked_at = now()
+WHERE name = %[3]s AND (lock_id IS NULL OR lock_id = %[4]s OR locked_at < now() - interval '%[6]d seconds');
+SELECT name, updated_by, updated_at, lock_id, locked_by, locked_at FROM %[1]s.%[2]s WHERE name = %[3]s;`,
+		sql.MigrationSchema, sql.StateTable, escapeLiteral(name), escapeLiteral(lockId), escapeLiteral(lockedBy), int64(ttl.Seconds()),
+	)
+}
+
+func BuildUnlockStateQuery(name, lockId string) string {
+	return fmt.Sprintf(
+		"UPDATE %s.%s SET lock_id = NULL, locked_by = NULL, locked_at = NULL WHERE name = %s AND lock_id = %s;",
+		sql.MigrationSchema, sql.StateTable, escapeLiteral(name), escapeLiteral(lockId),
+	)
+}
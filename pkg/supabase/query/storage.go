@@ -0,0 +1,79 @@
+package query
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/sev-2/raiden/pkg/supabase/objects"
+	"github.com/sev-2/raiden/pkg/supabase/query/sql"
+)
+
+// bucket is managed by storage api, the query is used
+// when bucket change need to be written as sql migration
+func getBucketId(bucket *objects.Bucket) string {
+	if bucket.ID != "" {
+		return bucket.ID
+	}
+	return bucket.Name
+}
+
+func buildBucketFileSizeLimit(bucket *objects.Bucket) string {
+	if bucket.FileSizeLimit == nil {
+		return "NULL"
+	}
+	return fmt.Sprintf("%d", *bucket.FileSizeLimit)
+}
+
+func buildBucketAllowedMimeTypes(bucket *objects.Bucket) string {
+	if len(bucket.AllowedMimeTypes) == 0 {
+		return "NULL"
+	}
+
+	var mimeTypes []string
+	for _, m := range bucket.AllowedMimeTypes {
+		mimeTypes = append(mimeTypes, sql.Literal(m))
+	}
+	return fmt.Sprintf("ARRAY[%s]::text[]", strings.Join(mimeTypes, ","))
+}
+
+func BuildCreateBucketQuery(bucket *objects.Bucket) string {
+	if bucket == nil {
+		return ""
+	}
+
+	return fmt.Sprintf(
+		"INSERT INTO storage.buckets (id, name, public, file_size_limit, allowed_mime_types) VALUES (%s, %s, %t, %s, %s) ON CONFLICT (id) DO NOTHING;",
+		sql.Literal(getBucketId(bucket)), sql.Literal(bucket.Name), bucket.Public, buildBucketFileSizeLimit(bucket), buildBucketAllowedMimeTypes(bucket),
+	)
+}
+
+func BuildUpdateBucketQuery(bucket *objects.Bucket, updateItem objects.UpdateBucketParam) string {
+	if bucket == nil {
+		return ""
+	}
+
+	var setClauses []string
+	for _, item := range updateItem.ChangeItems {
+		switch item {
+		case objects.UpdateBucketIsPublic:
+			setClauses = append(setClauses, fmt.Sprintf("public = %t", bucket.Public))
+		case objects.UpdateBucketFileSizeLimit:
+			setClauses = append(setClauses, fmt.Sprintf("file_size_limit = %s", buildBucketFileSizeLimit(bucket)))
+		case objects.UpdateBucketAllowedMimeTypes:
+			setClauses = append(setClauses, fmt.Sprintf("allowed_mime_types = %s", buildBucketAllowedMimeTypes(bucket)))
+		}
+	}
+
+	if len(setClauses) == 0 {
+		return ""
+	}
+
+	return fmt.Sprintf("UPDATE storage.buckets SET %s WHERE id = %s;", strings.Join(setClauses, ", "), sql.Literal(getBucketId(bucket)))
+}
+
+func BuildDeleteBucketQuery(bucket *objects.Bucket) string {
+	if bucket == nil {
+		return ""
+	}
+	return fmt.Sprintf("DELETE FROM storage.buckets WHERE id = %s;", sql.Literal(getBucketId(bucket)))
+}
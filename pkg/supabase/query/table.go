@@ -27,26 +27,53 @@ func BuildCreateTableQuery(newTable objects.Table) (string, error) {
 		rlsForcedQuery = fmt.Sprintf("ALTER TABLE %s.%s FORCE ROW LEVEL SECURITY;", newTable.Schema, newTable.Name)
 	}
 
+	var indexQuery string
+	for i := range newTable.Indexes {
+		index := newTable.Indexes[i]
+		index.Schema, index.Table = newTable.Schema, newTable.Name
+		indexQuery += BuildCreateIndexQuery(&index)
+	}
+
+	var publicationQuery string
+	for i := range newTable.Publications {
+		publicationQuery += BuildAddTablePublicationQuery(newTable.Schema, newTable.Name, &newTable.Publications[i])
+	}
+
+	var commentQuery string
+	if getStringValue(newTable.Comment) != "" {
+		commentQuery += buildCommentQuery(fmt.Sprintf("TABLE %s.%s", newTable.Schema, newTable.Name), newTable.Comment)
+	}
+
+	for _, c := range newTable.Columns {
+		if getStringValue(c.Comment) != "" {
+			commentQuery += buildCommentQuery(fmt.Sprintf("COLUMN %s.%s.%s", newTable.Schema, newTable.Name, c.Name), c.Comment)
+		}
+	}
+
 	sql := fmt.Sprintf(`
 	BEGIN;
 	  %s
 	  %s
 	  %s
+	  %s
+	  %s
+	  %s
 	COMMIT;
-	`, createSql, rlsEnableQuery, rlsForcedQuery)
+	`, createSql, rlsEnableQuery, rlsForcedQuery, indexQuery, publicationQuery, commentQuery)
 	return sql, nil
 }
 
 func BuildUpdateTableQuery(newTable objects.Table, updateItem objects.UpdateTableParam) string {
-	var enableRlsQuery, forceRlsQuery, primaryKeysQuery, replicaIdentityQuery, schemaQuery, nameQuery string
+	var enableRlsQuery, forceRlsQuery, primaryKeysQuery, replicaIdentityQuery, commentQuery, schemaQuery, nameQuery string
 	alter := fmt.Sprintf("ALTER TABLE %s.%s", updateItem.OldData.Schema, updateItem.OldData.Name)
 	for _, uType := range updateItem.ChangeItems {
 		switch uType {
 		case objects.UpdateTableSchema:
 			schemaQuery = fmt.Sprintf("%s SET SCHEMA %s;", alter, newTable.Schema)
 		case objects.UpdateTableName:
+			// name is changed after schema, so table is renamed in new schema
 			if newTable.Name != "" {
-				nameQuery = fmt.Sprintf("%s RENAME TO %s;", alter, newTable.Name)
+				nameQuery = fmt.Sprintf("ALTER TABLE %s.%s RENAME TO %s;", newTable.Schema, updateItem.OldData.Name, newTable.Name)
 			}
 		case objects.UpdateTableRlsEnable:
 			if newTable.RLSEnabled {
@@ -62,6 +89,8 @@ func BuildUpdateTableQuery(newTable objects.Table, updateItem objects.UpdateTabl
 			}
 		case objects.UpdateTableReplicaIdentity:
 			// TODO : implement if needed
+		case objects.UpdateTableComment:
+			commentQuery = buildCommentQuery(fmt.Sprintf("TABLE %s.%s", updateItem.OldData.Schema, updateItem.OldData.Name), newTable.Comment)
 		case objects.UpdateTablePrimaryKey:
 			if len(updateItem.OldData.PrimaryKeys) > 0 {
 				primaryKeysQuery += fmt.Sprintf(`
@@ -98,8 +127,9 @@ func BuildUpdateTableQuery(newTable objects.Table, updateItem objects.UpdateTabl
 	  %s
 	  %s
 	  %s
+	  %s
 	COMMIT;
-	`, enableRlsQuery, forceRlsQuery, replicaIdentityQuery, primaryKeysQuery, schemaQuery, nameQuery)
+	`, enableRlsQuery, forceRlsQuery, replicaIdentityQuery, primaryKeysQuery, commentQuery, schemaQuery, nameQuery)
 
 	return sql
 }
@@ -154,26 +184,21 @@ func BuildCreateColumnQuery(column objects.Column, isPrimary bool) (q string, er
 		isPrimaryKeyClause = "PRIMARY KEY"
 	}
 
-	// TODO : implement check setup
-	// checkSql := ""
-	// if column.Check != nil {
-	// 	checkSql = fmt.Sprintf("CHECK (%s)", *column.Check)
-	// }
-
-	// TODO : implement comment setup
-	// commentSql := ""
-	// if column.Comment != nil {
-	// 	commentSql = fmt.Sprintf("COMMENT ON COLUMN %s.%s.%s IS %s", ident(schema), ident(table.Name), ident(name), literal(*column.Comment))
-	// }
+	// check constraint is declared in column definition
+	commentSql := ""
+	if getStringValue(column.Comment) != "" {
+		commentSql = buildCommentQuery(fmt.Sprintf("COLUMN %s.%s.%s", column.Schema, column.Table, column.Name), column.Comment)
+	}
 
 	q = fmt.Sprintf(`
 	BEGIN;
 	  ALTER TABLE %s.%s ADD COLUMN %s %s;
-	COMMIT;`, column.Schema, column.Table, colDef, isPrimaryKeyClause)
+	  %s
+	COMMIT;`, column.Schema, column.Table, colDef, isPrimaryKeyClause, commentSql)
 	return
 }
 
-func BuildUpdateColumnQuery(oldColumn, newColumn objects.Column, updateItem objects.UpdateColumnItem) (q string) {
+func BuildUpdateColumnQuery(oldColumn, newColumn objects.Column, updateItem objects.UpdateColumnItem) (q string, err error) {
 	// Prepare SQL statements
 	var sqlStatements []string
 	var alter = fmt.Sprintf("ALTER TABLE %s.%s", newColumn.Schema, newColumn.Table)
@@ -184,7 +209,7 @@ func BuildUpdateColumnQuery(oldColumn, newColumn objects.Column, updateItem obje
 				sqlStatements = append(
 					sqlStatements,
 					fmt.Sprintf(
-						"%s RENAME COLUMN %s TO %s;", alter, newColumn.Name, newColumn.Name,
+						"%s RENAME COLUMN %s TO %s;", alter, oldColumn.Name, newColumn.Name,
 					),
 				)
 			}
@@ -198,7 +223,7 @@ func BuildUpdateColumnQuery(oldColumn, newColumn objects.Column, updateItem obje
 			sqlStatements = append(
 				sqlStatements,
 				fmt.Sprintf(
-					"%s ALTER COLUMN %s SET DATA TYPE %s USING %s::%s;", alter, oldColumn.Name, dataType, oldColumn.Name, dataType,
+					"%s ALTER COLUMN %s SET DATA TYPE %s USING %s::%s;", alter, newColumn.Name, dataType, newColumn.Name, dataType,
 				),
 			)
 		case objects.UpdateColumnUnique:
@@ -275,7 +300,14 @@ func BuildUpdateColumnQuery(oldColumn, newColumn objects.Column, updateItem obje
 			)
 
 		case objects.UpdateColumnIdentity:
-			if newColumn.IsIdentity {
+			if newColumn.IsIdentity && oldColumn.IsIdentity {
+				sqlStatements = append(
+					sqlStatements,
+					fmt.Sprintf(
+						"%s ALTER COLUMN %s SET GENERATED %s;", alter, newColumn.Name, newColumn.IdentityGeneration,
+					),
+				)
+			} else if newColumn.IsIdentity {
 				sqlStatements = append(
 					sqlStatements,
 					fmt.Sprintf(
@@ -290,6 +322,47 @@ func BuildUpdateColumnQuery(oldColumn, newColumn objects.Column, updateItem obje
 					),
 				)
 			}
+		case objects.UpdateColumnCheck:
+			// use default name of column check constraint, so constraint
+			// created in column definition can be replaced
+			constraintName := fmt.Sprintf("%s_%s_check", newColumn.Table, newColumn.Name)
+			sqlStatements = append(
+				sqlStatements,
+				fmt.Sprintf("%s DROP CONSTRAINT IF EXISTS %s;", alter, constraintName),
+			)
+
+			if check := getStringValue(newColumn.Check); check != "" {
+				sqlStatements = append(
+					sqlStatements,
+					fmt.Sprintf("%s ADD CONSTRAINT %s CHECK (%s);", alter, constraintName, check),
+				)
+			}
+		case objects.UpdateColumnComment:
+			sqlStatements = append(
+				sqlStatements,
+				buildCommentQuery(fmt.Sprintf("COLUMN %s.%s.%s", newColumn.Schema, newColumn.Table, newColumn.Name), newColumn.Comment),
+			)
+		case objects.UpdateColumnGenerated:
+			// expression of generated column can't be altered,
+			// the column is recreated and the value is computed again
+			if !newColumn.IsGenerated {
+				sqlStatements = append(
+					sqlStatements,
+					fmt.Sprintf("%s ALTER COLUMN %s DROP EXPRESSION;", alter, newColumn.Name),
+				)
+				continue
+			}
+
+			colDef, err := buildColumnDef(newColumn)
+			if err != nil {
+				return q, fmt.Errorf("err build column definition %s : %s", newColumn.Name, err.Error())
+			}
+
+			sqlStatements = append(
+				sqlStatements,
+				fmt.Sprintf("%s DROP COLUMN %s;", alter, newColumn.Name),
+				fmt.Sprintf("%s ADD COLUMN %s;", alter, colDef),
+			)
 		}
 	}
 
@@ -300,12 +373,18 @@ func BuildUpdateColumnQuery(oldColumn, newColumn objects.Column, updateItem obje
 	}
 	q += " COMMIT;"
 
-	return q
+	return q, nil
 }
 
 func buildColumnDef(column objects.Column) (string, error) {
 	var defaultValueClause string
-	if column.IsIdentity {
+	if column.IsGenerated {
+		expression := getStringValue(column.DefaultValue)
+		if expression == "" {
+			return "", fmt.Errorf("generated column %s.%s %s does not have generation expression", column.Schema, column.Table, column.Name)
+		}
+		defaultValueClause = fmt.Sprintf("GENERATED ALWAYS AS (%s) STORED", expression)
+	} else if column.IsIdentity {
 		if column.DefaultValue != nil {
 			return "", fmt.Errorf("columns %s.%s %s cannot both be identity and have a default value", column.Schema, column.Table, column.Name)
 		}
@@ -365,9 +444,32 @@ func buildColumnDef(column objects.Column) (string, error) {
 	}
 
 	q := fmt.Sprintf("%s %s %s %s %s", column.Name, dataType, defaultValueClause, isNullableClause, isUniqueClause)
+	if check := getStringValue(column.Check); check != "" {
+		q += fmt.Sprintf(" CHECK (%s)", check)
+	}
 	return q, nil
 }
 
+func buildCommentQuery(target string, comment any) string {
+	value := "NULL"
+	if c := getStringValue(comment); c != "" {
+		value = escapeLiteral(c)
+	}
+	return fmt.Sprintf("COMMENT ON %s IS %s;", target, value)
+}
+
+func getStringValue(value any) string {
+	switch v := value.(type) {
+	case string:
+		return v
+	case *string:
+		if v != nil {
+			return *v
+		}
+	}
+	return ""
+}
+
 func BuildDeleteColumnQuery(column objects.Column) (q string) {
 	return fmt.Sprintf("ALTER TABLE %s.%s DROP COLUMN %s;", column.Schema, column.Table, column.Name)
 }
@@ -0,0 +1,124 @@
+package query
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/lib/pq"
+	"github.com/sev-2/raiden/pkg/supabase/objects"
+)
+
+type TriggerAction string
+
+const (
+	TriggerActionCreate TriggerAction = "create"
+	TriggerActionUpdate TriggerAction = "update"
+	TriggerActionDelete TriggerAction = "delete"
+)
+
+func BuildCreateTriggerQuery(trigger *objects.Trigger) string {
+	if trigger == nil {
+		return ""
+	}
+
+	schema, functionSchema := "public", "public"
+	if trigger.Schema != "" {
+		schema = trigger.Schema
+	}
+
+	if trigger.FunctionSchema != "" {
+		functionSchema = trigger.FunctionSchema
+	}
+
+	orientation := "ROW"
+	if trigger.Orientation != "" {
+		orientation = strings.ToUpper(trigger.Orientation)
+	}
+
+	var condition string
+	if trigger.Condition != nil && *trigger.Condition != "" {
+		condition = fmt.Sprintf(" WHEN (%s)", *trigger.Condition)
+	}
+
+	args := []string{}
+	for _, a := range trigger.FunctionArgs {
+		args = append(args, pq.QuoteLiteral(a))
+	}
+
+	createSql := fmt.Sprintf(
+		`CREATE TRIGGER %s %s %s ON %s FOR EACH %s%s EXECUTE FUNCTION %s(%s);`,
+		pq.QuoteIdentifier(trigger.Name), strings.ToUpper(trigger.Activation), strings.ToUpper(strings.Join(trigger.Events, " OR ")),
+		getTriggerIdentifier(schema, trigger.Table), orientation, condition,
+		getTriggerIdentifier(functionSchema, trigger.FunctionName), strings.Join(args, ","),
+	)
+
+	if enabledSql := buildTriggerEnabledModeQuery(trigger, schema); enabledSql != "" {
+		createSql = fmt.Sprintf("%s %s", createSql, enabledSql)
+	}
+
+	return createSql
+}
+
+func buildTriggerEnabledModeQuery(trigger *objects.Trigger, schema string) string {
+	var mode string
+	switch strings.ToUpper(trigger.EnabledMode) {
+	case "DISABLED":
+		mode = "DISABLE"
+	case "REPLICA":
+		mode = "ENABLE REPLICA"
+	case "ALWAYS":
+		mode = "ENABLE ALWAYS"
+	default:
+		return ""
+	}
+	return fmt.Sprintf(`ALTER TABLE %s %s TRIGGER %s;`, getTriggerIdentifier(schema, trigger.Table), mode, pq.QuoteIdentifier(trigger.Name))
+}
+
+// getTriggerIdentifier return quoted schema and name of trigger table or function, for example "public"."users"
+func getTriggerIdentifier(schema, name string) string {
+	return fmt.Sprintf("%s.%s", pq.QuoteIdentifier(schema), pq.QuoteIdentifier(name))
+}
+
+func BuildDeleteTriggerQuery(trigger *objects.Trigger) string {
+	if trigger == nil {
+		return ""
+	}
+
+	schema := "public"
+	if trigger.Schema != "" {
+		schema = trigger.Schema
+	}
+	return fmt.Sprintf(`DROP TRIGGER IF EXISTS %s ON %s;`, pq.QuoteIdentifier(trigger.Name), getTriggerIdentifier(schema, trigger.Table))
+}
+
+func BuildTriggerQuery(action TriggerAction, trigger *objects.Trigger) (string, error) {
+	switch action {
+	case TriggerActionCreate:
+		return BuildCreateTriggerQuery(trigger), nil
+	case TriggerActionDelete:
+		return BuildDeleteTriggerQuery(trigger), nil
+	case TriggerActionUpdate:
+		if trigger == nil {
+			return "", nil
+		}
+		return BuildUpdateTriggerQuery(*trigger, objects.UpdateTriggerParam{OldData: *trigger}), nil
+	default:
+		return "", fmt.Errorf("generate trigger sql with action '%s' is not available", action)
+	}
+}
+
+// BuildUpdateTriggerQuery recreate trigger in single transaction,
+// old trigger is dropped first because name or target table can be changed
+func BuildUpdateTriggerQuery(newTrigger objects.Trigger, updateItem objects.UpdateTriggerParam) string {
+	oldTrigger := updateItem.OldData
+	if oldTrigger.Name == "" {
+		oldTrigger = newTrigger
+	}
+
+	return fmt.Sprintf(`
+		BEGIN;
+			%s
+			%s
+		COMMIT;
+	`, BuildDeleteTriggerQuery(&oldTrigger), BuildCreateTriggerQuery(&newTrigger))
+}
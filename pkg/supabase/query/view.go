@@ -0,0 +1,111 @@
+package query
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/lib/pq"
+	"github.com/sev-2/raiden/pkg/supabase/objects"
+)
+
+type ViewAction string
+
+const (
+	ViewActionCreate ViewAction = "create"
+	ViewActionUpdate ViewAction = "update"
+	ViewActionDelete ViewAction = "delete"
+)
+
+func getViewSchema(view *objects.View) string {
+	if view.Schema != "" {
+		return view.Schema
+	}
+	return "public"
+}
+
+// getViewIdentifier return quoted schema and name of view, for example "public"."active_users"
+func getViewIdentifier(view *objects.View) string {
+	return fmt.Sprintf("%s.%s", pq.QuoteIdentifier(getViewSchema(view)), pq.QuoteIdentifier(view.Name))
+}
+
+func cleanViewDefinition(definition string) string {
+	return strings.TrimRight(strings.TrimSpace(definition), ";")
+}
+
+func BuildCreateViewQuery(view *objects.View) string {
+	if view == nil {
+		return ""
+	}
+
+	if view.IsMaterialized {
+		return fmt.Sprintf(`CREATE MATERIALIZED VIEW IF NOT EXISTS %s AS %s;`, getViewIdentifier(view), cleanViewDefinition(view.Definition))
+	}
+	return fmt.Sprintf(`CREATE OR REPLACE VIEW %s AS %s;`, getViewIdentifier(view), cleanViewDefinition(view.Definition))
+}
+
+func BuildDeleteViewQuery(view *objects.View) string {
+	if view == nil {
+		return ""
+	}
+
+	if view.IsMaterialized {
+		return fmt.Sprintf(`DROP MATERIALIZED VIEW IF EXISTS %s;`, getViewIdentifier(view))
+	}
+	return fmt.Sprintf(`DROP VIEW IF EXISTS %s;`, getViewIdentifier(view))
+}
+
+func BuildViewQuery(action ViewAction, view *objects.View) (string, error) {
+	switch action {
+	case ViewActionCreate:
+		return BuildCreateViewQuery(view), nil
+	case ViewActionDelete:
+		return BuildDeleteViewQuery(view), nil
+	case ViewActionUpdate:
+		if view == nil {
+			return "", nil
+		}
+		return BuildUpdateViewQuery(*view, objects.UpdateViewParam{OldData: *view}), nil
+	default:
+		return "", fmt.Errorf("generate view sql with action '%s' is not available", action)
+	}
+}
+
+// BuildUpdateViewQuery replace view definition, regular view is replaced in place
+// when only the definition is changed, otherwise the old view is dropped and created
+// again in single transaction because materialized view does not support CREATE OR REPLACE
+func BuildUpdateViewQuery(newView objects.View, updateItem objects.UpdateViewParam) string {
+	oldView := updateItem.OldData
+	if oldView.Name == "" {
+		oldView = newView
+	}
+
+	isRecreate := newView.IsMaterialized || oldView.IsMaterialized
+	for _, item := range updateItem.ChangeItems {
+		if item != objects.UpdateViewDefinition {
+			isRecreate = true
+		}
+	}
+
+	if !isRecreate {
+		return BuildCreateViewQuery(&newView)
+	}
+
+	return fmt.Sprintf(`
+		BEGIN;
+			%s
+			%s
+		COMMIT;
+	`, BuildDeleteViewQuery(&oldView), BuildCreateViewQuery(&newView))
+}
+
+func BuildRefreshMaterializedViewQuery(view *objects.View, concurrently bool) string {
+	if view == nil {
+		return ""
+	}
+
+	var concurrentlySql string
+	if concurrently {
+		concurrentlySql = " CONCURRENTLY"
+	}
+	return fmt.Sprintf(`REFRESH MATERIALIZED VIEW%s %s;`, concurrentlySql, getViewIdentifier(view))
+}
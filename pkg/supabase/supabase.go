@@ -8,6 +8,7 @@ import (
 	"fmt"
 	"reflect"
 	"strings"
+	"time"
 
 	"github.com/hashicorp/go-hclog"
 	"github.com/sev-2/raiden"
@@ -280,16 +281,16 @@ func DeletePolicy(cfg *raiden.Config, old objects.Policy) (err error) {
 	})
 }
 
-func GetFunctions(cfg *raiden.Config) ([]objects.Function, error) {
+func GetFunctions(cfg *raiden.Config, includedSchemas []string) ([]objects.Function, error) {
 	if cfg.DeploymentTarget == raiden.DeploymentTargetCloud {
 		SupabaseLogger.Debug("Get all function from supabase cloud", "project-id", cfg.ProjectId)
 		return decorateActionWithDataErr("fetch", "rpc", func() ([]objects.Function, error) {
-			return cloud.GetFunctions(cfg)
+			return cloud.GetFunctions(cfg, includedSchemas)
 		})
 	}
 	SupabaseLogger.Debug("Get all function from supabase pg-meta")
 	return decorateActionWithDataErr("fetch", "rpc", func() ([]objects.Function, error) {
-		return meta.GetFunctions(cfg)
+		return meta.GetFunctions(cfg, includedSchemas)
 	})
 }
 
@@ -306,6 +307,19 @@ func GetFunctionByName(cfg *raiden.Config, schema string, name string) (objects.
 	})
 }
 
+func GetFunctionBySignature(cfg *raiden.Config, schema string, name string, identityArgumentTypes string) (objects.Function, error) {
+	if cfg.DeploymentTarget == raiden.DeploymentTargetCloud {
+		SupabaseLogger.Debug("Get function by signature from supabase cloud", "project-id", cfg.ProjectId)
+		return decorateActionWithDataErr("fetch", "rpc", func() (objects.Function, error) {
+			return cloud.GetFunctionBySignature(cfg, schema, name, identityArgumentTypes)
+		})
+	}
+	SupabaseLogger.Debug("Get function by signature from supabase pg-meta")
+	return decorateActionWithDataErr("fetch", "rpc", func() (objects.Function, error) {
+		return meta.GetFunctionBySignature(cfg, schema, name, identityArgumentTypes)
+	})
+}
+
 func CreateFunction(cfg *raiden.Config, fn objects.Function) (objects.Function, error) {
 	if cfg.DeploymentTarget == raiden.DeploymentTargetCloud {
 		SupabaseLogger.Debug("Create function from supabase cloud", "project-id", cfg.ProjectId)
@@ -358,6 +372,19 @@ func GetIndexes(cfg *raiden.Config, schema string) ([]objects.Index, error) {
 	})
 }
 
+func GetPublications(cfg *raiden.Config) ([]objects.Publication, error) {
+	if cfg.DeploymentTarget == raiden.DeploymentTargetCloud {
+		SupabaseLogger.Debug("Get all publication from supabase cloud", "project-id", cfg.ProjectId)
+		return decorateActionWithDataErr("fetch", "publication", func() ([]objects.Publication, error) {
+			return cloud.GetPublications(cfg)
+		})
+	}
+	SupabaseLogger.Debug("Get all publication from supabase pg-meta")
+	return decorateActionWithDataErr("fetch", "publication", func() ([]objects.Publication, error) {
+		return meta.GetPublications(cfg)
+	})
+}
+
 func AdminUpdateUserData(cfg *raiden.Config, userId string, data objects.User) (objects.User, error) {
 	if cfg.DeploymentTarget == raiden.DeploymentTargetCloud {
 		SupabaseLogger.Debug("Update user data in supabase cloud", "user-id", userId, "project-id", cfg.ProjectId)
@@ -534,6 +561,227 @@ func DeleteType(cfg *raiden.Config, t objects.Type) (err error) {
 	})
 }
 
+func GetTriggers(cfg *raiden.Config, includedSchemas []string) ([]objects.Trigger, error) {
+	if cfg.DeploymentTarget == raiden.DeploymentTargetCloud {
+		SupabaseLogger.Debug("Get all triggers from supabase cloud", "project-id", cfg.ProjectId)
+		return decorateActionWithDataErr("fetch", "triggers", func() ([]objects.Trigger, error) {
+			return cloud.GetTriggers(cfg, includedSchemas)
+		})
+	}
+	SupabaseLogger.Debug("Get all triggers from supabase pg-meta")
+	return decorateActionWithDataErr("fetch", "triggers", func() ([]objects.Trigger, error) {
+		return meta.GetTriggers(cfg, includedSchemas)
+	})
+}
+
+func CreateTrigger(cfg *raiden.Config, t objects.Trigger) (objects.Trigger, error) {
+	if cfg.DeploymentTarget == raiden.DeploymentTargetCloud {
+		SupabaseLogger.Debug("Create trigger from supabase cloud", "project-id", cfg.ProjectId)
+		return decorateActionWithDataErr("create", "trigger", func() (objects.Trigger, error) {
+			return cloud.CreateTrigger(cfg, t)
+		})
+	}
+	SupabaseLogger.Debug("Create trigger from supabase pg-meta")
+	return decorateActionWithDataErr("create", "trigger", func() (objects.Trigger, error) {
+		return meta.CreateTrigger(cfg, t)
+	})
+}
+
+func UpdateTrigger(cfg *raiden.Config, t objects.Trigger, updateItem objects.UpdateTriggerParam) (err error) {
+	if cfg.DeploymentTarget == raiden.DeploymentTargetCloud {
+		SupabaseLogger.Debug("Update trigger in supabase cloud", "name", t.Name, "project-id", cfg.ProjectId)
+		return decorateActionErr("update", "trigger", func() error {
+			return cloud.UpdateTrigger(cfg, t, updateItem)
+		})
+	}
+	SupabaseLogger.Debug("Update trigger in supabase pg-meta", "name", t.Name)
+	return decorateActionErr("update", "trigger", func() error {
+		return meta.UpdateTrigger(cfg, t, updateItem)
+	})
+}
+
+func DeleteTrigger(cfg *raiden.Config, t objects.Trigger) (err error) {
+	if cfg.DeploymentTarget == raiden.DeploymentTargetCloud {
+		SupabaseLogger.Debug("Delete trigger in supabase cloud", "name", t.Name, "project-id", cfg.ProjectId)
+		return decorateActionErr("delete", "trigger", func() error {
+			return cloud.DeleteTrigger(cfg, t)
+		})
+	}
+	SupabaseLogger.Debug("Delete trigger in supabase pg-meta", "name", t.Name)
+	return decorateActionErr("delete", "trigger", func() error {
+		return meta.DeleteTrigger(cfg, t)
+	})
+}
+
+func GetViews(cfg *raiden.Config, includedSchemas []string) ([]objects.View, error) {
+	if cfg.DeploymentTarget == raiden.DeploymentTargetCloud {
+		SupabaseLogger.Debug("Get all views from supabase cloud", "project-id", cfg.ProjectId)
+		return decorateActionWithDataErr("fetch", "views", func() ([]objects.View, error) {
+			return cloud.GetViews(cfg, includedSchemas)
+		})
+	}
+	SupabaseLogger.Debug("Get all views from supabase pg-meta")
+	return decorateActionWithDataErr("fetch", "views", func() ([]objects.View, error) {
+		return meta.GetViews(cfg, includedSchemas)
+	})
+}
+
+func CreateView(cfg *raiden.Config, v objects.View) (objects.View, error) {
+	if cfg.DeploymentTarget == raiden.DeploymentTargetCloud {
+		SupabaseLogger.Debug("Create view from supabase cloud", "project-id", cfg.ProjectId)
+		return decorateActionWithDataErr("create", "view", func() (objects.View, error) {
+			return cloud.CreateView(cfg, v)
+		})
+	}
+	SupabaseLogger.Debug("Create view from supabase pg-meta")
+	return decorateActionWithDataErr("create", "view", func() (objects.View, error) {
+		return meta.CreateView(cfg, v)
+	})
+}
+
+func UpdateView(cfg *raiden.Config, v objects.View, updateItem objects.UpdateViewParam) (err error) {
+	if cfg.DeploymentTarget == raiden.DeploymentTargetCloud {
+		SupabaseLogger.Debug("Update view in supabase cloud", "name", v.Name, "project-id", cfg.ProjectId)
+		return decorateActionErr("update", "view", func() error {
+			return cloud.UpdateView(cfg, v, updateItem)
+		})
+	}
+	SupabaseLogger.Debug("Update view in supabase pg-meta", "name", v.Name)
+	return decorateActionErr("update", "view", func() error {
+		return meta.UpdateView(cfg, v, updateItem)
+	})
+}
+
+func DeleteView(cfg *raiden.Config, v objects.View) (err error) {
+	if cfg.DeploymentTarget == raiden.DeploymentTargetCloud {
+		SupabaseLogger.Debug("Delete view in supabase cloud", "name", v.Name, "project-id", cfg.ProjectId)
+		return decorateActionErr("delete", "view", func() error {
+			return cloud.DeleteView(cfg, v)
+		})
+	}
+	SupabaseLogger.Debug("Delete view in supabase pg-meta", "name", v.Name)
+	return decorateActionErr("delete", "view", func() error {
+		return meta.DeleteView(cfg, v)
+	})
+}
+
+func RefreshMaterializedView(cfg *raiden.Config, v objects.View, concurrently bool) (err error) {
+	if cfg.DeploymentTarget == raiden.DeploymentTargetCloud {
+		SupabaseLogger.Debug("Refresh materialized view in supabase cloud", "name", v.Name, "project-id", cfg.ProjectId)
+		return decorateActionErr("refresh", "materialized view", func() error {
+			return cloud.RefreshMaterializedView(cfg, v, concurrently)
+		})
+	}
+	SupabaseLogger.Debug("Refresh materialized view in supabase pg-meta", "name", v.Name)
+	return decorateActionErr("refresh", "materialized view", func() error {
+		return meta.RefreshMaterializedView(cfg, v, concurrently)
+	})
+}
+
+func GetExtensions(cfg *raiden.Config) ([]objects.Extension, error) {
+	if cfg.DeploymentTarget == raiden.DeploymentTargetCloud {
+		SupabaseLogger.Debug("Get all extensions from supabase cloud", "project-id", cfg.ProjectId)
+		return decorateActionWithDataErr("fetch", "extensions", func() ([]objects.Extension, error) {
+			return cloud.GetExtensions(cfg)
+		})
+	}
+	SupabaseLogger.Debug("Get all extensions from supabase pg-meta")
+	return decorateActionWithDataErr("fetch", "extensions", func() ([]objects.Extension, error) {
+		return meta.GetExtensions(cfg)
+	})
+}
+
+func CreateExtension(cfg *raiden.Config, e objects.Extension) (objects.Extension, error) {
+	if cfg.DeploymentTarget == raiden.DeploymentTargetCloud {
+		SupabaseLogger.Debug("Create extension from supabase cloud", "project-id", cfg.ProjectId)
+		return decorateActionWithDataErr("create", "extension", func() (objects.Extension, error) {
+			return cloud.CreateExtension(cfg, e)
+		})
+	}
+	SupabaseLogger.Debug("Create extension from supabase pg-meta")
+	return decorateActionWithDataErr("create", "extension", func() (objects.Extension, error) {
+		return meta.CreateExtension(cfg, e)
+	})
+}
+
+func UpdateExtension(cfg *raiden.Config, e objects.Extension, updateItem objects.UpdateExtensionParam) (err error) {
+	if cfg.DeploymentTarget == raiden.DeploymentTargetCloud {
+		SupabaseLogger.Debug("Update extension in supabase cloud", "name", e.Name, "project-id", cfg.ProjectId)
+		return decorateActionErr("update", "extension", func() error {
+			return cloud.UpdateExtension(cfg, e, updateItem)
+		})
+	}
+	SupabaseLogger.Debug("Update extension in supabase pg-meta", "name", e.Name)
+	return decorateActionErr("update", "extension", func() error {
+		return meta.UpdateExtension(cfg, e, updateItem)
+	})
+}
+
+func DeleteExtension(cfg *raiden.Config, e objects.Extension) (err error) {
+	if cfg.DeploymentTarget == raiden.DeploymentTargetCloud {
+		SupabaseLogger.Debug("Delete extension in supabase cloud", "name", e.Name, "project-id", cfg.ProjectId)
+		return decorateActionErr("delete", "extension", func() error {
+			return cloud.DeleteExtension(cfg, e)
+		})
+	}
+	SupabaseLogger.Debug("Delete extension in supabase pg-meta", "name", e.Name)
+	return decorateActionErr("delete", "extension", func() error {
+		return meta.DeleteExtension(cfg, e)
+	})
+}
+
+func GetMigrations(cfg *raiden.Config) ([]objects.Migration, error) {
+	if cfg.DeploymentTarget == raiden.DeploymentTargetCloud {
+		SupabaseLogger.Debug("Get all migration from supabase cloud", "project-id", cfg.ProjectId)
+		return decorateActionWithDataErr("fetch", "migration", func() ([]objects.Migration, error) {
+			return cloud.GetMigrations(cfg)
+		})
+	}
+	SupabaseLogger.Debug("Get all migration from supabase pg-meta")
+	return decorateActionWithDataErr("fetch", "migration", func() ([]objects.Migration, error) {
+		return meta.GetMigrations(cfg)
+	})
+}
+
+func GetMigration(cfg *raiden.Config, id int) (objects.Migration, error) {
+	if cfg.DeploymentTarget == raiden.DeploymentTargetCloud {
+		SupabaseLogger.Debug("Get migration from supabase cloud", "id", id, "project-id", cfg.ProjectId)
+		return decorateActionWithDataErr("fetch", "migration", func() (objects.Migration, error) {
+			return cloud.GetMigration(cfg, id)
+		})
+	}
+	SupabaseLogger.Debug("Get migration from supabase pg-meta", "id", id)
+	return decorateActionWithDataErr("fetch", "migration", func() (objects.Migration, error) {
+		return meta.GetMigration(cfg, id)
+	})
+}
+
+func CreateMigration(cfg *raiden.Config, m objects.Migration) (objects.Migration, error) {
+	if cfg.DeploymentTarget == raiden.DeploymentTargetCloud {
+		SupabaseLogger.Debug("Create migration history in supabase cloud", "action", m.Action, "project-id", cfg.ProjectId)
+		return decorateActionWithDataErr("create", "migration", func() (objects.Migration, error) {
+			return cloud.CreateMigration(cfg, m)
+		})
+	}
+	SupabaseLogger.Debug("Create migration history in supabase pg-meta", "action", m.Action)
+	return decorateActionWithDataErr("create", "migration", func() (objects.Migration, error) {
+		return meta.CreateMigration(cfg, m)
+	})
+}
+
+func RunMigrationScript(cfg *raiden.Config, script string) error {
+	if cfg.DeploymentTarget == raiden.DeploymentTargetCloud {
+		SupabaseLogger.Debug("Run migration script in supabase cloud", "project-id", cfg.ProjectId)
+		return decorateActionErr("run", "migration script", func() error {
+			return cloud.RunMigrationScript(cfg, script)
+		})
+	}
+	SupabaseLogger.Debug("Run migration script in supabase pg-meta")
+	return decorateActionErr("run", "migration script", func() error {
+		return meta.RunMigrationScript(cfg, script)
+	})
+}
+
 func decorateActionWithDataErr[T any](action, resource string, fetchFn func() (T, error)) (T, error) {
 	data, err := fetchFn()
 	if err != nil && (StorageLogger.GetLevel() != hclog.Trace && StorageLogger.GetLevel() != hclog.Debug) {
@@ -559,3 +807,55 @@ func decorateActionErr(action, resource string, fetchFn func() error) error {
 	}
 	return err
 }
+
+func GetState(cfg *raiden.Config, name string) (objects.RemoteState, error) {
+	if cfg.DeploymentTarget == raiden.DeploymentTargetCloud {
+		SupabaseLogger.Debug("Get state from supabase cloud", "name", name, "project-id", cfg.ProjectId)
+		return decorateActionWithDataErr("fetch", "state", func() (objects.RemoteState, error) {
+			return cloud.GetState(cfg, name)
+		})
+	}
+	SupabaseLogger.Debug("Get state from supabase pg-meta", "name", name)
+	return decorateActionWithDataErr("fetch", "state", func() (objects.RemoteState, error) {
+		return meta.GetState(cfg, name)
+	})
+}
+
+func SaveState(cfg *raiden.Config, s objects.RemoteState) error {
+	if cfg.DeploymentTarget == raiden.DeploymentTargetCloud {
+		SupabaseLogger.Debug("Save state in supabase cloud", "name", s.Name, "project-id", cfg.ProjectId)
+		return decorateActionErr("save", "state", func() error {
+			return cloud.SaveState(cfg, s)
+		})
+	}
+	SupabaseLogger.Debug("Save state in supabase pg-meta", "name", s.Name)
+	return decorateActionErr("save", "state", func() error {
+		return meta.SaveState(cfg, s)
+	})
+}
+
+func LockState(cfg *raiden.Config, name, lockId, lockedBy string, ttl time.Duration) (objects.RemoteState, error) {
+	if cfg.DeploymentTarget == raiden.DeploymentTargetCloud {
+		SupabaseLogger.Debug("Lock state in supabase cloud", "name", name, "project-id", cfg.ProjectId)
+		return decorateActionWithDataErr("lock", "state", func() (objects.RemoteState, error) {
+			return cloud.LockState(cfg, name, lockId, lockedBy, ttl)
+		})
+	}
+	SupabaseLogger.Debug("Lock state in supabase pg-meta", "name", name)
+	return decorateActionWithDataErr("lock", "state", func() (objects.RemoteState, error) {
+		return meta.LockState(cfg, name, lockId, lockedBy, ttl)
+	})
+}
+
+func UnlockState(cfg *raiden.Config, name, lockId string) error {
+	if cfg.DeploymentTarget == raiden.DeploymentTargetCloud {
+		SupabaseLogger.Debug("Unlock state in supabase cloud", "name", name, "project-id", cfg.ProjectId)
+		return decorateActionErr("unlock", "state", func() error {
+			return cloud.UnlockState(cfg, name, lockId)
+		})
+	}
+	SupabaseLogger.Debug("Unlock state in supabase pg-meta", "name", name)
+	return decorateActionErr("unlock", "state", func() error {
+		return meta.UnlockState(cfg, name, lockId)
+	})
+}
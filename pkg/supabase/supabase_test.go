@@ -1357,7 +1357,7 @@ func TestDeletePolicy_SelfHosted(t *testing.T) {
 func TestGetFunctions_Cloud(t *testing.T) {
 	cfg := loadCloudConfig()
 
-	_, err := supabase.GetFunctions(cfg)
+	_, err := supabase.GetFunctions(cfg, []string{"public"})
 	assert.Error(t, err)
 
 	remoteFunctions := []objects.Function{
@@ -1376,7 +1376,7 @@ func TestGetFunctions_Cloud(t *testing.T) {
 	err0 := mock.MockGetFunctionsWithExpectedResponse(200, remoteFunctions)
 	assert.NoError(t, err0)
 
-	functions, err1 := supabase.GetFunctions(cfg)
+	functions, err1 := supabase.GetFunctions(cfg, []string{"public"})
 	assert.NoError(t, err1)
 	assert.Equal(t, len(remoteFunctions), len(functions))
 }
@@ -1384,7 +1384,7 @@ func TestGetFunctions_Cloud(t *testing.T) {
 func TestGetFunctions_SelfHosted(t *testing.T) {
 	cfg := loadSelfHostedConfig()
 
-	_, err := supabase.GetFunctions(cfg)
+	_, err := supabase.GetFunctions(cfg, []string{"public"})
 	assert.Error(t, err)
 
 	remoteFunctions := []objects.Function{
@@ -1403,7 +1403,7 @@ func TestGetFunctions_SelfHosted(t *testing.T) {
 	err0 := mock.MockGetFunctionsWithExpectedResponse(200, remoteFunctions)
 	assert.NoError(t, err0)
 
-	functions, err1 := supabase.GetFunctions(cfg)
+	functions, err1 := supabase.GetFunctions(cfg, []string{"public"})
 	assert.NoError(t, err1)
 	assert.Equal(t, len(remoteFunctions), len(functions))
 }
@@ -1411,7 +1411,7 @@ func TestGetFunctions_SelfHosted(t *testing.T) {
 func TestGetFunctionByName_Cloud(t *testing.T) {
 	cfg := loadCloudConfig()
 
-	_, err := supabase.GetFunctions(cfg)
+	_, err := supabase.GetFunctions(cfg, []string{"public"})
 	assert.Error(t, err)
 
 	remoteFunction := objects.Function{
@@ -1433,7 +1433,7 @@ func TestGetFunctionByName_Cloud(t *testing.T) {
 func TestGetFunctionByName_SelfHosted(t *testing.T) {
 	cfg := loadSelfHostedConfig()
 
-	_, err := supabase.GetFunctions(cfg)
+	_, err := supabase.GetFunctions(cfg, []string{"public"})
 	assert.Error(t, err)
 
 	remoteFunction := objects.Function{
@@ -1452,6 +1452,26 @@ func TestGetFunctionByName_SelfHosted(t *testing.T) {
 	assert.Equal(t, remoteFunction.Name, function.Name)
 }
 
+func TestGetFunctionBySignature_Cloud(t *testing.T) {
+	cfg := loadCloudConfig()
+
+	remoteFunction := objects.Function{
+		Name:                  "some-function",
+		IdentityArgumentTypes: "in_id integer",
+	}
+
+	mock := mock.MockSupabase{Cfg: cfg}
+	mock.Activate()
+	defer mock.Deactivate()
+
+	err0 := mock.MockGetFunctionByNameWithExpectedResponse(200, remoteFunction)
+	assert.NoError(t, err0)
+
+	function, err1 := supabase.GetFunctionBySignature(cfg, "some-schema", "some-function", "in_id integer")
+	assert.NoError(t, err1)
+	assert.Equal(t, remoteFunction.IdentityArgumentTypes, function.IdentityArgumentTypes)
+}
+
 func TestCreateFunction_Cloud(t *testing.T) {
 	cfg := loadCloudConfig()
 
@@ -703,6 +703,29 @@ func (p RpcParams) ToQuery(userPrefix bool) (string, error) {
 	return strings.Join(qArr, ", "), nil
 }
 
+// ToIdentityQuery return param without default value, the result has the same
+// format with identity argument of postgres function and used for identify overloaded rpc
+func (p RpcParams) ToIdentityQuery(userPrefix bool) (string, error) {
+	var qArr []string
+	for i := range p {
+		pi := p[i]
+
+		var prefix string
+		if userPrefix {
+			prefix = DefaultRpcParamPrefix
+		}
+
+		pt, err := GetValidRpcParamType(string(pi.Type), false)
+		if err != nil {
+			return "", err
+		}
+
+		qArr = append(qArr, fmt.Sprintf("%s%s %s", prefix, pi.Name, pt))
+	}
+
+	return strings.ToLower(strings.Join(qArr, ", ")), nil
+}
+
 func BuildRpc(rpc Rpc) (err error) {
 	rpc.BindModels()
 
@@ -83,6 +83,25 @@ func TestCreateQuery(t *testing.T) {
 	assert.Equal(t, expectedCompleteQuery, rpc.GetCompleteStmt())
 }
 
+func TestRpcParams_ToIdentityQuery(t *testing.T) {
+	defaultName := "anon"
+	params := raiden.RpcParams{
+		{Name: "Name", Type: raiden.RpcParamDataTypeVarcharAlias, Default: &defaultName},
+		{Name: "created_at", Type: raiden.RpcParamDataTypeTimestampTZAlias},
+	}
+
+	q, err := params.ToIdentityQuery(true)
+	assert.NoError(t, err)
+	assert.Equal(t, "in_name character varying, in_created_at timestamp with time zone", q)
+
+	q, err = params.ToIdentityQuery(false)
+	assert.NoError(t, err)
+	assert.Equal(t, "name character varying, created_at timestamp with time zone", q)
+
+	_, err = raiden.RpcParams{{Name: "id", Type: "unknown"}}.ToIdentityQuery(false)
+	assert.Error(t, err)
+}
+
 func TestExecuteRpc(t *testing.T) {
 	mockCtx := &mock.MockContext{
 		ConfigFn: func() *raiden.Config {
@@ -0,0 +1,27 @@
+package raiden
+
+type (
+	// Seed is reference data of model table that upserted with `raiden seed` command,
+	// row is identified by primary key of the model, example :
+	//
+	//	type CountrySeed struct {
+	//		raiden.SeedBase
+	//	}
+	//
+	//	func (s *CountrySeed) Model() any {
+	//		return &models.Countries{}
+	//	}
+	//
+	//	func (s *CountrySeed) Data() []any {
+	//		return []any{
+	//			models.Countries{Id: 1, Code: "ID", Name: "Indonesia"},
+	//			models.Countries{Id: 2, Code: "SG", Name: "Singapore"},
+	//		}
+	//	}
+	Seed interface {
+		Model() any
+		Data() []any
+	}
+
+	SeedBase struct{}
+)
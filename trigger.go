@@ -0,0 +1,94 @@
+package raiden
+
+const (
+	DefaultTriggerSchema = "public"
+)
+
+type (
+	TriggerTiming      string
+	TriggerEvent       string
+	TriggerOrientation string
+	TriggerEnabledMode string
+)
+
+const (
+	TriggerTimingBefore    TriggerTiming = "BEFORE"
+	TriggerTimingAfter     TriggerTiming = "AFTER"
+	TriggerTimingInsteadOf TriggerTiming = "INSTEAD OF"
+
+	TriggerEventInsert   TriggerEvent = "INSERT"
+	TriggerEventUpdate   TriggerEvent = "UPDATE"
+	TriggerEventDelete   TriggerEvent = "DELETE"
+	TriggerEventTruncate TriggerEvent = "TRUNCATE"
+
+	TriggerOrientationRow       TriggerOrientation = "ROW"
+	TriggerOrientationStatement TriggerOrientation = "STATEMENT"
+
+	TriggerEnabledModeOrigin   TriggerEnabledMode = "ORIGIN"
+	TriggerEnabledModeReplica  TriggerEnabledMode = "REPLICA"
+	TriggerEnabledModeAlways   TriggerEnabledMode = "ALWAYS"
+	TriggerEnabledModeDisabled TriggerEnabledMode = "DISABLED"
+)
+
+type (
+	Trigger interface {
+		Name() string
+		Schema() string
+		Table() string
+		Timing() TriggerTiming
+		Events() []TriggerEvent
+		Orientation() TriggerOrientation
+		Condition() string
+		FunctionName() string
+		FunctionSchema() string
+		FunctionArgs() []string
+		EnabledMode() TriggerEnabledMode
+	}
+)
+
+// ----- base trigger default function -----
+type TriggerBase struct{}
+
+func (*TriggerBase) Name() string {
+	return ""
+}
+
+func (*TriggerBase) Schema() string {
+	return DefaultTriggerSchema
+}
+
+func (*TriggerBase) Table() string {
+	return ""
+}
+
+func (*TriggerBase) Timing() TriggerTiming {
+	return TriggerTimingAfter
+}
+
+func (*TriggerBase) Events() []TriggerEvent {
+	return []TriggerEvent{}
+}
+
+func (*TriggerBase) Orientation() TriggerOrientation {
+	return TriggerOrientationRow
+}
+
+func (*TriggerBase) Condition() string {
+	return ""
+}
+
+func (*TriggerBase) FunctionName() string {
+	return ""
+}
+
+func (*TriggerBase) FunctionSchema() string {
+	return DefaultTriggerSchema
+}
+
+func (*TriggerBase) FunctionArgs() []string {
+	return []string{}
+}
+
+func (*TriggerBase) EnabledMode() TriggerEnabledMode {
+	return TriggerEnabledModeOrigin
+}
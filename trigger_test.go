@@ -0,0 +1,23 @@
+package raiden_test
+
+import (
+	"testing"
+
+	"github.com/sev-2/raiden"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTriggerBase_Default(t *testing.T) {
+	triggerBase := raiden.TriggerBase{}
+	assert.Equal(t, "", triggerBase.Name())
+	assert.Equal(t, raiden.DefaultTriggerSchema, triggerBase.Schema())
+	assert.Equal(t, "", triggerBase.Table())
+	assert.Equal(t, raiden.TriggerTimingAfter, triggerBase.Timing())
+	assert.Equal(t, 0, len(triggerBase.Events()))
+	assert.Equal(t, raiden.TriggerOrientationRow, triggerBase.Orientation())
+	assert.Equal(t, "", triggerBase.Condition())
+	assert.Equal(t, "", triggerBase.FunctionName())
+	assert.Equal(t, raiden.DefaultTriggerSchema, triggerBase.FunctionSchema())
+	assert.Equal(t, 0, len(triggerBase.FunctionArgs()))
+	assert.Equal(t, raiden.TriggerEnabledModeOrigin, triggerBase.EnabledMode())
+}
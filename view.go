@@ -0,0 +1,35 @@
+package raiden
+
+const (
+	DefaultViewSchema = "public"
+)
+
+// View is database view declaration, view name and schema
+// is taken from Metadata field tag, example :
+//
+//	type ActiveUser struct {
+//		raiden.ViewBase
+//		Id   int64  `json:"id,omitempty" column:"name:id;type:bigint"`
+//		Name string `json:"name,omitempty" column:"name:name;type:text"`
+//
+//		Metadata string `json:"-" schema:"public" tableName:"active_user"`
+//	}
+//
+//	func (v *ActiveUser) Definition() string {
+//		return "SELECT id, name FROM users WHERE is_active"
+//	}
+type View interface {
+	Definition() string
+	Materialized() bool
+}
+
+// ----- base view default function -----
+type ViewBase struct{}
+
+func (*ViewBase) Definition() string {
+	return ""
+}
+
+func (*ViewBase) Materialized() bool {
+	return false
+}
@@ -0,0 +1,14 @@
+package raiden_test
+
+import (
+	"testing"
+
+	"github.com/sev-2/raiden"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestViewBase_Default(t *testing.T) {
+	viewBase := raiden.ViewBase{}
+	assert.Equal(t, "", viewBase.Definition())
+	assert.False(t, viewBase.Materialized())
+}